@@ -0,0 +1,19 @@
+package clients
+
+import (
+	"log"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func NewDeliveryServiceClient(address string) (pb.DeliveryServiceClient, *grpc.ClientConn) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to delivery service: %v", err)
+	}
+
+	client := pb.NewDeliveryServiceClient(conn)
+	return client, conn
+}
@@ -0,0 +1,19 @@
+package clients
+
+import (
+	"log"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func NewOrderServiceClient(address string) (pb.OrderServiceClient, *grpc.ClientConn) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to order service: %v", err)
+	}
+
+	client := pb.NewOrderServiceClient(conn)
+	return client, conn
+}
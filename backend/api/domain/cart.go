@@ -0,0 +1,48 @@
+package domain
+
+type Cart struct {
+	UserID       string         `json:"user_id"`
+	RestaurantID string         `json:"restaurant_id"`
+	Items        []*CartItem    `json:"items"`
+	Subtotal     float64        `json:"subtotal"`
+	Valid        bool           `json:"valid"`
+	Warnings     []*CartWarning `json:"warnings"`
+	UpdatedAt    string         `json:"updated_at"`
+}
+
+type CartItem struct {
+	ID             string             `json:"id"`
+	MenuItemID     string             `json:"menu_item_id"`
+	Name           string             `json:"name"`
+	OptionIDs      []string           `json:"option_ids"`
+	Options        []*OrderItemOption `json:"options"`
+	Quantity       int32              `json:"quantity"`
+	UnitPrice      float64            `json:"unit_price"`
+	AddedUnitPrice float64            `json:"added_unit_price"`
+	LinePrice      float64            `json:"line_price"`
+	Valid          bool               `json:"valid"`
+	Errors         []string           `json:"errors,omitempty"`
+}
+
+type CartWarning struct {
+	CartItemID string `json:"cart_item_id"`
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+}
+
+type AddCartItemRequest struct {
+	RestaurantID string   `json:"restaurant_id" binding:"required"`
+	MenuItemID   string   `json:"menu_item_id" binding:"required"`
+	OptionIDs    []string `json:"option_ids"`
+	Quantity     int32    `json:"quantity" binding:"omitempty,min=1"`
+	Replace      bool     `json:"replace"`
+}
+
+type UpdateCartItemRequest struct {
+	Quantity    int32 `json:"quantity" binding:"required,min=1"`
+	AcceptPrice bool  `json:"accept_price"`
+}
+
+type CartResponse struct {
+	Cart *Cart `json:"cart"`
+}
@@ -0,0 +1,99 @@
+package domain
+
+type Delivery struct {
+	ID               string  `json:"id"`
+	OrderID          string  `json:"order_id"`
+	CustomerID       string  `json:"customer_id"`
+	RestaurantID     string  `json:"restaurant_id"`
+	CourierID        string  `json:"courier_id"`
+	Status           string  `json:"status"`
+	PickupLatitude   float64 `json:"pickup_latitude"`
+	PickupLongitude  float64 `json:"pickup_longitude"`
+	DropoffLatitude  float64 `json:"dropoff_latitude"`
+	DropoffLongitude float64 `json:"dropoff_longitude"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
+}
+
+type GetDeliveryResponse struct {
+	Delivery *Delivery `json:"delivery"`
+}
+
+type AssignCourierRequest struct {
+	CourierID string `json:"courier_id" binding:"required"`
+}
+
+type AssignCourierResponse struct {
+	Delivery *Delivery `json:"delivery"`
+}
+
+type UpdateDeliveryStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=picked_up delivered"`
+}
+
+type UpdateDeliveryStatusResponse struct {
+	Delivery *Delivery `json:"delivery"`
+}
+
+type Courier struct {
+	CourierID   string  `json:"courier_id"`
+	IsAvailable bool    `json:"is_available"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	UpdatedAt   string  `json:"updated_at"`
+}
+
+type DeliveryOffer struct {
+	ID               string    `json:"id"`
+	DeliveryID       string    `json:"delivery_id"`
+	CourierID        string    `json:"courier_id"`
+	Status           string    `json:"status"`
+	DistanceKm       float64   `json:"distance_km"`
+	TripDistanceKm   float64   `json:"trip_distance_km"`
+	ExpiresInSeconds int32     `json:"expires_in_seconds"`
+	Delivery         *Delivery `json:"delivery"`
+	CreatedAt        string    `json:"created_at"`
+}
+
+// SetCourierAvailabilityRequest needs the position to go on shift; going off
+// shift without one keeps the last known position
+type SetCourierAvailabilityRequest struct {
+	IsAvailable *bool    `json:"is_available" binding:"required"`
+	Latitude    *float64 `json:"latitude" binding:"omitempty,min=-90,max=90"`
+	Longitude   *float64 `json:"longitude" binding:"omitempty,min=-180,max=180"`
+}
+
+type SetCourierAvailabilityResponse struct {
+	Courier *Courier `json:"courier"`
+}
+
+type GetCourierOfferResponse struct {
+	Offer *DeliveryOffer `json:"offer"`
+}
+
+type RespondToOfferResponse struct {
+	Offer *DeliveryOffer `json:"offer"`
+}
+
+type LocationPing struct {
+	Latitude  float64 `json:"latitude" binding:"min=-90,max=90"`
+	Longitude float64 `json:"longitude" binding:"min=-180,max=180"`
+}
+
+type UpdateLocationRequest struct {
+	Pings []LocationPing `json:"pings" binding:"required,min=1,max=100,dive"`
+}
+
+type UpdateLocationResponse struct {
+	Accepted int32 `json:"accepted"`
+}
+
+type DeliveryEvent struct {
+	DeliveryID       string  `json:"delivery_id"`
+	Status           string  `json:"status"`
+	CourierID        string  `json:"courier_id"`
+	HasLocation      bool    `json:"has_location"`
+	CourierLatitude  float64 `json:"courier_latitude"`
+	CourierLongitude float64 `json:"courier_longitude"`
+	CreatedAt        string  `json:"created_at"`
+}
@@ -0,0 +1,136 @@
+package domain
+
+type Order struct {
+	ID                string          `json:"id"`
+	UserID            string          `json:"user_id"`
+	RestaurantID      string          `json:"restaurant_id"`
+	DeliveryAddressID string          `json:"delivery_address_id"`
+	Status            string          `json:"status"`
+	TotalPrice        float64         `json:"total_price"`
+	QuoteID           string          `json:"quote_id,omitempty"`
+	Price             *PriceBreakdown `json:"price"`
+	PaymentStatus     string          `json:"payment_status"`
+	Items             []*OrderItem    `json:"items"`
+	CreatedAt         string          `json:"created_at"`
+	UpdatedAt         string          `json:"updated_at"`
+}
+
+// PriceBreakdown amounts are integer minor units of Currency
+type PriceBreakdown struct {
+	Currency      string `json:"currency"`
+	Subtotal      int64  `json:"subtotal"`
+	DeliveryFee   int64  `json:"delivery_fee"`
+	SmallOrderFee int64  `json:"small_order_fee"`
+	ServiceFee    int64  `json:"service_fee"`
+	Discount      int64  `json:"discount"`
+	Tax           int64  `json:"tax"`
+	Total         int64  `json:"total"`
+}
+
+type OrderItem struct {
+	ID         string             `json:"id"`
+	MenuItemID string             `json:"menu_item_id"`
+	Name       string             `json:"name"`
+	Price      float64            `json:"price"`
+	Quantity   int32              `json:"quantity"`
+	Options    []*OrderItemOption `json:"options"`
+}
+
+type OrderItemOption struct {
+	GroupName  string  `json:"group_name"`
+	Name       string  `json:"name"`
+	PriceDelta float64 `json:"price_delta"`
+}
+
+type OrderItemInput struct {
+	MenuItemID string   `json:"menu_item_id" binding:"required"`
+	Quantity   int32    `json:"quantity" binding:"required,min=1"`
+	OptionIDs  []string `json:"option_ids"`
+}
+
+// CreateOrderRequest - with a quote_id the rest defaults to what was quoted,
+// promo codes included
+type CreateOrderRequest struct {
+	QuoteID           string            `json:"quote_id"`
+	RestaurantID      string            `json:"restaurant_id" binding:"required_without=QuoteID"`
+	DeliveryAddressID string            `json:"delivery_address_id" binding:"required_without=QuoteID"`
+	Items             []*OrderItemInput `json:"items" binding:"required_without=QuoteID,dive"`
+	PaymentMethod     string            `json:"payment_method"`
+	PromoCodes        []string          `json:"promo_codes"`
+}
+
+type CreateOrderResponse struct {
+	Order *Order `json:"order"`
+}
+
+type QuoteLine struct {
+	MenuItemID string             `json:"menu_item_id"`
+	Name       string             `json:"name"`
+	OptionIDs  []string           `json:"option_ids"`
+	Options    []*OrderItemOption `json:"options"`
+	Quantity   int32              `json:"quantity"`
+	UnitPrice  int64              `json:"unit_price"`
+	LinePrice  int64              `json:"line_price"`
+}
+
+type Quote struct {
+	ID                string            `json:"id"`
+	RestaurantID      string            `json:"restaurant_id"`
+	DeliveryAddressID string            `json:"delivery_address_id"`
+	Lines             []*QuoteLine      `json:"lines"`
+	Price             *PriceBreakdown   `json:"price"`
+	Promos            []*AppliedPromo   `json:"promos"`
+	PromoRejections   []*PromoRejection `json:"promo_rejections"`
+	DistanceKm        float64           `json:"distance_km"`
+	ExpiresAt         string            `json:"expires_at"`
+}
+
+// QuoteRequest - without items the user's cart is quoted
+type QuoteRequest struct {
+	RestaurantID      string            `json:"restaurant_id"`
+	DeliveryAddressID string            `json:"delivery_address_id" binding:"required"`
+	Items             []*OrderItemInput `json:"items" binding:"omitempty,dive"`
+	PromoCodes        []string          `json:"promo_codes"`
+}
+
+type QuoteResponse struct {
+	Quote *Quote `json:"quote"`
+}
+
+type OrderRejectionResponse struct {
+	Error            string            `json:"error"`
+	Reason           string            `json:"reason"`
+	UnavailableItems []string          `json:"unavailable_items"`
+	Errors           []string          `json:"errors,omitempty"`
+	PromoRejections  []*PromoRejection `json:"promo_rejections,omitempty"`
+}
+
+type OrderStatusChange struct {
+	FromStatus  string `json:"from_status"`
+	ToStatus    string `json:"to_status"`
+	ActorUserID string `json:"actor_user_id"`
+	ActorRole   string `json:"actor_role"`
+	CreatedAt   string `json:"created_at"`
+}
+
+type GetOrderResponse struct {
+	Order   *Order               `json:"order"`
+	History []*OrderStatusChange `json:"history"`
+}
+
+type ListOrdersResponse struct {
+	Orders        []*Order `json:"orders"`
+	NextPageToken string   `json:"next_page_token"`
+}
+
+type CancelOrderResponse struct {
+	Order *Order `json:"order"`
+}
+
+type UpdateOrderStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=accepted preparing ready_for_pickup cancelled rejected"`
+}
+
+type UpdateOrderStatusResponse struct {
+	Order *Order `json:"order"`
+}
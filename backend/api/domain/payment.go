@@ -0,0 +1,14 @@
+package domain
+
+type PaymentWebhookEvent struct {
+	ID            string `json:"id" binding:"required"`
+	Type          string `json:"type" binding:"required"`
+	PaymentRef    string `json:"payment_ref" binding:"required"`
+	FailureReason string `json:"failure_reason"`
+}
+
+type PaymentWebhookResponse struct {
+	OrderID       string `json:"order_id,omitempty"`
+	PaymentStatus string `json:"payment_status,omitempty"`
+	Duplicate     bool   `json:"duplicate"`
+}
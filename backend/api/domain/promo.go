@@ -0,0 +1,65 @@
+package domain
+
+// PromoCode amounts are integer minor units; starts_at and ends_at are RFC3339
+type PromoCode struct {
+	ID             string   `json:"id"`
+	Code           string   `json:"code"`
+	Description    string   `json:"description"`
+	Kind           string   `json:"kind"`
+	PercentOff     int32    `json:"percent_off"`
+	AmountOff      int64    `json:"amount_off"`
+	MaxDiscount    int64    `json:"max_discount"`
+	MinSubtotal    int64    `json:"min_subtotal"`
+	FirstOrderOnly bool     `json:"first_order_only"`
+	RestaurantIDs  []string `json:"restaurant_ids"`
+	StartsAt       string   `json:"starts_at"`
+	EndsAt         string   `json:"ends_at"`
+	MaxUses        int32    `json:"max_uses"`
+	MaxUsesPerUser int32    `json:"max_uses_per_user"`
+	Stackable      bool     `json:"stackable"`
+	Active         bool     `json:"active"`
+	TimesUsed      int32    `json:"times_used"`
+	CreatedAt      string   `json:"created_at"`
+	UpdatedAt      string   `json:"updated_at"`
+}
+
+type AppliedPromo struct {
+	PromoID  string `json:"promo_id"`
+	Code     string `json:"code"`
+	Kind     string `json:"kind"`
+	Discount int64  `json:"discount"`
+}
+
+type PromoRejection struct {
+	Code    string `json:"code"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// PromoCodeRequest - used to create a code and to replace all of its fields
+type PromoCodeRequest struct {
+	Code           string   `json:"code" binding:"required,max=40"`
+	Description    string   `json:"description" binding:"max=500"`
+	Kind           string   `json:"kind" binding:"required,oneof=percent_off fixed_amount free_delivery"`
+	PercentOff     int32    `json:"percent_off" binding:"min=0,max=100"`
+	AmountOff      int64    `json:"amount_off" binding:"min=0"`
+	MaxDiscount    int64    `json:"max_discount" binding:"min=0"`
+	MinSubtotal    int64    `json:"min_subtotal" binding:"min=0"`
+	FirstOrderOnly bool     `json:"first_order_only"`
+	RestaurantIDs  []string `json:"restaurant_ids" binding:"dive,uuid"`
+	StartsAt       string   `json:"starts_at"`
+	EndsAt         string   `json:"ends_at"`
+	MaxUses        int32    `json:"max_uses" binding:"min=0"`
+	MaxUsesPerUser int32    `json:"max_uses_per_user" binding:"min=0"`
+	Stackable      bool     `json:"stackable"`
+	Active         bool     `json:"active"`
+}
+
+type PromoCodeResponse struct {
+	PromoCode *PromoCode `json:"promo_code"`
+}
+
+type ListPromoCodesResponse struct {
+	PromoCodes    []*PromoCode `json:"promo_codes"`
+	NextPageToken string       `json:"next_page_token"`
+}
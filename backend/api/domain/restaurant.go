@@ -1,70 +1,378 @@
 package domain
 
 type Restaurant struct {
-	ID          string  `json:"id"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Address     string  `json:"address"`
-	Phone       string  `json:"phone"`
-	Latitude    float64 `json:"latitude"`
-	Longitude   float64 `json:"longitude"`
-	LogoURL     string  `json:"logo_url"`
-	OpeningTime string  `json:"opening_time"`
-	ClosingTime string  `json:"closing_time"`
-	CreatedAt   string  `json:"created_at"`
-	UpdatedAt   string  `json:"updated_at"`
+	ID               string     `json:"id"`
+	Name             string     `json:"name"`
+	Description      string     `json:"description"`
+	Address          string     `json:"address"`
+	Phone            string     `json:"phone"`
+	Latitude         float64    `json:"latitude"`
+	Longitude        float64    `json:"longitude"`
+	LogoURL          string     `json:"logo_url"`
+	OpeningTime      string     `json:"opening_time"`
+	ClosingTime      string     `json:"closing_time"`
+	CreatedAt        string     `json:"created_at"`
+	UpdatedAt        string     `json:"updated_at"`
+	DeliveryRadiusKm float64    `json:"delivery_radius_km"`
+	DeliveryPolygon  []GeoPoint `json:"delivery_polygon"`
+	DistanceKm       float64    `json:"distance_km"`
+	OwnerUserID      string     `json:"owner_user_id"`
+	Timezone         string     `json:"timezone"`
+	IsPaused         bool       `json:"is_paused"`
+}
+
+type GeoPoint struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
 }
 
 type MenuItem struct {
-	ID           string  `json:"id"`
-	RestaurantID string  `json:"restaurant_id"`
-	Name         string  `json:"name"`
-	Description  string  `json:"description"`
-	Price        float64 `json:"price"`
-	ImageURL     string  `json:"image_url"`
-	IsAvailable  bool    `json:"is_available"`
-	Category     string  `json:"category"`
-	CreatedAt    string  `json:"created_at"`
-	UpdatedAt    string  `json:"updated_at"`
+	ID           string         `json:"id"`
+	RestaurantID string         `json:"restaurant_id"`
+	Name         string         `json:"name"`
+	Description  string         `json:"description"`
+	Price        float64        `json:"price"`
+	ImageURL     string         `json:"image_url"`
+	IsAvailable  bool           `json:"is_available"`
+	Category     string         `json:"category"`
+	CategoryID   string         `json:"category_id"`
+	Position     int32          `json:"position"`
+	ExternalSKU  string         `json:"external_sku"`
+	OptionGroups []*OptionGroup `json:"option_groups"`
+	CreatedAt    string         `json:"created_at"`
+	UpdatedAt    string         `json:"updated_at"`
+}
+
+// OptionGroup is a choice offered with a menu item; customers pick between
+// min_select and max_select of its options.
+type OptionGroup struct {
+	ID        string        `json:"id"`
+	Name      string        `json:"name"`
+	MinSelect int32         `json:"min_select"`
+	MaxSelect int32         `json:"max_select"`
+	Options   []*MenuOption `json:"options"`
 }
 
+type MenuOption struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	PriceDelta  float64 `json:"price_delta"`
+	IsAvailable bool    `json:"is_available"`
+}
+
+// GetRestaurantsResponse next_page_token is passed back as page_token to
+// get the next page; it is empty on the last page.
 type GetRestaurantsResponse struct {
-	Restaurants []*Restaurant `json:"restaurants"`
-	Total       int32         `json:"total"`
+	Restaurants   []*Restaurant `json:"restaurants"`
+	NextPageToken string        `json:"next_page_token"`
 }
 
 type GetRestaurantResponse struct {
 	Restaurant *Restaurant `json:"restaurant"`
 }
 
+// GetMenuResponse has items for a page of the menu and categories when the
+// whole menu is requested with grouped=true.
 type GetMenuResponse struct {
-	Items []*MenuItem `json:"items"`
-	Total int32       `json:"total"`
+	Items         []*MenuItem     `json:"items"`
+	Categories    []*MenuCategory `json:"categories,omitempty"`
+	NextPageToken string          `json:"next_page_token"`
+}
+
+// MenuCategory groups the menu; items without a category are listed last
+// under a category with an empty id.
+type MenuCategory struct {
+	ID       string      `json:"id"`
+	Name     string      `json:"name"`
+	Position int32       `json:"position"`
+	Items    []*MenuItem `json:"items,omitempty"`
 }
 
 type GetMenuItemResponse struct {
 	Item *MenuItem `json:"item"`
 }
 
+// GetRestaurantStatusResponse times are RFC 3339 in the restaurant's
+// timezone and left out when unknown, e.g. while the restaurant is paused.
 type GetRestaurantStatusResponse struct {
 	IsAcceptingOrders bool   `json:"is_accepting_orders"`
 	OpeningTime       string `json:"opening_time"`
 	ClosingTime       string `json:"closing_time"`
+	Timezone          string `json:"timezone"`
+	IsPaused          bool   `json:"is_paused"`
+	IsOpen            bool   `json:"is_open"`
+	NextOpenAt        string `json:"next_open_at,omitempty"`
+	NextCloseAt       string `json:"next_close_at,omitempty"`
 }
 
 type MenuItemValidation struct {
-	ItemID      string `json:"item_id"`
-	IsAvailable bool   `json:"is_available"`
-	Name        string `json:"name"`
+	ItemID      string  `json:"item_id"`
+	IsAvailable bool    `json:"is_available"`
+	Name        string  `json:"name"`
+	Price       float64 `json:"price"`
 }
 
+// ValidateMenuItemsRequest needs item_ids, lines or both. Lines also check
+// the chosen options and get priced.
 type ValidateMenuItemsRequest struct {
-	RestaurantID string   `json:"restaurant_id" binding:"required"`
-	ItemIDs      []string `json:"item_ids" binding:"required,min=1"`
+	RestaurantID string           `json:"restaurant_id" binding:"required"`
+	ItemIDs      []string         `json:"item_ids"`
+	Lines        []*ItemSelection `json:"lines" binding:"dive"`
+}
+
+type ItemSelection struct {
+	MenuItemID string   `json:"menu_item_id" binding:"required"`
+	OptionIDs  []string `json:"option_ids"`
+	Quantity   int32    `json:"quantity" binding:"min=0"`
+}
+
+type SelectedOption struct {
+	ID         string  `json:"id"`
+	GroupName  string  `json:"group_name"`
+	Name       string  `json:"name"`
+	PriceDelta float64 `json:"price_delta"`
+}
+
+type LineValidation struct {
+	MenuItemID string            `json:"menu_item_id"`
+	Valid      bool              `json:"valid"`
+	Name       string            `json:"name"`
+	UnitPrice  float64           `json:"unit_price"`
+	Quantity   int32             `json:"quantity"`
+	LinePrice  float64           `json:"line_price"`
+	Options    []*SelectedOption `json:"options"`
+	Errors     []string          `json:"errors"`
 }
 
 type ValidateMenuItemsResponse struct {
 	AllAvailable     bool                  `json:"all_available"`
 	Items            []*MenuItemValidation `json:"items"`
 	UnavailableItems []string              `json:"unavailable_items"`
+	Lines            []*LineValidation     `json:"lines"`
+	AllValid         bool                  `json:"all_valid"`
+}
+
+type CheckDeliverableResponse struct {
+	Deliverable bool    `json:"deliverable"`
+	DistanceKm  float64 `json:"distance_km"`
+}
+
+type SearchResult struct {
+	Restaurant *Restaurant `json:"restaurant"`
+	Items      []*MenuItem `json:"items"`
+	Rank       float64     `json:"rank"`
+}
+
+// SearchResponse next_page_token is passed back as page_token to get the
+// next page; it is empty on the last page.
+type SearchResponse struct {
+	Results       []*SearchResult `json:"results"`
+	NextPageToken string          `json:"next_page_token"`
+}
+
+type CreateRestaurantRequest struct {
+	OwnerUserID      string     `json:"owner_user_id"`
+	Name             string     `json:"name" binding:"required,max=255"`
+	Description      string     `json:"description"`
+	Address          string     `json:"address" binding:"required,max=255"`
+	Phone            string     `json:"phone" binding:"required,max=20"`
+	Latitude         float64    `json:"latitude" binding:"min=-90,max=90"`
+	Longitude        float64    `json:"longitude" binding:"min=-180,max=180"`
+	LogoURL          string     `json:"logo_url" binding:"omitempty,url,max=500"`
+	OpeningTime      string     `json:"opening_time" binding:"required"`
+	ClosingTime      string     `json:"closing_time" binding:"required"`
+	DeliveryRadiusKm float64    `json:"delivery_radius_km" binding:"min=0"`
+	DeliveryPolygon  []GeoPoint `json:"delivery_polygon"`
+	Timezone         string     `json:"timezone" binding:"max=64"`
+}
+
+type CreateRestaurantResponse struct {
+	Restaurant *Restaurant `json:"restaurant"`
+}
+
+// UpdateRestaurantRequest changes only the fields present in the body;
+// an empty delivery_polygon removes the polygon.
+type UpdateRestaurantRequest struct {
+	Name             *string     `json:"name" binding:"omitempty,max=255"`
+	Description      *string     `json:"description"`
+	Address          *string     `json:"address" binding:"omitempty,max=255"`
+	Phone            *string     `json:"phone" binding:"omitempty,max=20"`
+	Latitude         *float64    `json:"latitude" binding:"omitempty,min=-90,max=90"`
+	Longitude        *float64    `json:"longitude" binding:"omitempty,min=-180,max=180"`
+	LogoURL          *string     `json:"logo_url" binding:"omitempty,max=500"`
+	OpeningTime      *string     `json:"opening_time"`
+	ClosingTime      *string     `json:"closing_time"`
+	DeliveryRadiusKm *float64    `json:"delivery_radius_km" binding:"omitempty,gt=0"`
+	DeliveryPolygon  *[]GeoPoint `json:"delivery_polygon"`
+	Timezone         *string     `json:"timezone" binding:"omitempty,max=64"`
+}
+
+type UpdateRestaurantResponse struct {
+	Restaurant *Restaurant `json:"restaurant"`
+}
+
+type CreateMenuItemRequest struct {
+	Name        string  `json:"name" binding:"required,max=255"`
+	Description string  `json:"description"`
+	Price       float64 `json:"price" binding:"required,gt=0"`
+	ImageURL    string  `json:"image_url" binding:"omitempty,url,max=500"`
+	Category    string  `json:"category" binding:"max=50"`
+	IsAvailable *bool   `json:"is_available"`
+	ExternalSKU string  `json:"external_sku" binding:"max=100"`
+}
+
+type CreateMenuItemResponse struct {
+	Item *MenuItem `json:"item"`
+}
+
+// UpdateMenuItemRequest changes only the fields present in the body.
+type UpdateMenuItemRequest struct {
+	Name        *string  `json:"name" binding:"omitempty,max=255"`
+	Description *string  `json:"description"`
+	Price       *float64 `json:"price" binding:"omitempty,gt=0"`
+	ImageURL    *string  `json:"image_url" binding:"omitempty,max=500"`
+	Category    *string  `json:"category" binding:"omitempty,max=50"`
+	IsAvailable *bool    `json:"is_available"`
+	ExternalSKU *string  `json:"external_sku" binding:"omitempty,max=100"`
+}
+
+type UpdateMenuItemResponse struct {
+	Item *MenuItem `json:"item"`
+}
+
+type SetItemAvailabilityRequest struct {
+	IsAvailable *bool `json:"is_available" binding:"required"`
+}
+
+type SetItemAvailabilityResponse struct {
+	Item *MenuItem `json:"item"`
+}
+
+type ImportRowError struct {
+	Row     int32  `json:"row"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type ImportMenuResponse struct {
+	Created int32             `json:"created"`
+	Updated int32             `json:"updated"`
+	Errors  []*ImportRowError `json:"errors"`
+	Applied bool              `json:"applied"`
+}
+
+// UploadedImage is an uploaded image and its thumbnails, keyed by size name
+type UploadedImage struct {
+	URL        string            `json:"url"`
+	Thumbnails map[string]string `json:"thumbnails"`
+}
+
+type UploadRestaurantLogoResponse struct {
+	Restaurant *Restaurant    `json:"restaurant"`
+	Image      *UploadedImage `json:"image"`
+}
+
+type UploadMenuItemImageResponse struct {
+	Item  *MenuItem      `json:"item"`
+	Image *UploadedImage `json:"image"`
+}
+
+// SetMenuItemOptionsRequest replaces all option groups of a menu item.
+// Groups and options keep their id when it is sent back.
+type SetMenuItemOptionsRequest struct {
+	OptionGroups []*OptionGroupInput `json:"option_groups" binding:"dive"`
+}
+
+// OptionGroupInput creates a group when id is empty and updates it otherwise
+type OptionGroupInput struct {
+	ID        string             `json:"id"`
+	Name      string             `json:"name" binding:"required,max=100"`
+	MinSelect int32              `json:"min_select" binding:"min=0"`
+	MaxSelect int32              `json:"max_select" binding:"required,min=1"`
+	Options   []*MenuOptionInput `json:"options" binding:"required,min=1,dive"`
+}
+
+// MenuOptionInput is available unless is_available is false
+type MenuOptionInput struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name" binding:"required,max=100"`
+	PriceDelta  float64 `json:"price_delta" binding:"min=0"`
+	IsAvailable *bool   `json:"is_available"`
+}
+
+type SetMenuItemOptionsResponse struct {
+	Item *MenuItem `json:"item"`
+}
+
+// TimeInterval times are HH:MM in the restaurant's timezone. A closing time
+// at or before the opening time runs past midnight.
+type TimeInterval struct {
+	OpensAt  string `json:"opens_at" binding:"required"`
+	ClosesAt string `json:"closes_at" binding:"required"`
+}
+
+// WeeklyHours weekday 0 is Sunday; weekdays left out are closed
+type WeeklyHours struct {
+	Weekday   int32           `json:"weekday" binding:"min=0,max=6"`
+	Intervals []*TimeInterval `json:"intervals" binding:"dive"`
+}
+
+// HoursException replaces the weekly hours on its date (YYYY-MM-DD).
+// Without intervals the restaurant is closed all day.
+type HoursException struct {
+	Date      string          `json:"date" binding:"required"`
+	Intervals []*TimeInterval `json:"intervals" binding:"dive"`
+	Note      string          `json:"note" binding:"max=200"`
+}
+
+type OpeningHours struct {
+	Timezone   string            `json:"timezone"`
+	Weekly     []*WeeklyHours    `json:"weekly"`
+	Exceptions []*HoursException `json:"exceptions"`
+	IsPaused   bool              `json:"is_paused"`
+}
+
+type GetOpeningHoursResponse struct {
+	Hours *OpeningHours `json:"hours"`
+}
+
+// SetOpeningHoursRequest replaces the weekly hours and all exceptions. With
+// no weekly hours the restaurant falls back to opening_time and closing_time.
+type SetOpeningHoursRequest struct {
+	Weekly     []*WeeklyHours    `json:"weekly" binding:"dive"`
+	Exceptions []*HoursException `json:"exceptions" binding:"dive"`
+}
+
+type SetOpeningHoursResponse struct {
+	Hours *OpeningHours `json:"hours"`
+}
+
+type SetRestaurantPausedRequest struct {
+	Paused *bool `json:"paused" binding:"required"`
+}
+
+type SetRestaurantPausedResponse struct {
+	Restaurant *Restaurant `json:"restaurant"`
+}
+
+// ReorderMenuCategoriesRequest lists categories in their new order; the ones
+// left out keep their order after them.
+type ReorderMenuCategoriesRequest struct {
+	CategoryIDs []string `json:"category_ids" binding:"required,min=1"`
+}
+
+type ReorderMenuCategoriesResponse struct {
+	Categories []*MenuCategory `json:"categories"`
+}
+
+// ReorderMenuItemsRequest orders the items of one category like
+// ReorderMenuCategoriesRequest. An empty category_id stands for the items
+// without a category.
+type ReorderMenuItemsRequest struct {
+	CategoryID string   `json:"category_id"`
+	ItemIDs    []string `json:"item_ids" binding:"required,min=1"`
+}
+
+type ReorderMenuItemsResponse struct {
+	Items []*MenuItem `json:"items"`
 }
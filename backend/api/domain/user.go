@@ -1,20 +1,21 @@
 package domain
 
 type User struct {
-	Id        string `json:"id"`
-	Email     string `json:"email"`
-	Name      string `json:"name"`
-	Phone     string `json:"phone"`
-	Role      string `json:"role"`
-	CreatedAt string `json:"created_at"`
+	Id            string `json:"id"`
+	Email         string `json:"email"`
+	Name          string `json:"name"`
+	Phone         string `json:"phone"`
+	Role          string `json:"role"`
+	CreatedAt     string `json:"created_at"`
+	EmailVerified bool   `json:"email_verified"`
 }
 
+// RegisterRequest - new users are customers, an admin grants other roles
 type RegisterRequest struct {
 	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required,min=6"`
 	Name     string `json:"name" binding:"required"`
 	Phone    string `json:"phone" binding:"required"`
-	Role     string `json:"role" binding:"required,oneof=customer restaurant courier"`
 }
 
 type RegisterResponse struct {
@@ -30,10 +31,49 @@ type LoginResponse struct {
 	User User `json:"user"`
 }
 
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// EmailRequest - used to ask for a verification or password reset link
+type EmailRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required,min=6"`
+}
+
+type Session struct {
+	ID         string `json:"id"`
+	UserAgent  string `json:"user_agent"`
+	IPAddress  string `json:"ip_address"`
+	CreatedAt  string `json:"created_at"`
+	LastUsedAt string `json:"last_used_at"`
+	Current    bool   `json:"current"`
+}
+
+type ListSessionsResponse struct {
+	Sessions []*Session `json:"sessions"`
+}
+
+type RevokeSessionResponse struct {
+	Revoked int32 `json:"revoked"`
+}
+
 type GetUserResponse struct {
 	User *User `json:"user"`
 }
 
+type SetUserRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=customer restaurant courier admin"`
+}
+
+type SetUserRoleResponse struct {
+	User *User `json:"user"`
+}
+
 type Address struct {
 	ID         string  `json:"id"`
 	UserID     string  `json:"user_id"`
@@ -60,5 +100,6 @@ type AddAddressResponse struct {
 }
 
 type GetAddressesResponse struct {
-	Addresses []*Address `json:"addresses"`
+	Addresses     []*Address `json:"addresses"`
+	NextPageToken string     `json:"next_page_token"`
 }
@@ -26,6 +26,9 @@ require (
 	github.com/joho/godotenv v1.5.1
 	github.com/json-iterator/go v1.1.12 // indirect
 	github.com/kimashii-dan/food-delivery-app/backend/pkg v0.0.0
+	github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service v0.0.0
+	github.com/kimashii-dan/food-delivery-app/backend/services/order-service v0.0.0
+	github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service v0.0.0
 	github.com/kimashii-dan/food-delivery-app/backend/services/user-service v0.0.0
 	github.com/klauspost/cpuid/v2 v2.3.0 // indirect
 	github.com/leodido/go-urn v1.4.0 // indirect
@@ -53,3 +56,9 @@ require (
 replace github.com/kimashii-dan/food-delivery-app/backend/pkg => ../pkg
 
 replace github.com/kimashii-dan/food-delivery-app/backend/services/user-service => ../services/user-service
+
+replace github.com/kimashii-dan/food-delivery-app/backend/services/order-service => ../services/order-service
+
+replace github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service => ../services/delivery-service
+
+replace github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service => ../services/restaurant-service
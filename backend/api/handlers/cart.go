@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kimashii-dan/food-delivery-app/backend/api/domain"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/pb"
+)
+
+type CartHandler struct {
+	orderClient pb.OrderServiceClient
+}
+
+func NewCartHandler(orderClient pb.OrderServiceClient) *CartHandler {
+	return &CartHandler{
+		orderClient: orderClient,
+	}
+}
+
+func (h *CartHandler) GetCart(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	grpcResp, err := h.orderClient.GetCart(c.Request.Context(), &pb.GetCartRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.CartResponse{
+		Cart: toDomainCart(grpcResp.Cart),
+	})
+}
+
+func (h *CartHandler) AddCartItem(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	var req domain.AddCartItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.AddCartItemRequest{
+		UserId:       userID,
+		RestaurantId: req.RestaurantID,
+		MenuItemId:   req.MenuItemID,
+		OptionIds:    req.OptionIDs,
+		Quantity:     req.Quantity,
+		Replace:      req.Replace,
+	}
+
+	grpcResp, err := h.orderClient.AddCartItem(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.CartResponse{
+		Cart: toDomainCart(grpcResp.Cart),
+	})
+}
+
+func (h *CartHandler) UpdateCartItem(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	itemID := c.Param("id")
+	if itemID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "cart item id is required"})
+		return
+	}
+
+	var req domain.UpdateCartItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.UpdateCartItemRequest{
+		UserId:      userID,
+		CartItemId:  itemID,
+		Quantity:    req.Quantity,
+		AcceptPrice: req.AcceptPrice,
+	}
+
+	grpcResp, err := h.orderClient.UpdateCartItem(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.CartResponse{
+		Cart: toDomainCart(grpcResp.Cart),
+	})
+}
+
+func (h *CartHandler) RemoveCartItem(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	itemID := c.Param("id")
+	if itemID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "cart item id is required"})
+		return
+	}
+
+	grpcReq := &pb.RemoveCartItemRequest{
+		UserId:     userID,
+		CartItemId: itemID,
+	}
+
+	grpcResp, err := h.orderClient.RemoveCartItem(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.CartResponse{
+		Cart: toDomainCart(grpcResp.Cart),
+	})
+}
+
+func (h *CartHandler) ClearCart(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	grpcResp, err := h.orderClient.ClearCart(c.Request.Context(), &pb.ClearCartRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.CartResponse{
+		Cart: toDomainCart(grpcResp.Cart),
+	})
+}
+
+func toDomainCart(cart *pb.Cart) *domain.Cart {
+	items := make([]*domain.CartItem, len(cart.Items))
+	for i, item := range cart.Items {
+		optionIDs := item.OptionIds
+		if optionIDs == nil {
+			optionIDs = []string{}
+		}
+
+		items[i] = &domain.CartItem{
+			ID:             item.Id,
+			MenuItemID:     item.MenuItemId,
+			Name:           item.Name,
+			OptionIDs:      optionIDs,
+			Options:        toDomainOrderItemOptions(item.Options),
+			Quantity:       item.Quantity,
+			UnitPrice:      item.UnitPrice,
+			AddedUnitPrice: item.AddedUnitPrice,
+			LinePrice:      item.LinePrice,
+			Valid:          item.Valid,
+			Errors:         item.Errors,
+		}
+	}
+
+	warnings := make([]*domain.CartWarning, len(cart.Warnings))
+	for i, warning := range cart.Warnings {
+		warnings[i] = &domain.CartWarning{
+			CartItemID: warning.CartItemId,
+			Code:       warning.Code,
+			Message:    warning.Message,
+		}
+	}
+
+	return &domain.Cart{
+		UserID:       cart.UserId,
+		RestaurantID: cart.RestaurantId,
+		Items:        items,
+		Subtotal:     cart.Subtotal,
+		Valid:        cart.Valid,
+		Warnings:     warnings,
+		UpdatedAt:    cart.UpdatedAt,
+	}
+}
@@ -0,0 +1,372 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kimashii-dan/food-delivery-app/backend/api/domain"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service/pb"
+)
+
+type DeliveryHandler struct {
+	deliveryClient pb.DeliveryServiceClient
+}
+
+func NewDeliveryHandler(deliveryClient pb.DeliveryServiceClient) *DeliveryHandler {
+	return &DeliveryHandler{
+		deliveryClient: deliveryClient,
+	}
+}
+
+func (h *DeliveryHandler) GetDelivery(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	deliveryID := c.Param("id")
+	if deliveryID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "delivery id is required"})
+		return
+	}
+
+	grpcReq := &pb.GetDeliveryRequest{
+		DeliveryId: deliveryID,
+	}
+
+	grpcResp, err := h.deliveryClient.GetDelivery(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	// Only the customer and the assigned courier may see a delivery
+	d := grpcResp.Delivery
+	if d.CustomerId != userID && d.CourierId != userID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "delivery not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.GetDeliveryResponse{
+		Delivery: toDomainDelivery(d),
+	})
+}
+
+// AssignCourier hands a delivery to the courier in the body, for dispatchers
+func (h *DeliveryHandler) AssignCourier(c *gin.Context) {
+	deliveryID := c.Param("id")
+	if deliveryID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "delivery id is required"})
+		return
+	}
+
+	var req domain.AssignCourierRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.AssignCourierRequest{
+		DeliveryId: deliveryID,
+		CourierId:  req.CourierID,
+	}
+
+	grpcResp, err := h.deliveryClient.AssignCourier(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.AssignCourierResponse{
+		Delivery: toDomainDelivery(grpcResp.Delivery),
+	})
+}
+
+func (h *DeliveryHandler) UpdateDeliveryStatus(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	deliveryID := c.Param("id")
+	if deliveryID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "delivery id is required"})
+		return
+	}
+
+	var req domain.UpdateDeliveryStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.UpdateDeliveryStatusRequest{
+		DeliveryId: deliveryID,
+		CourierId:  userID,
+		Status:     req.Status,
+	}
+
+	grpcResp, err := h.deliveryClient.UpdateDeliveryStatus(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.UpdateDeliveryStatusResponse{
+		Delivery: toDomainDelivery(grpcResp.Delivery),
+	})
+}
+
+func (h *DeliveryHandler) SetCourierAvailability(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	var req domain.SetCourierAvailabilityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if (req.Latitude == nil) != (req.Longitude == nil) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "latitude and longitude go together"})
+		return
+	}
+	if *req.IsAvailable && req.Latitude == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "latitude and longitude are required to go on shift"})
+		return
+	}
+
+	grpcReq := &pb.SetCourierAvailabilityRequest{
+		CourierId:   userID,
+		IsAvailable: *req.IsAvailable,
+		Latitude:    req.Latitude,
+		Longitude:   req.Longitude,
+	}
+
+	grpcResp, err := h.deliveryClient.SetCourierAvailability(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	courier := grpcResp.Courier
+	c.JSON(http.StatusOK, domain.SetCourierAvailabilityResponse{
+		Courier: &domain.Courier{
+			CourierID:   courier.CourierId,
+			IsAvailable: courier.IsAvailable,
+			Latitude:    courier.Latitude,
+			Longitude:   courier.Longitude,
+			UpdatedAt:   courier.UpdatedAt,
+		},
+	})
+}
+
+func (h *DeliveryHandler) GetCourierOffer(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	grpcReq := &pb.GetCourierOfferRequest{
+		CourierId: userID,
+	}
+
+	grpcResp, err := h.deliveryClient.GetCourierOffer(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	var offer *domain.DeliveryOffer
+	if grpcResp.Offer != nil {
+		offer = toDomainOffer(grpcResp.Offer)
+	}
+
+	c.JSON(http.StatusOK, domain.GetCourierOfferResponse{
+		Offer: offer,
+	})
+}
+
+func (h *DeliveryHandler) AcceptOffer(c *gin.Context) {
+	h.respondToOffer(c, true)
+}
+
+func (h *DeliveryHandler) DeclineOffer(c *gin.Context) {
+	h.respondToOffer(c, false)
+}
+
+func (h *DeliveryHandler) respondToOffer(c *gin.Context, accept bool) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	offerID := c.Param("id")
+	if offerID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "offer id is required"})
+		return
+	}
+
+	grpcReq := &pb.RespondToOfferRequest{
+		OfferId:   offerID,
+		CourierId: userID,
+		Accept:    accept,
+	}
+
+	grpcResp, err := h.deliveryClient.RespondToOffer(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.RespondToOfferResponse{
+		Offer: toDomainOffer(grpcResp.Offer),
+	})
+}
+
+// WatchDelivery streams the delivery's status and its courier's position to
+// the browser as Server-Sent Events until the delivery is done.
+func (h *DeliveryHandler) WatchDelivery(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	deliveryID := c.Param("id")
+	if deliveryID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "delivery id is required"})
+		return
+	}
+
+	grpcResp, err := h.deliveryClient.GetDelivery(c.Request.Context(), &pb.GetDeliveryRequest{
+		DeliveryId: deliveryID,
+	})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	d := grpcResp.Delivery
+	if d.CustomerId != userID && d.CourierId != userID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "delivery not found"})
+		return
+	}
+
+	// The stream is cancelled together with the request when the browser goes away
+	stream, err := h.deliveryClient.WatchDelivery(c.Request.Context(), &pb.WatchDeliveryRequest{
+		DeliveryId: deliveryID,
+	})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	c.Stream(func(w io.Writer) bool {
+		event, err := stream.Recv()
+		if err != nil {
+			if err != io.EOF && c.Request.Context().Err() == nil {
+				c.SSEvent("error", gin.H{"error": errorMessage(err)})
+			}
+			return false
+		}
+
+		c.SSEvent("delivery", domain.DeliveryEvent{
+			DeliveryID:       event.DeliveryId,
+			Status:           event.Status,
+			CourierID:        event.CourierId,
+			HasLocation:      event.HasLocation,
+			CourierLatitude:  event.CourierLatitude,
+			CourierLongitude: event.CourierLongitude,
+			CreatedAt:        event.CreatedAt,
+		})
+		return true
+	})
+}
+
+// UpdateLocation forwards a batch of GPS pings to delivery-service over a
+// single client stream.
+func (h *DeliveryHandler) UpdateLocation(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	var req domain.UpdateLocationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	stream, err := h.deliveryClient.UpdateLocation(c.Request.Context())
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	for _, ping := range req.Pings {
+		err := stream.Send(&pb.LocationUpdate{
+			CourierId: userID,
+			Latitude:  ping.Latitude,
+			Longitude: ping.Longitude,
+		})
+		// The server closed the stream early; its error comes with CloseAndRecv
+		if err != nil {
+			break
+		}
+	}
+
+	grpcResp, err := stream.CloseAndRecv()
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.UpdateLocationResponse{
+		Accepted: grpcResp.Accepted,
+	})
+}
+
+func toDomainDelivery(d *pb.Delivery) *domain.Delivery {
+	return &domain.Delivery{
+		ID:               d.Id,
+		OrderID:          d.OrderId,
+		CustomerID:       d.CustomerId,
+		RestaurantID:     d.RestaurantId,
+		CourierID:        d.CourierId,
+		Status:           d.Status,
+		PickupLatitude:   d.PickupLatitude,
+		PickupLongitude:  d.PickupLongitude,
+		DropoffLatitude:  d.DropoffLatitude,
+		DropoffLongitude: d.DropoffLongitude,
+		CreatedAt:        d.CreatedAt,
+		UpdatedAt:        d.UpdatedAt,
+	}
+}
+
+func toDomainOffer(o *pb.DeliveryOffer) *domain.DeliveryOffer {
+	return &domain.DeliveryOffer{
+		ID:               o.Id,
+		DeliveryID:       o.DeliveryId,
+		CourierID:        o.CourierId,
+		Status:           o.Status,
+		DistanceKm:       o.DistanceKm,
+		TripDistanceKm:   o.TripDistanceKm,
+		ExpiresInSeconds: o.ExpiresInSeconds,
+		Delivery:         toDomainDelivery(o.Delivery),
+		CreatedAt:        o.CreatedAt,
+	}
+}
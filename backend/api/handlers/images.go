@@ -0,0 +1,282 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kimashii-dan/food-delivery-app/backend/api/domain"
+	"github.com/kimashii-dan/food-delivery-app/backend/api/images"
+	"github.com/kimashii-dan/food-delivery-app/backend/api/middleware"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
+)
+
+// UploadRestaurantLogo takes an image as the multipart "image" field, stores
+// it with its thumbnails and makes it the restaurant's logo.
+func (h *RestaurantHandler) UploadRestaurantLogo(c *gin.Context) {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant id is required"})
+		return
+	}
+
+	current, err := h.restaurantClient.GetRestaurant(c.Request.Context(), &pb.GetRestaurantRequest{Id: id})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	// Nothing is stored for actors the update would turn away anyway
+	if !managesRestaurant(actor, current.Restaurant) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "you do not own this restaurant"})
+		return
+	}
+
+	variants, ok := readImage(c)
+	if !ok {
+		return
+	}
+
+	prefix := fmt.Sprintf("restaurants/%s/logo", id)
+	uploaded, keys, err := h.storeImage(c.Request.Context(), prefix, variants)
+	if err != nil {
+		log.Printf("failed to store logo for restaurant %s: %v", id, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to store image"})
+		return
+	}
+
+	grpcReq := &pb.UpdateRestaurantRequest{
+		Actor:   actor,
+		Id:      id,
+		LogoUrl: &uploaded.URL,
+	}
+
+	grpcResp, err := h.restaurantClient.UpdateRestaurant(c.Request.Context(), grpcReq)
+	if err != nil {
+		h.deleteKeys(keys)
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	h.deleteImage(current.Restaurant.GetLogoUrl(), prefix)
+
+	c.JSON(http.StatusOK, domain.UploadRestaurantLogoResponse{
+		Restaurant: toDomainRestaurant(grpcResp.Restaurant),
+		Image:      uploaded,
+	})
+}
+
+// UploadMenuItemImage takes an image as the multipart "image" field, stores
+// it with its thumbnails and makes it the menu item's image.
+func (h *RestaurantHandler) UploadMenuItemImage(c *gin.Context) {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "menu item id is required"})
+		return
+	}
+
+	current, err := h.restaurantClient.GetMenuItem(c.Request.Context(), &pb.GetMenuItemRequest{Id: id})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	restaurant, err := h.restaurantClient.GetRestaurant(c.Request.Context(), &pb.GetRestaurantRequest{Id: current.Item.GetRestaurantId()})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	if !managesRestaurant(actor, restaurant.Restaurant) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "you do not own this restaurant"})
+		return
+	}
+
+	variants, ok := readImage(c)
+	if !ok {
+		return
+	}
+
+	prefix := fmt.Sprintf("restaurants/%s/items/%s", current.Item.GetRestaurantId(), id)
+	uploaded, keys, err := h.storeImage(c.Request.Context(), prefix, variants)
+	if err != nil {
+		log.Printf("failed to store image for menu item %s: %v", id, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to store image"})
+		return
+	}
+
+	grpcReq := &pb.UpdateMenuItemRequest{
+		Actor:    actor,
+		Id:       id,
+		ImageUrl: &uploaded.URL,
+	}
+
+	grpcResp, err := h.restaurantClient.UpdateMenuItem(c.Request.Context(), grpcReq)
+	if err != nil {
+		h.deleteKeys(keys)
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	h.deleteImage(current.Item.GetImageUrl(), prefix)
+
+	c.JSON(http.StatusOK, domain.UploadMenuItemImageResponse{
+		Item:  toDomainMenuItem(grpcResp.Item),
+		Image: uploaded,
+	})
+}
+
+// managesRestaurant mirrors the ownership check restaurant-service makes on
+// updates, so uploads can be turned away before anything is stored.
+func managesRestaurant(actor *pb.Actor, restaurant *pb.Restaurant) bool {
+	if actor.Role == middleware.RoleAdmin {
+		return true
+	}
+	return actor.Role == middleware.RoleRestaurant && restaurant.GetOwnerUserId() == actor.UserId
+}
+
+// readImage reads and processes the uploaded image, writing the error
+// response itself when the upload is unusable.
+func readImage(c *gin.Context) ([]*images.Variant, bool) {
+	// Leave some room for the rest of the multipart body
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, images.MaxFileSize+1<<20)
+
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": images.ErrTooLarge.Error()})
+			return nil, false
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "image file is required"})
+		return nil, false
+	}
+
+	if fileHeader.Size > images.MaxFileSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": images.ErrTooLarge.Error()})
+		return nil, false
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read image"})
+		return nil, false
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read image"})
+		return nil, false
+	}
+
+	variants, err := images.Process(data)
+	switch {
+	case errors.Is(err, images.ErrTooLarge):
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+		return nil, false
+	case errors.Is(err, images.ErrUnsupportedType):
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		return nil, false
+	case errors.Is(err, images.ErrTooManyPixels):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, false
+	case err != nil:
+		log.Printf("failed to process image: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to process image"})
+		return nil, false
+	}
+
+	return variants, true
+}
+
+// storeImage puts every variant in storage under a fresh random name. The
+// image itself is stored as "<prefix>-<random>.<ext>" and each thumbnail adds
+// its size name, e.g. "<prefix>-<random>-thumb.<ext>". It returns the stored
+// keys so they can be removed again.
+func (h *RestaurantHandler) storeImage(ctx context.Context, prefix string, variants []*images.Variant) (*domain.UploadedImage, []string, error) {
+	name := make([]byte, 8)
+	if _, err := rand.Read(name); err != nil {
+		return nil, nil, err
+	}
+	base := prefix + "-" + hex.EncodeToString(name)
+
+	uploaded := &domain.UploadedImage{
+		Thumbnails: make(map[string]string),
+	}
+	var keys []string
+
+	for i, variant := range variants {
+		key := variantKey(base, variant.Name, variant.Ext, i == 0)
+
+		url, err := h.store.Put(ctx, key, variant.ContentType, variant.Data)
+		if err != nil {
+			h.deleteKeys(keys)
+			return nil, nil, err
+		}
+		keys = append(keys, key)
+
+		if i == 0 {
+			uploaded.URL = url
+		} else {
+			uploaded.Thumbnails[variant.Name] = url
+		}
+	}
+
+	return uploaded, keys, nil
+}
+
+// deleteImage removes an image replaced by a new upload, along with its
+// thumbnails. Only images stored under the given prefix by storeImage are
+// removed, so a URL pointing elsewhere, even at another restaurant's files,
+// is left alone.
+func (h *RestaurantHandler) deleteImage(url, prefix string) {
+	key, ok := h.store.KeyFromURL(url)
+	if !ok || !strings.HasPrefix(key, prefix+"-") || path.Clean(key) != key {
+		return
+	}
+
+	ext := path.Ext(key)
+	base := strings.TrimSuffix(key, ext)
+
+	keys := []string{key}
+	for _, size := range images.Sizes[1:] {
+		keys = append(keys, variantKey(base, size.Name, strings.TrimPrefix(ext, "."), false))
+	}
+
+	h.deleteKeys(keys)
+}
+
+// deleteKeys is best effort, a leftover file only wastes space
+func (h *RestaurantHandler) deleteKeys(keys []string) {
+	for _, key := range keys {
+		if err := h.store.Delete(context.Background(), key); err != nil {
+			log.Printf("failed to delete %s: %v", key, err)
+		}
+	}
+}
+
+func variantKey(base, name, ext string, original bool) string {
+	if original {
+		return base + "." + ext
+	}
+	return base + "-" + name + "." + ext
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/api/storage"
+)
+
+// recordingStore is local storage that remembers what was deleted
+type recordingStore struct {
+	storage.Storage
+	deleted []string
+}
+
+func (s *recordingStore) Delete(ctx context.Context, key string) error {
+	s.deleted = append(s.deleted, key)
+	return nil
+}
+
+func TestDeleteImage(t *testing.T) {
+	const base = "http://localhost:8080/uploads"
+	const prefix = "restaurants/r1/logo"
+
+	tests := []struct {
+		name string
+		url  string
+		want []string
+	}{
+		{
+			name: "own image and its thumbnails",
+			url:  base + "/restaurants/r1/logo-0a1b.jpg",
+			want: []string{
+				"restaurants/r1/logo-0a1b.jpg",
+				"restaurants/r1/logo-0a1b-medium.jpg",
+				"restaurants/r1/logo-0a1b-thumb.jpg",
+			},
+		},
+		{name: "another restaurant's logo", url: base + "/restaurants/r2/logo-0a1b.jpg"},
+		{name: "another restaurant sharing the id prefix", url: base + "/restaurants/r10/logo-0a1b.jpg"},
+		{name: "a menu item image of the same restaurant", url: base + "/restaurants/r1/items/i1-0a1b.jpg"},
+		{name: "the prefix without a name", url: base + "/restaurants/r1/logo.jpg"},
+		{name: "climbing out of the prefix", url: base + "/restaurants/r1/logo-x/../../r2/logo-0a1b.jpg"},
+		{name: "hosted elsewhere", url: "https://images.example.com/restaurants/r1/logo-0a1b.jpg"},
+		{name: "no image", url: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			local, err := storage.NewLocalStorage(t.TempDir(), base)
+			if err != nil {
+				t.Fatal(err)
+			}
+			store := &recordingStore{Storage: local}
+			h := NewRestaurantHandler(nil, store)
+
+			h.deleteImage(tt.url, prefix)
+
+			if !slices.Equal(store.deleted, tt.want) {
+				t.Errorf("deleted %v, want %v", store.deleted, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kimashii-dan/food-delivery-app/backend/pkg"
+)
+
+// JWKSHandler publishes the keys access tokens are verified with
+type JWKSHandler struct {
+	jwtService *pkg.JWTService
+}
+
+func NewJWKSHandler(jwtService *pkg.JWTService) *JWKSHandler {
+	return &JWKSHandler{
+		jwtService: jwtService,
+	}
+}
+
+// GetJWKS is cached briefly so a key added for rotation reaches clients
+// well before tokens are signed with it
+func (h *JWKSHandler) GetJWKS(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.jwtService.JWKS())
+}
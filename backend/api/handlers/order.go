@@ -1 +1,430 @@
 package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kimashii-dan/food-delivery-app/backend/api/domain"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/pb"
+)
+
+type OrderHandler struct {
+	orderClient pb.OrderServiceClient
+}
+
+func NewOrderHandler(orderClient pb.OrderServiceClient) *OrderHandler {
+	return &OrderHandler{
+		orderClient: orderClient,
+	}
+}
+
+func (h *OrderHandler) CreateOrder(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	var req domain.CreateOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.CreateOrderRequest{
+		UserId:            userID,
+		RestaurantId:      req.RestaurantID,
+		DeliveryAddressId: req.DeliveryAddressID,
+		Items:             toPbOrderItemInputs(req.Items),
+		QuoteId:           req.QuoteID,
+		PaymentMethod:     req.PaymentMethod,
+		PromoCodes:        req.PromoCodes,
+	}
+
+	grpcResp, err := h.orderClient.CreateOrder(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	if rejection := grpcResp.Rejection; rejection != nil {
+		if rejection.Reason == "payment_declined" {
+			c.JSON(http.StatusPaymentRequired, domain.OrderRejectionResponse{
+				Error:            "payment declined",
+				Reason:           rejection.Reason,
+				UnavailableItems: rejection.UnavailableItems,
+				Errors:           rejection.Errors,
+			})
+			return
+		}
+
+		if rejection.Reason == "invalid_promo_code" {
+			c.JSON(http.StatusConflict, domain.OrderRejectionResponse{
+				Error:            "promo code can't be applied",
+				Reason:           rejection.Reason,
+				UnavailableItems: rejection.UnavailableItems,
+				PromoRejections:  toDomainPromoRejections(rejection.PromoRejections),
+			})
+			return
+		}
+
+		c.JSON(http.StatusConflict, domain.OrderRejectionResponse{
+			Error:            "order rejected by restaurant",
+			Reason:           rejection.Reason,
+			UnavailableItems: rejection.UnavailableItems,
+			Errors:           rejection.Errors,
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, domain.CreateOrderResponse{
+		Order: toDomainOrder(grpcResp.Order),
+	})
+}
+
+func (h *OrderHandler) Quote(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	var req domain.QuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.QuoteRequest{
+		UserId:            userID,
+		RestaurantId:      req.RestaurantID,
+		DeliveryAddressId: req.DeliveryAddressID,
+		Items:             toPbOrderItemInputs(req.Items),
+		PromoCodes:        req.PromoCodes,
+	}
+
+	grpcResp, err := h.orderClient.Quote(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	if rejection := grpcResp.Rejection; rejection != nil {
+		c.JSON(http.StatusConflict, domain.OrderRejectionResponse{
+			Error:            "order rejected by restaurant",
+			Reason:           rejection.Reason,
+			UnavailableItems: rejection.UnavailableItems,
+			Errors:           rejection.Errors,
+		})
+		return
+	}
+
+	quote := grpcResp.Quote
+	lines := make([]*domain.QuoteLine, len(quote.Lines))
+	for i, line := range quote.Lines {
+		optionIDs := line.OptionIds
+		if optionIDs == nil {
+			optionIDs = []string{}
+		}
+
+		lines[i] = &domain.QuoteLine{
+			MenuItemID: line.MenuItemId,
+			Name:       line.Name,
+			OptionIDs:  optionIDs,
+			Options:    toDomainOrderItemOptions(line.Options),
+			Quantity:   line.Quantity,
+			UnitPrice:  line.UnitPrice,
+			LinePrice:  line.LinePrice,
+		}
+	}
+
+	c.JSON(http.StatusOK, domain.QuoteResponse{
+		Quote: &domain.Quote{
+			ID:                quote.Id,
+			RestaurantID:      quote.RestaurantId,
+			DeliveryAddressID: quote.DeliveryAddressId,
+			Lines:             lines,
+			Price:             toDomainPriceBreakdown(quote.Price),
+			Promos:            toDomainAppliedPromos(quote.Promos),
+			PromoRejections:   toDomainPromoRejections(quote.PromoRejections),
+			DistanceKm:        quote.DistanceKm,
+			ExpiresAt:         quote.ExpiresAt,
+		},
+	})
+}
+
+func (h *OrderHandler) GetOrder(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	orderID := c.Param("id")
+	if orderID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "order id is required"})
+		return
+	}
+
+	grpcReq := &pb.GetOrderRequest{
+		OrderId: orderID,
+		UserId:  userID,
+	}
+
+	grpcResp, err := h.orderClient.GetOrder(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.GetOrderResponse{
+		Order:   toDomainOrder(grpcResp.Order),
+		History: toDomainStatusHistory(grpcResp.History),
+	})
+}
+
+func (h *OrderHandler) ListOrders(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	pageSize, pageToken, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	grpcReq := &pb.ListOrdersForUserRequest{
+		UserId:    userID,
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	}
+
+	grpcResp, err := h.orderClient.ListOrdersForUser(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	orders := make([]*domain.Order, len(grpcResp.Orders))
+	for i, order := range grpcResp.Orders {
+		orders[i] = toDomainOrder(order)
+	}
+
+	c.JSON(http.StatusOK, domain.ListOrdersResponse{
+		Orders:        orders,
+		NextPageToken: grpcResp.NextPageToken,
+	})
+}
+
+func (h *OrderHandler) CancelOrder(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	orderID := c.Param("id")
+	if orderID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "order id is required"})
+		return
+	}
+
+	grpcReq := &pb.CancelOrderRequest{
+		OrderId: orderID,
+		UserId:  userID,
+	}
+
+	grpcResp, err := h.orderClient.CancelOrder(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.CancelOrderResponse{
+		Order: toDomainOrder(grpcResp.Order),
+	})
+}
+
+func (h *OrderHandler) UpdateOrderStatus(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	orderID := c.Param("id")
+	if orderID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "order id is required"})
+		return
+	}
+
+	var req domain.UpdateOrderStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.UpdateOrderStatusRequest{
+		OrderId:     orderID,
+		Status:      req.Status,
+		ActorUserId: userID,
+		ActorRole:   c.GetString("user_role"),
+	}
+
+	grpcResp, err := h.orderClient.UpdateOrderStatus(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.UpdateOrderStatusResponse{
+		Order: toDomainOrder(grpcResp.Order),
+	})
+}
+
+// ListRestaurantOrders lists the orders of the restaurant in the path for
+// its owner; order-service checks the ownership
+func (h *OrderHandler) ListRestaurantOrders(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	pageSize, pageToken, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	grpcResp, err := h.orderClient.ListRestaurantOrders(c.Request.Context(), &pb.ListRestaurantOrdersRequest{
+		RestaurantId: c.Param("id"),
+		ActorUserId:  userID,
+		ActorRole:    c.GetString("user_role"),
+		PageSize:     pageSize,
+		PageToken:    pageToken,
+	})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	orders := make([]*domain.Order, len(grpcResp.Orders))
+	for i, order := range grpcResp.Orders {
+		orders[i] = toDomainOrder(order)
+	}
+
+	c.JSON(http.StatusOK, domain.ListOrdersResponse{
+		Orders:        orders,
+		NextPageToken: grpcResp.NextPageToken,
+	})
+}
+
+func (h *OrderHandler) GetRestaurantOrder(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	grpcResp, err := h.orderClient.GetRestaurantOrder(c.Request.Context(), &pb.GetRestaurantOrderRequest{
+		RestaurantId: c.Param("id"),
+		OrderId:      c.Param("order_id"),
+		ActorUserId:  userID,
+		ActorRole:    c.GetString("user_role"),
+	})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.GetOrderResponse{
+		Order:   toDomainOrder(grpcResp.Order),
+		History: toDomainStatusHistory(grpcResp.History),
+	})
+}
+
+func toDomainOrder(order *pb.Order) *domain.Order {
+	items := make([]*domain.OrderItem, len(order.Items))
+	for i, item := range order.Items {
+		items[i] = &domain.OrderItem{
+			ID:         item.Id,
+			MenuItemID: item.MenuItemId,
+			Name:       item.Name,
+			Price:      item.Price,
+			Quantity:   item.Quantity,
+			Options:    toDomainOrderItemOptions(item.Options),
+		}
+	}
+
+	return &domain.Order{
+		ID:                order.Id,
+		UserID:            order.UserId,
+		RestaurantID:      order.RestaurantId,
+		DeliveryAddressID: order.DeliveryAddressId,
+		Status:            order.Status,
+		TotalPrice:        order.TotalPrice,
+		QuoteID:           order.QuoteId,
+		Price:             toDomainPriceBreakdown(order.Price),
+		PaymentStatus:     order.PaymentStatus,
+		Items:             items,
+		CreatedAt:         order.CreatedAt,
+		UpdatedAt:         order.UpdatedAt,
+	}
+}
+
+func toDomainOrderItemOptions(options []*pb.OrderItemOption) []*domain.OrderItemOption {
+	domainOptions := make([]*domain.OrderItemOption, len(options))
+	for i, option := range options {
+		domainOptions[i] = &domain.OrderItemOption{
+			GroupName:  option.GroupName,
+			Name:       option.Name,
+			PriceDelta: option.PriceDelta,
+		}
+	}
+	return domainOptions
+}
+
+func toDomainPriceBreakdown(price *pb.PriceBreakdown) *domain.PriceBreakdown {
+	if price == nil {
+		return nil
+	}
+
+	return &domain.PriceBreakdown{
+		Currency:      price.Currency,
+		Subtotal:      price.Subtotal,
+		DeliveryFee:   price.DeliveryFee,
+		SmallOrderFee: price.SmallOrderFee,
+		ServiceFee:    price.ServiceFee,
+		Discount:      price.Discount,
+		Tax:           price.Tax,
+		Total:         price.Total,
+	}
+}
+
+func toPbOrderItemInputs(items []*domain.OrderItemInput) []*pb.OrderItemInput {
+	pbItems := make([]*pb.OrderItemInput, len(items))
+	for i, item := range items {
+		pbItems[i] = &pb.OrderItemInput{
+			MenuItemId: item.MenuItemID,
+			Quantity:   item.Quantity,
+			OptionIds:  item.OptionIDs,
+		}
+	}
+	return pbItems
+}
+
+func toDomainStatusHistory(history []*pb.OrderStatusChange) []*domain.OrderStatusChange {
+	domainHistory := make([]*domain.OrderStatusChange, len(history))
+	for i, change := range history {
+		domainHistory[i] = &domain.OrderStatusChange{
+			FromStatus:  change.FromStatus,
+			ToStatus:    change.ToStatus,
+			ActorUserID: change.ActorUserId,
+			ActorRole:   change.ActorRole,
+			CreatedAt:   change.CreatedAt,
+		}
+	}
+	return domainHistory
+}
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// pageParams reads the page_size and page_token query parameters of list
+// endpoints. The services apply the default size and the cap; an unusable
+// page_size is answered with 400 here.
+func pageParams(c *gin.Context) (int32, string, bool) {
+	var pageSize int32
+	if sizeStr := c.Query("page_size"); sizeStr != "" {
+		size, err := strconv.ParseInt(sizeStr, 10, 32)
+		if err != nil || size < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "page_size must be a non-negative number"})
+			return 0, "", false
+		}
+		pageSize = int32(size)
+	}
+
+	return pageSize, c.Query("page_token"), true
+}
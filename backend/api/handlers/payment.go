@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/kimashii-dan/food-delivery-app/backend/api/domain"
+	"github.com/kimashii-dan/food-delivery-app/backend/pkg"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/pb"
+)
+
+// PaymentSignatureHeader carries the HMAC signature of a webhook, see pkg.SignWebhook
+const PaymentSignatureHeader = "X-Payment-Signature"
+
+type PaymentHandler struct {
+	orderClient   pb.OrderServiceClient
+	webhookSecret []byte
+}
+
+func NewPaymentHandler(orderClient pb.OrderServiceClient, webhookSecret string) *PaymentHandler {
+	return &PaymentHandler{
+		orderClient:   orderClient,
+		webhookSecret: []byte(webhookSecret),
+	}
+}
+
+// Webhook receives events from the payment provider. Only signed events are
+// passed on to order-service, which ignores events it has already handled.
+func (h *PaymentHandler) Webhook(c *gin.Context) {
+	body, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+		return
+	}
+
+	signature := c.GetHeader(PaymentSignatureHeader)
+	if err := pkg.VerifyWebhook(h.webhookSecret, signature, body, time.Now()); err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var event domain.PaymentWebhookEvent
+	if err := binding.JSON.BindBody(body, &event); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.HandlePaymentWebhookRequest{
+		EventId:       event.ID,
+		Type:          event.Type,
+		PaymentRef:    event.PaymentRef,
+		FailureReason: event.FailureReason,
+	}
+
+	grpcResp, err := h.orderClient.HandlePaymentWebhook(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.PaymentWebhookResponse{
+		OrderID:       grpcResp.OrderId,
+		PaymentStatus: grpcResp.PaymentStatus,
+		Duplicate:     grpcResp.Duplicate,
+	})
+}
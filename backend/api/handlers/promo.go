@@ -0,0 +1,232 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kimashii-dan/food-delivery-app/backend/api/domain"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/pb"
+)
+
+// PromoHandler serves the admin endpoints for promo codes; order-service
+// checks the role
+type PromoHandler struct {
+	orderClient pb.OrderServiceClient
+}
+
+func NewPromoHandler(orderClient pb.OrderServiceClient) *PromoHandler {
+	return &PromoHandler{
+		orderClient: orderClient,
+	}
+}
+
+func (h *PromoHandler) CreatePromoCode(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	var req domain.PromoCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.CreatePromoCodeRequest{
+		PromoCode:   toPbPromoCode(&req),
+		ActorUserId: userID,
+		ActorRole:   c.GetString("user_role"),
+	}
+
+	grpcResp, err := h.orderClient.CreatePromoCode(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusCreated, domain.PromoCodeResponse{
+		PromoCode: toDomainPromoCode(grpcResp.PromoCode),
+	})
+}
+
+func (h *PromoHandler) GetPromoCode(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	grpcResp, err := h.orderClient.GetPromoCode(c.Request.Context(), &pb.GetPromoCodeRequest{
+		Id:          c.Param("id"),
+		ActorUserId: userID,
+		ActorRole:   c.GetString("user_role"),
+	})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.PromoCodeResponse{
+		PromoCode: toDomainPromoCode(grpcResp.PromoCode),
+	})
+}
+
+func (h *PromoHandler) ListPromoCodes(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	pageSize, pageToken, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	grpcResp, err := h.orderClient.ListPromoCodes(c.Request.Context(), &pb.ListPromoCodesRequest{
+		PageSize:    pageSize,
+		PageToken:   pageToken,
+		ActorUserId: userID,
+		ActorRole:   c.GetString("user_role"),
+	})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	promos := make([]*domain.PromoCode, len(grpcResp.PromoCodes))
+	for i, promo := range grpcResp.PromoCodes {
+		promos[i] = toDomainPromoCode(promo)
+	}
+
+	c.JSON(http.StatusOK, domain.ListPromoCodesResponse{
+		PromoCodes:    promos,
+		NextPageToken: grpcResp.NextPageToken,
+	})
+}
+
+func (h *PromoHandler) UpdatePromoCode(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	var req domain.PromoCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.UpdatePromoCodeRequest{
+		Id:          c.Param("id"),
+		PromoCode:   toPbPromoCode(&req),
+		ActorUserId: userID,
+		ActorRole:   c.GetString("user_role"),
+	}
+
+	grpcResp, err := h.orderClient.UpdatePromoCode(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.PromoCodeResponse{
+		PromoCode: toDomainPromoCode(grpcResp.PromoCode),
+	})
+}
+
+func (h *PromoHandler) DeletePromoCode(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	_, err := h.orderClient.DeletePromoCode(c.Request.Context(), &pb.DeletePromoCodeRequest{
+		Id:          c.Param("id"),
+		ActorUserId: userID,
+		ActorRole:   c.GetString("user_role"),
+	})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "promo code deleted"})
+}
+
+func toPbPromoCode(req *domain.PromoCodeRequest) *pb.PromoCode {
+	return &pb.PromoCode{
+		Code:           req.Code,
+		Description:    req.Description,
+		Kind:           req.Kind,
+		PercentOff:     req.PercentOff,
+		AmountOff:      req.AmountOff,
+		MaxDiscount:    req.MaxDiscount,
+		MinSubtotal:    req.MinSubtotal,
+		FirstOrderOnly: req.FirstOrderOnly,
+		RestaurantIds:  req.RestaurantIDs,
+		StartsAt:       req.StartsAt,
+		EndsAt:         req.EndsAt,
+		MaxUses:        req.MaxUses,
+		MaxUsesPerUser: req.MaxUsesPerUser,
+		Stackable:      req.Stackable,
+		Active:         req.Active,
+	}
+}
+
+func toDomainPromoCode(promo *pb.PromoCode) *domain.PromoCode {
+	restaurantIDs := promo.RestaurantIds
+	if restaurantIDs == nil {
+		restaurantIDs = []string{}
+	}
+
+	return &domain.PromoCode{
+		ID:             promo.Id,
+		Code:           promo.Code,
+		Description:    promo.Description,
+		Kind:           promo.Kind,
+		PercentOff:     promo.PercentOff,
+		AmountOff:      promo.AmountOff,
+		MaxDiscount:    promo.MaxDiscount,
+		MinSubtotal:    promo.MinSubtotal,
+		FirstOrderOnly: promo.FirstOrderOnly,
+		RestaurantIDs:  restaurantIDs,
+		StartsAt:       promo.StartsAt,
+		EndsAt:         promo.EndsAt,
+		MaxUses:        promo.MaxUses,
+		MaxUsesPerUser: promo.MaxUsesPerUser,
+		Stackable:      promo.Stackable,
+		Active:         promo.Active,
+		TimesUsed:      promo.TimesUsed,
+		CreatedAt:      promo.CreatedAt,
+		UpdatedAt:      promo.UpdatedAt,
+	}
+}
+
+func toDomainAppliedPromos(promos []*pb.AppliedPromo) []*domain.AppliedPromo {
+	domainPromos := make([]*domain.AppliedPromo, len(promos))
+	for i, promo := range promos {
+		domainPromos[i] = &domain.AppliedPromo{
+			PromoID:  promo.PromoId,
+			Code:     promo.Code,
+			Kind:     promo.Kind,
+			Discount: promo.Discount,
+		}
+	}
+	return domainPromos
+}
+
+func toDomainPromoRejections(rejections []*pb.PromoRejection) []*domain.PromoRejection {
+	domainRejections := make([]*domain.PromoRejection, len(rejections))
+	for i, rejection := range rejections {
+		domainRejections[i] = &domain.PromoRejection{
+			Code:    rejection.Code,
+			Reason:  rejection.Reason,
+			Message: rejection.Message,
+		}
+	}
+	return domainRejections
+}
@@ -1,63 +1,87 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/kimashii-dan/food-delivery-app/backend/api/domain"
+	"github.com/kimashii-dan/food-delivery-app/backend/api/storage"
 	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
 )
 
 type RestaurantHandler struct {
 	restaurantClient pb.RestaurantServiceClient
+	store            storage.Storage
 }
 
-func NewRestaurantHandler(restaurantClient pb.RestaurantServiceClient) *RestaurantHandler {
+func NewRestaurantHandler(restaurantClient pb.RestaurantServiceClient, store storage.Storage) *RestaurantHandler {
 	return &RestaurantHandler{
 		restaurantClient: restaurantClient,
+		store:            store,
 	}
 }
 
 func (h *RestaurantHandler) GetRestaurants(c *gin.Context) {
-	page := int32(1)
-	if pageStr := c.Query("page"); pageStr != "" {
-		if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
-			page = int32(p)
-		}
+	pageSize, pageToken, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	// Filtering by a saved address needs to know whose address it is
+	addressID := c.Query("address_id")
+	userID := c.GetString("user_id")
+	if addressID != "" && userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "log in to filter by address"})
+		return
 	}
 
 	grpcReq := &pb.GetRestaurantsRequest{
-		Page: page,
+		AddressId: addressID,
+		UserId:    userID,
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	}
+
+	// lat and lng together switch to a nearest-first search
+	if c.Query("lat") != "" || c.Query("lng") != "" {
+		lat, latErr := strconv.ParseFloat(c.Query("lat"), 64)
+		lng, lngErr := strconv.ParseFloat(c.Query("lng"), 64)
+		if latErr != nil || lngErr != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "lat and lng must both be numbers"})
+			return
+		}
+		grpcReq.Origin = &pb.GeoPoint{Latitude: lat, Longitude: lng}
+
+		if radiusStr := c.Query("radius_km"); radiusStr != "" {
+			radius, err := strconv.ParseFloat(radiusStr, 64)
+			if err != nil || radius < 0 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "radius_km must be a positive number"})
+				return
+			}
+			grpcReq.RadiusKm = radius
+		}
 	}
 
 	grpcResp, err := h.restaurantClient.GetRestaurants(c.Request.Context(), grpcReq)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
 		return
 	}
 
 	restaurants := make([]*domain.Restaurant, len(grpcResp.Restaurants))
 	for i, r := range grpcResp.Restaurants {
-		restaurants[i] = &domain.Restaurant{
-			ID:          r.Id,
-			Name:        r.Name,
-			Description: r.Description,
-			Address:     r.Address,
-			Phone:       r.Phone,
-			Latitude:    r.Latitude,
-			Longitude:   r.Longitude,
-			LogoURL:     r.LogoUrl,
-			OpeningTime: r.OpeningTime,
-			ClosingTime: r.ClosingTime,
-			CreatedAt:   r.CreatedAt,
-			UpdatedAt:   r.UpdatedAt,
-		}
+		restaurants[i] = toDomainRestaurant(r)
 	}
 
 	c.JSON(http.StatusOK, domain.GetRestaurantsResponse{
-		Restaurants: restaurants,
-		Total:       grpcResp.Total,
+		Restaurants:   restaurants,
+		NextPageToken: grpcResp.NextPageToken,
 	})
 }
 
@@ -74,26 +98,12 @@ func (h *RestaurantHandler) GetRestaurant(c *gin.Context) {
 
 	grpcResp, err := h.restaurantClient.GetRestaurant(c.Request.Context(), grpcReq)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "restaurant not found"})
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
 		return
 	}
 
-	r := grpcResp.Restaurant
 	c.JSON(http.StatusOK, domain.GetRestaurantResponse{
-		Restaurant: &domain.Restaurant{
-			ID:          r.Id,
-			Name:        r.Name,
-			Description: r.Description,
-			Address:     r.Address,
-			Phone:       r.Phone,
-			Latitude:    r.Latitude,
-			Longitude:   r.Longitude,
-			LogoURL:     r.LogoUrl,
-			OpeningTime: r.OpeningTime,
-			ClosingTime: r.ClosingTime,
-			CreatedAt:   r.CreatedAt,
-			UpdatedAt:   r.UpdatedAt,
-		},
+		Restaurant: toDomainRestaurant(grpcResp.Restaurant),
 	})
 }
 
@@ -104,43 +114,39 @@ func (h *RestaurantHandler) GetMenu(c *gin.Context) {
 		return
 	}
 
-	page := int32(1)
-	if pageStr := c.Query("page"); pageStr != "" {
-		if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
-			page = int32(p)
-		}
+	pageSize, pageToken, ok := pageParams(c)
+	if !ok {
+		return
 	}
 
 	grpcReq := &pb.GetMenuRequest{
 		RestaurantId: restaurantID,
-		Page:         page,
+		Grouped:      c.Query("grouped") == "true",
+		PageSize:     pageSize,
+		PageToken:    pageToken,
 	}
 
 	grpcResp, err := h.restaurantClient.GetMenu(c.Request.Context(), grpcReq)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	if grpcReq.Grouped {
+		c.JSON(http.StatusOK, domain.GetMenuResponse{
+			Categories: toDomainMenuCategories(grpcResp.Categories),
+		})
 		return
 	}
 
 	items := make([]*domain.MenuItem, len(grpcResp.Items))
 	for i, item := range grpcResp.Items {
-		items[i] = &domain.MenuItem{
-			ID:           item.Id,
-			RestaurantID: item.RestaurantId,
-			Name:         item.Name,
-			Description:  item.Description,
-			Price:        item.Price,
-			ImageURL:     item.ImageUrl,
-			IsAvailable:  item.IsAvailable,
-			Category:     item.Category,
-			CreatedAt:    item.CreatedAt,
-			UpdatedAt:    item.UpdatedAt,
-		}
+		items[i] = toDomainMenuItem(item)
 	}
 
 	c.JSON(http.StatusOK, domain.GetMenuResponse{
-		Items: items,
-		Total: grpcResp.Total,
+		Items:         items,
+		NextPageToken: grpcResp.NextPageToken,
 	})
 }
 
@@ -161,20 +167,8 @@ func (h *RestaurantHandler) GetMenuItem(c *gin.Context) {
 		return
 	}
 
-	item := grpcResp.Item
 	c.JSON(http.StatusOK, domain.GetMenuItemResponse{
-		Item: &domain.MenuItem{
-			ID:           item.Id,
-			RestaurantID: item.RestaurantId,
-			Name:         item.Name,
-			Description:  item.Description,
-			Price:        item.Price,
-			ImageURL:     item.ImageUrl,
-			IsAvailable:  item.IsAvailable,
-			Category:     item.Category,
-			CreatedAt:    item.CreatedAt,
-			UpdatedAt:    item.UpdatedAt,
-		},
+		Item: toDomainMenuItem(grpcResp.Item),
 	})
 }
 
@@ -199,6 +193,11 @@ func (h *RestaurantHandler) GetRestaurantStatus(c *gin.Context) {
 		IsAcceptingOrders: grpcResp.IsAcceptingOrders,
 		OpeningTime:       grpcResp.OpeningTime,
 		ClosingTime:       grpcResp.ClosingTime,
+		Timezone:          grpcResp.Timezone,
+		IsPaused:          grpcResp.IsPaused,
+		IsOpen:            grpcResp.IsOpen,
+		NextOpenAt:        grpcResp.NextOpenAt,
+		NextCloseAt:       grpcResp.NextCloseAt,
 	})
 }
 
@@ -209,9 +208,24 @@ func (h *RestaurantHandler) ValidateMenuItems(c *gin.Context) {
 		return
 	}
 
+	if len(req.ItemIDs) == 0 && len(req.Lines) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "item_ids or lines are required"})
+		return
+	}
+
+	lines := make([]*pb.ItemSelection, len(req.Lines))
+	for i, line := range req.Lines {
+		lines[i] = &pb.ItemSelection{
+			MenuItemId: line.MenuItemID,
+			OptionIds:  line.OptionIDs,
+			Quantity:   line.Quantity,
+		}
+	}
+
 	grpcReq := &pb.ValidateMenuItemsRequest{
 		RestaurantId: req.RestaurantID,
 		ItemIds:      req.ItemIDs,
+		Lines:        lines,
 	}
 
 	grpcResp, err := h.restaurantClient.ValidateMenuItems(c.Request.Context(), grpcReq)
@@ -226,6 +240,31 @@ func (h *RestaurantHandler) ValidateMenuItems(c *gin.Context) {
 			ItemID:      item.ItemId,
 			IsAvailable: item.IsAvailable,
 			Name:        item.Name,
+			Price:       item.Price,
+		}
+	}
+
+	lineValidations := make([]*domain.LineValidation, len(grpcResp.Lines))
+	for i, line := range grpcResp.Lines {
+		options := make([]*domain.SelectedOption, len(line.Options))
+		for j, option := range line.Options {
+			options[j] = &domain.SelectedOption{
+				ID:         option.Id,
+				GroupName:  option.GroupName,
+				Name:       option.Name,
+				PriceDelta: option.PriceDelta,
+			}
+		}
+
+		lineValidations[i] = &domain.LineValidation{
+			MenuItemID: line.MenuItemId,
+			Valid:      line.Valid,
+			Name:       line.Name,
+			UnitPrice:  line.UnitPrice,
+			Quantity:   line.Quantity,
+			LinePrice:  line.LinePrice,
+			Options:    options,
+			Errors:     line.Errors,
 		}
 	}
 
@@ -233,5 +272,835 @@ func (h *RestaurantHandler) ValidateMenuItems(c *gin.Context) {
 		AllAvailable:     grpcResp.AllAvailable,
 		Items:            items,
 		UnavailableItems: grpcResp.UnavailableItems,
+		Lines:            lineValidations,
+		AllValid:         grpcResp.AllValid,
+	})
+}
+
+func (h *RestaurantHandler) CheckDeliverable(c *gin.Context) {
+	restaurantID := c.Param("id")
+	if restaurantID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant id is required"})
+		return
+	}
+
+	lat, latErr := strconv.ParseFloat(c.Query("lat"), 64)
+	lng, lngErr := strconv.ParseFloat(c.Query("lng"), 64)
+	if latErr != nil || lngErr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "lat and lng are required"})
+		return
+	}
+
+	grpcReq := &pb.CheckDeliverableRequest{
+		RestaurantId: restaurantID,
+		Latitude:     lat,
+		Longitude:    lng,
+	}
+
+	grpcResp, err := h.restaurantClient.CheckDeliverable(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.CheckDeliverableResponse{
+		Deliverable: grpcResp.Deliverable,
+		DistanceKm:  grpcResp.DistanceKm,
+	})
+}
+
+func (h *RestaurantHandler) Search(c *gin.Context) {
+	query := c.Query("q")
+	if query == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "search query is required"})
+		return
+	}
+
+	pageSize, pageToken, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	grpcReq := &pb.SearchRequest{
+		Query:     query,
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	}
+
+	grpcResp, err := h.restaurantClient.Search(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	results := make([]*domain.SearchResult, len(grpcResp.Results))
+	for i, result := range grpcResp.Results {
+		items := make([]*domain.MenuItem, len(result.Items))
+		for j, item := range result.Items {
+			items[j] = toDomainMenuItem(item)
+		}
+
+		results[i] = &domain.SearchResult{
+			Restaurant: toDomainRestaurant(result.Restaurant),
+			Items:      items,
+			Rank:       result.Rank,
+		}
+	}
+
+	c.JSON(http.StatusOK, domain.SearchResponse{
+		Results:       results,
+		NextPageToken: grpcResp.NextPageToken,
+	})
+}
+
+func (h *RestaurantHandler) CreateRestaurant(c *gin.Context) {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req domain.CreateRestaurantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.CreateRestaurantRequest{
+		Actor:            actor,
+		OwnerUserId:      req.OwnerUserID,
+		Name:             req.Name,
+		Description:      req.Description,
+		Address:          req.Address,
+		Phone:            req.Phone,
+		Latitude:         req.Latitude,
+		Longitude:        req.Longitude,
+		LogoUrl:          req.LogoURL,
+		OpeningTime:      req.OpeningTime,
+		ClosingTime:      req.ClosingTime,
+		DeliveryRadiusKm: req.DeliveryRadiusKm,
+		DeliveryPolygon:  toPbGeoPoints(req.DeliveryPolygon),
+		Timezone:         req.Timezone,
+	}
+
+	grpcResp, err := h.restaurantClient.CreateRestaurant(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusCreated, domain.CreateRestaurantResponse{
+		Restaurant: toDomainRestaurant(grpcResp.Restaurant),
+	})
+}
+
+func (h *RestaurantHandler) UpdateRestaurant(c *gin.Context) {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant id is required"})
+		return
+	}
+
+	var req domain.UpdateRestaurantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.UpdateRestaurantRequest{
+		Actor:            actor,
+		Id:               id,
+		Name:             req.Name,
+		Description:      req.Description,
+		Address:          req.Address,
+		Phone:            req.Phone,
+		Latitude:         req.Latitude,
+		Longitude:        req.Longitude,
+		LogoUrl:          req.LogoURL,
+		OpeningTime:      req.OpeningTime,
+		ClosingTime:      req.ClosingTime,
+		DeliveryRadiusKm: req.DeliveryRadiusKm,
+		Timezone:         req.Timezone,
+	}
+	if req.DeliveryPolygon != nil {
+		grpcReq.DeliveryPolygon = &pb.DeliveryPolygonUpdate{
+			Points: toPbGeoPoints(*req.DeliveryPolygon),
+		}
+	}
+
+	grpcResp, err := h.restaurantClient.UpdateRestaurant(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.UpdateRestaurantResponse{
+		Restaurant: toDomainRestaurant(grpcResp.Restaurant),
+	})
+}
+
+func (h *RestaurantHandler) CreateMenuItem(c *gin.Context) {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	restaurantID := c.Param("id")
+	if restaurantID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant id is required"})
+		return
+	}
+
+	var req domain.CreateMenuItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.CreateMenuItemRequest{
+		Actor:        actor,
+		RestaurantId: restaurantID,
+		Name:         req.Name,
+		Description:  req.Description,
+		Price:        req.Price,
+		ImageUrl:     req.ImageURL,
+		Category:     req.Category,
+		IsAvailable:  req.IsAvailable,
+		ExternalSku:  req.ExternalSKU,
+	}
+
+	grpcResp, err := h.restaurantClient.CreateMenuItem(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusCreated, domain.CreateMenuItemResponse{
+		Item: toDomainMenuItem(grpcResp.Item),
+	})
+}
+
+func (h *RestaurantHandler) UpdateMenuItem(c *gin.Context) {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "menu item id is required"})
+		return
+	}
+
+	var req domain.UpdateMenuItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.UpdateMenuItemRequest{
+		Actor:       actor,
+		Id:          id,
+		Name:        req.Name,
+		Description: req.Description,
+		Price:       req.Price,
+		ImageUrl:    req.ImageURL,
+		Category:    req.Category,
+		IsAvailable: req.IsAvailable,
+		ExternalSku: req.ExternalSKU,
+	}
+
+	grpcResp, err := h.restaurantClient.UpdateMenuItem(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.UpdateMenuItemResponse{
+		Item: toDomainMenuItem(grpcResp.Item),
+	})
+}
+
+func (h *RestaurantHandler) DeleteMenuItem(c *gin.Context) {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "menu item id is required"})
+		return
+	}
+
+	grpcReq := &pb.DeleteMenuItemRequest{
+		Actor: actor,
+		Id:    id,
+	}
+
+	if _, err := h.restaurantClient.DeleteMenuItem(c.Request.Context(), grpcReq); err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "menu item deleted"})
+}
+
+func (h *RestaurantHandler) SetItemAvailability(c *gin.Context) {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "menu item id is required"})
+		return
+	}
+
+	var req domain.SetItemAvailabilityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.SetItemAvailabilityRequest{
+		Actor:       actor,
+		Id:          id,
+		IsAvailable: *req.IsAvailable,
+	}
+
+	grpcResp, err := h.restaurantClient.SetItemAvailability(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.SetItemAvailabilityResponse{
+		Item: toDomainMenuItem(grpcResp.Item),
+	})
+}
+
+func (h *RestaurantHandler) SetMenuItemOptions(c *gin.Context) {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "menu item id is required"})
+		return
+	}
+
+	var req domain.SetMenuItemOptionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	groups := make([]*pb.OptionGroup, len(req.OptionGroups))
+	for i, group := range req.OptionGroups {
+		options := make([]*pb.MenuOption, len(group.Options))
+		for j, option := range group.Options {
+			options[j] = &pb.MenuOption{
+				Id:          option.ID,
+				Name:        option.Name,
+				PriceDelta:  option.PriceDelta,
+				IsAvailable: option.IsAvailable == nil || *option.IsAvailable,
+			}
+		}
+
+		groups[i] = &pb.OptionGroup{
+			Id:        group.ID,
+			Name:      group.Name,
+			MinSelect: group.MinSelect,
+			MaxSelect: group.MaxSelect,
+			Options:   options,
+		}
+	}
+
+	grpcReq := &pb.SetMenuItemOptionsRequest{
+		Actor:        actor,
+		MenuItemId:   id,
+		OptionGroups: groups,
+	}
+
+	grpcResp, err := h.restaurantClient.SetMenuItemOptions(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.SetMenuItemOptionsResponse{
+		Item: toDomainMenuItem(grpcResp.Item),
 	})
 }
+
+func (h *RestaurantHandler) GetOpeningHours(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant id is required"})
+		return
+	}
+
+	grpcReq := &pb.GetOpeningHoursRequest{
+		RestaurantId: id,
+	}
+
+	grpcResp, err := h.restaurantClient.GetOpeningHours(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.GetOpeningHoursResponse{
+		Hours: toDomainOpeningHours(grpcResp.Hours),
+	})
+}
+
+func (h *RestaurantHandler) SetOpeningHours(c *gin.Context) {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant id is required"})
+		return
+	}
+
+	var req domain.SetOpeningHoursRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	weekly := make([]*pb.WeeklyHours, len(req.Weekly))
+	for i, day := range req.Weekly {
+		weekly[i] = &pb.WeeklyHours{
+			Weekday:   day.Weekday,
+			Intervals: toPbTimeIntervals(day.Intervals),
+		}
+	}
+
+	exceptions := make([]*pb.HoursException, len(req.Exceptions))
+	for i, exception := range req.Exceptions {
+		exceptions[i] = &pb.HoursException{
+			Date:      exception.Date,
+			Intervals: toPbTimeIntervals(exception.Intervals),
+			Note:      exception.Note,
+		}
+	}
+
+	grpcReq := &pb.SetOpeningHoursRequest{
+		Actor:        actor,
+		RestaurantId: id,
+		Weekly:       weekly,
+		Exceptions:   exceptions,
+	}
+
+	grpcResp, err := h.restaurantClient.SetOpeningHours(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.SetOpeningHoursResponse{
+		Hours: toDomainOpeningHours(grpcResp.Hours),
+	})
+}
+
+func (h *RestaurantHandler) SetRestaurantPaused(c *gin.Context) {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant id is required"})
+		return
+	}
+
+	var req domain.SetRestaurantPausedRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.SetRestaurantPausedRequest{
+		Actor:        actor,
+		RestaurantId: id,
+		Paused:       *req.Paused,
+	}
+
+	grpcResp, err := h.restaurantClient.SetRestaurantPaused(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.SetRestaurantPausedResponse{
+		Restaurant: toDomainRestaurant(grpcResp.Restaurant),
+	})
+}
+
+func (h *RestaurantHandler) ReorderMenuCategories(c *gin.Context) {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant id is required"})
+		return
+	}
+
+	var req domain.ReorderMenuCategoriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.ReorderMenuCategoriesRequest{
+		Actor:        actor,
+		RestaurantId: id,
+		CategoryIds:  req.CategoryIDs,
+	}
+
+	grpcResp, err := h.restaurantClient.ReorderMenuCategories(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.ReorderMenuCategoriesResponse{
+		Categories: toDomainMenuCategories(grpcResp.Categories),
+	})
+}
+
+func (h *RestaurantHandler) ReorderMenuItems(c *gin.Context) {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant id is required"})
+		return
+	}
+
+	var req domain.ReorderMenuItemsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.ReorderMenuItemsRequest{
+		Actor:        actor,
+		RestaurantId: id,
+		CategoryId:   req.CategoryID,
+		ItemIds:      req.ItemIDs,
+	}
+
+	grpcResp, err := h.restaurantClient.ReorderMenuItems(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	items := make([]*domain.MenuItem, len(grpcResp.Items))
+	for i, item := range grpcResp.Items {
+		items[i] = toDomainMenuItem(item)
+	}
+
+	c.JSON(http.StatusOK, domain.ReorderMenuItemsResponse{
+		Items: items,
+	})
+}
+
+// maxMenuFileSize keeps uploaded menus well under gRPC's 4MB message limit
+const maxMenuFileSize = 2 << 20
+
+// ImportMenu takes a CSV or JSON menu as the multipart "file" field. The
+// format comes from the "format" field or the file extension, and dry_run=true
+// only validates the file.
+func (h *RestaurantHandler) ImportMenu(c *gin.Context) {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	restaurantID := c.Param("id")
+	if restaurantID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant id is required"})
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxMenuFileSize+1<<20)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "menu file must be at most 2MB"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "menu file is required"})
+		return
+	}
+
+	if fileHeader.Size > maxMenuFileSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "menu file must be at most 2MB"})
+		return
+	}
+
+	format := strings.ToLower(c.PostForm("format"))
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(fileHeader.Filename)), ".")
+	}
+
+	dryRun, err := strconv.ParseBool(c.DefaultPostForm("dry_run", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "dry_run must be true or false"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read menu file"})
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read menu file"})
+		return
+	}
+
+	grpcReq := &pb.ImportMenuRequest{
+		Actor:        actor,
+		RestaurantId: restaurantID,
+		Format:       format,
+		Data:         data,
+		DryRun:       dryRun,
+	}
+
+	grpcResp, err := h.restaurantClient.ImportMenu(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	rowErrors := make([]*domain.ImportRowError, len(grpcResp.Errors))
+	for i, rowErr := range grpcResp.Errors {
+		rowErrors[i] = &domain.ImportRowError{
+			Row:     rowErr.Row,
+			Field:   rowErr.Field,
+			Message: rowErr.Message,
+		}
+	}
+
+	statusCode := http.StatusOK
+	if len(rowErrors) > 0 {
+		statusCode = http.StatusUnprocessableEntity
+	}
+
+	c.JSON(statusCode, domain.ImportMenuResponse{
+		Created: grpcResp.Created,
+		Updated: grpcResp.Updated,
+		Errors:  rowErrors,
+		Applied: grpcResp.Applied,
+	})
+}
+
+func (h *RestaurantHandler) ExportMenu(c *gin.Context) {
+	actor, ok := actorFromContext(c)
+	if !ok {
+		return
+	}
+
+	restaurantID := c.Param("id")
+	if restaurantID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "restaurant id is required"})
+		return
+	}
+
+	grpcReq := &pb.ExportMenuRequest{
+		Actor:        actor,
+		RestaurantId: restaurantID,
+		Format:       c.DefaultQuery("format", "csv"),
+	}
+
+	grpcResp, err := h.restaurantClient.ExportMenu(c.Request.Context(), grpcReq)
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", grpcResp.Filename))
+	c.Data(http.StatusOK, grpcResp.ContentType, grpcResp.Data)
+}
+
+// actorFromContext identifies the logged-in user for restaurant-service,
+// which decides whether they may make the change.
+func actorFromContext(c *gin.Context) (*pb.Actor, bool) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return nil, false
+	}
+
+	return &pb.Actor{
+		UserId: userID,
+		Role:   c.GetString("user_role"),
+	}, true
+}
+
+func toPbGeoPoints(points []domain.GeoPoint) []*pb.GeoPoint {
+	result := make([]*pb.GeoPoint, len(points))
+	for i, p := range points {
+		result[i] = &pb.GeoPoint{Latitude: p.Latitude, Longitude: p.Longitude}
+	}
+	return result
+}
+
+func toDomainRestaurant(r *pb.Restaurant) *domain.Restaurant {
+	polygon := make([]domain.GeoPoint, len(r.DeliveryPolygon))
+	for i, p := range r.DeliveryPolygon {
+		polygon[i] = domain.GeoPoint{Latitude: p.Latitude, Longitude: p.Longitude}
+	}
+
+	return &domain.Restaurant{
+		ID:               r.Id,
+		Name:             r.Name,
+		Description:      r.Description,
+		Address:          r.Address,
+		Phone:            r.Phone,
+		Latitude:         r.Latitude,
+		Longitude:        r.Longitude,
+		LogoURL:          r.LogoUrl,
+		OpeningTime:      r.OpeningTime,
+		ClosingTime:      r.ClosingTime,
+		CreatedAt:        r.CreatedAt,
+		UpdatedAt:        r.UpdatedAt,
+		DeliveryRadiusKm: r.DeliveryRadiusKm,
+		DeliveryPolygon:  polygon,
+		DistanceKm:       r.DistanceKm,
+		OwnerUserID:      r.OwnerUserId,
+		Timezone:         r.Timezone,
+		IsPaused:         r.IsPaused,
+	}
+}
+
+func toDomainMenuItem(item *pb.MenuItem) *domain.MenuItem {
+	return &domain.MenuItem{
+		ID:           item.Id,
+		RestaurantID: item.RestaurantId,
+		Name:         item.Name,
+		Description:  item.Description,
+		Price:        item.Price,
+		ImageURL:     item.ImageUrl,
+		IsAvailable:  item.IsAvailable,
+		Category:     item.Category,
+		CategoryID:   item.CategoryId,
+		Position:     item.Position,
+		ExternalSKU:  item.ExternalSku,
+		OptionGroups: toDomainOptionGroups(item.OptionGroups),
+		CreatedAt:    item.CreatedAt,
+		UpdatedAt:    item.UpdatedAt,
+	}
+}
+
+func toDomainOptionGroups(groups []*pb.OptionGroup) []*domain.OptionGroup {
+	result := make([]*domain.OptionGroup, len(groups))
+	for i, group := range groups {
+		options := make([]*domain.MenuOption, len(group.Options))
+		for j, option := range group.Options {
+			options[j] = &domain.MenuOption{
+				ID:          option.Id,
+				Name:        option.Name,
+				PriceDelta:  option.PriceDelta,
+				IsAvailable: option.IsAvailable,
+			}
+		}
+
+		result[i] = &domain.OptionGroup{
+			ID:        group.Id,
+			Name:      group.Name,
+			MinSelect: group.MinSelect,
+			MaxSelect: group.MaxSelect,
+			Options:   options,
+		}
+	}
+	return result
+}
+
+func toPbTimeIntervals(intervals []*domain.TimeInterval) []*pb.TimeInterval {
+	result := make([]*pb.TimeInterval, len(intervals))
+	for i, interval := range intervals {
+		result[i] = &pb.TimeInterval{OpensAt: interval.OpensAt, ClosesAt: interval.ClosesAt}
+	}
+	return result
+}
+
+func toDomainTimeIntervals(intervals []*pb.TimeInterval) []*domain.TimeInterval {
+	result := make([]*domain.TimeInterval, len(intervals))
+	for i, interval := range intervals {
+		result[i] = &domain.TimeInterval{OpensAt: interval.OpensAt, ClosesAt: interval.ClosesAt}
+	}
+	return result
+}
+
+func toDomainOpeningHours(hours *pb.OpeningHours) *domain.OpeningHours {
+	weekly := make([]*domain.WeeklyHours, len(hours.Weekly))
+	for i, day := range hours.Weekly {
+		weekly[i] = &domain.WeeklyHours{
+			Weekday:   day.Weekday,
+			Intervals: toDomainTimeIntervals(day.Intervals),
+		}
+	}
+
+	exceptions := make([]*domain.HoursException, len(hours.Exceptions))
+	for i, exception := range hours.Exceptions {
+		exceptions[i] = &domain.HoursException{
+			Date:      exception.Date,
+			Intervals: toDomainTimeIntervals(exception.Intervals),
+			Note:      exception.Note,
+		}
+	}
+
+	return &domain.OpeningHours{
+		Timezone:   hours.Timezone,
+		Weekly:     weekly,
+		Exceptions: exceptions,
+		IsPaused:   hours.IsPaused,
+	}
+}
+
+func toDomainMenuCategories(categories []*pb.MenuCategory) []*domain.MenuCategory {
+	result := make([]*domain.MenuCategory, len(categories))
+	for i, category := range categories {
+		items := make([]*domain.MenuItem, len(category.Items))
+		for j, item := range category.Items {
+			items[j] = toDomainMenuItem(item)
+		}
+
+		result[i] = &domain.MenuCategory{
+			ID:       category.Id,
+			Name:     category.Name,
+			Position: category.Position,
+			Items:    items,
+		}
+	}
+	return result
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/kimashii-dan/food-delivery-app/backend/api/domain"
 	"github.com/kimashii-dan/food-delivery-app/backend/services/user-service/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type UserHandler struct {
@@ -31,7 +33,6 @@ func (h *UserHandler) Register(c *gin.Context) {
 		Password: req.Password,
 		Name:     req.Name,
 		Phone:    req.Phone,
-		Role:     req.Role,
 	}
 
 	grpcResp, err := h.userClient.Register(c.Request.Context(), grpcReq)
@@ -54,12 +55,18 @@ func (h *UserHandler) Login(c *gin.Context) {
 	}
 
 	grpcReq := &pb.LoginRequest{
-		Email:    req.Email,
-		Password: req.Password,
+		Email:     req.Email,
+		Password:  req.Password,
+		UserAgent: c.Request.UserAgent(),
+		IpAddress: c.ClientIP(),
 	}
 
 	grpcResp, err := h.userClient.Login(c.Request.Context(), grpcReq)
 	if err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			c.JSON(http.StatusForbidden, gin.H{"error": errorMessage(err)})
+			return
+		}
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
 		return
 	}
@@ -86,12 +93,13 @@ func (h *UserHandler) Login(c *gin.Context) {
 
 	c.JSON(http.StatusOK, domain.LoginResponse{
 		User: domain.User{
-			Id:        grpcResp.User.Id,
-			Email:     grpcResp.User.Email,
-			Name:      grpcResp.User.Name,
-			Phone:     grpcResp.User.Phone,
-			Role:      grpcResp.User.Role,
-			CreatedAt: grpcResp.User.CreatedAt,
+			Id:            grpcResp.User.Id,
+			Email:         grpcResp.User.Email,
+			Name:          grpcResp.User.Name,
+			Phone:         grpcResp.User.Phone,
+			Role:          grpcResp.User.Role,
+			CreatedAt:     grpcResp.User.CreatedAt,
+			EmailVerified: grpcResp.User.EmailVerified,
 		},
 	})
 }
@@ -106,6 +114,8 @@ func (h *UserHandler) Refresh(c *gin.Context) {
 
 	grpcReq := &pb.RefreshRequest{
 		RefreshToken: tokenString,
+		UserAgent:    c.Request.UserAgent(),
+		IpAddress:    c.ClientIP(),
 	}
 	grpcResp, err := h.userClient.Refresh(c.Request.Context(), grpcReq)
 	if err != nil {
@@ -139,13 +149,171 @@ func (h *UserHandler) Refresh(c *gin.Context) {
 	c.Status(http.StatusOK)
 }
 
+// Logout revokes the refresh token server-side and clears the cookies. The
+// cookies are cleared even when revoking fails; an already invalid token
+// needs no revoking.
 func (h *UserHandler) Logout(c *gin.Context) {
+	tokenString, cookieErr := c.Cookie("refreshToken")
+
 	c.SetCookie("accessToken", "", -1, "/", "", false, true)
 	c.SetCookie("refreshToken", "", -1, "/", "", false, true)
 
+	if cookieErr == nil {
+		grpcReq := &pb.LogoutRequest{
+			RefreshToken: tokenString,
+		}
+		if _, err := h.userClient.Logout(c.Request.Context(), grpcReq); err != nil && status.Code(err) != codes.Unauthenticated {
+			c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+			return
+		}
+	}
+
 	c.Status(http.StatusOK)
 }
 
+func (h *UserHandler) VerifyEmail(c *gin.Context) {
+	var req domain.VerifyEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	_, err := h.userClient.VerifyEmail(c.Request.Context(), &pb.VerifyEmailRequest{
+		Token: req.Token,
+	})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "email verified"})
+}
+
+// ResendVerificationEmail answers the same whether or not a mail was sent,
+// so it can't be used to find out who has an account
+func (h *UserHandler) ResendVerificationEmail(c *gin.Context) {
+	var req domain.EmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	_, err := h.userClient.ResendVerificationEmail(c.Request.Context(), &pb.ResendVerificationEmailRequest{
+		Email: req.Email,
+	})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "if the email needs verifying, a link is on its way"})
+}
+
+// RequestPasswordReset answers the same whether or not a mail was sent,
+// like ResendVerificationEmail
+func (h *UserHandler) RequestPasswordReset(c *gin.Context) {
+	var req domain.EmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	_, err := h.userClient.RequestPasswordReset(c.Request.Context(), &pb.RequestPasswordResetRequest{
+		Email: req.Email,
+	})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "if the email is registered, a reset link is on its way"})
+}
+
+func (h *UserHandler) ResetPassword(c *gin.Context) {
+	var req domain.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	_, err := h.userClient.ResetPassword(c.Request.Context(), &pb.ResetPasswordRequest{
+		Token:       req.Token,
+		NewPassword: req.Password,
+	})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "password changed, log in again"})
+}
+
+func (h *UserHandler) ListSessions(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	grpcResp, err := h.userClient.ListSessions(c.Request.Context(), &pb.ListSessionsRequest{
+		UserId:           userID,
+		CurrentSessionId: c.GetString("session_id"),
+	})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	sessions := make([]*domain.Session, len(grpcResp.Sessions))
+	for i, session := range grpcResp.Sessions {
+		sessions[i] = &domain.Session{
+			ID:         session.Id,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IpAddress,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			Current:    session.Current,
+		}
+	}
+
+	c.JSON(http.StatusOK, domain.ListSessionsResponse{
+		Sessions: sessions,
+	})
+}
+
+// RevokeSession logs out the session in the path, or every other session
+// of the user when there is none
+func (h *UserHandler) RevokeSession(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	// Tokens issued before sessions had ids can't tell which one to keep,
+	// and revoking the others would sign this one out too
+	sessionID := c.Param("id")
+	currentSessionID := c.GetString("session_id")
+	if sessionID == "" && currentSessionID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "sign in again to sign out your other sessions"})
+		return
+	}
+
+	grpcResp, err := h.userClient.RevokeSession(c.Request.Context(), &pb.RevokeSessionRequest{
+		UserId:           userID,
+		SessionId:        sessionID,
+		CurrentSessionId: currentSessionID,
+	})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.RevokeSessionResponse{
+		Revoked: grpcResp.Revoked,
+	})
+}
+
 func (h *UserHandler) GetUser(c *gin.Context) {
 	userID := c.GetString("user_id")
 	if userID == "" {
@@ -165,12 +333,50 @@ func (h *UserHandler) GetUser(c *gin.Context) {
 
 	c.JSON(http.StatusOK, domain.GetUserResponse{
 		User: &domain.User{
-			Id:        grpcResp.User.Id,
-			Email:     grpcResp.User.Email,
-			Name:      grpcResp.User.Name,
-			Phone:     grpcResp.User.Phone,
-			Role:      grpcResp.User.Role,
-			CreatedAt: grpcResp.User.CreatedAt,
+			Id:            grpcResp.User.Id,
+			Email:         grpcResp.User.Email,
+			Name:          grpcResp.User.Name,
+			Phone:         grpcResp.User.Phone,
+			Role:          grpcResp.User.Role,
+			CreatedAt:     grpcResp.User.CreatedAt,
+			EmailVerified: grpcResp.User.EmailVerified,
+		},
+	})
+}
+
+// SetUserRole changes the role of the user in the path; only admins get here
+func (h *UserHandler) SetUserRole(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	var req domain.SetUserRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcResp, err := h.userClient.SetUserRole(c.Request.Context(), &pb.SetUserRoleRequest{
+		UserId:      c.Param("id"),
+		Role:        req.Role,
+		ActorUserId: userID,
+	})
+	if err != nil {
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain.SetUserRoleResponse{
+		User: &domain.User{
+			Id:            grpcResp.User.Id,
+			Email:         grpcResp.User.Email,
+			Name:          grpcResp.User.Name,
+			Phone:         grpcResp.User.Phone,
+			Role:          grpcResp.User.Role,
+			CreatedAt:     grpcResp.User.CreatedAt,
+			EmailVerified: grpcResp.User.EmailVerified,
 		},
 	})
 }
@@ -216,13 +422,20 @@ func (h *UserHandler) GetAddresses(c *gin.Context) {
 		return
 	}
 
+	pageSize, pageToken, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
 	grpcReq := &pb.GetAddressesRequest{
-		UserId: userID,
+		UserId:    userID,
+		PageSize:  pageSize,
+		PageToken: pageToken,
 	}
 
 	grpcResp, err := h.userClient.GetAddresses(c.Request.Context(), grpcReq)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		c.JSON(httpStatusFromError(err), gin.H{"error": errorMessage(err)})
 		return
 	}
 
@@ -242,6 +455,7 @@ func (h *UserHandler) GetAddresses(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, domain.GetAddressesResponse{
-		Addresses: addresses,
+		Addresses:     addresses,
+		NextPageToken: grpcResp.NextPageToken,
 	})
 }
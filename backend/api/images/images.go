@@ -0,0 +1,188 @@
+package images
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif" // register the GIF decoder with image.Decode
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+const (
+	// MaxFileSize is the largest upload accepted
+	MaxFileSize = 5 << 20
+
+	// maxPixels guards against small files that decode into huge images
+	maxPixels = 40_000_000
+)
+
+var (
+	ErrTooLarge        = fmt.Errorf("image must be at most %dMB", MaxFileSize>>20)
+	ErrUnsupportedType = errors.New("image must be a JPEG, PNG or GIF")
+	ErrTooManyPixels   = errors.New("image dimensions are too large")
+)
+
+// Size is one rendition of an uploaded image, limited by its longest side
+type Size struct {
+	Name    string
+	MaxSide int
+}
+
+// Sizes are generated for every upload, largest first. The first one is the
+// image itself, the rest are thumbnails.
+var Sizes = []Size{
+	{Name: "original", MaxSide: 1600},
+	{Name: "medium", MaxSide: 640},
+	{Name: "thumb", MaxSide: 200},
+}
+
+type Variant struct {
+	Name        string
+	ContentType string
+	Ext         string
+	Width       int
+	Height      int
+	Data        []byte
+}
+
+// Process checks that data is an image we accept and renders it in every
+// size. JPEGs stay JPEGs; PNGs and GIFs become PNGs so transparency survives.
+// Re-encoding also strips any metadata the original carried.
+func Process(data []byte) ([]*Variant, error) {
+	if len(data) > MaxFileSize {
+		return nil, ErrTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	if contentType != "image/jpeg" && contentType != "image/png" && contentType != "image/gif" {
+		return nil, ErrUnsupportedType
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedType
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrTooManyPixels
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedType
+	}
+
+	encode := encodePNG
+	outType, ext := "image/png", "png"
+	if contentType == "image/jpeg" {
+		encode = encodeJPEG
+		outType, ext = "image/jpeg", "jpg"
+	}
+
+	// Each size is scaled down from the previous one, which is much cheaper
+	// than going back to the full image every time
+	img := toRGBA(decoded)
+	variants := make([]*Variant, 0, len(Sizes))
+	for _, size := range Sizes {
+		img = fit(img, size.MaxSide)
+
+		encoded, err := encode(img)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s image: %w", size.Name, err)
+		}
+
+		variants = append(variants, &Variant{
+			Name:        size.Name,
+			ContentType: outType,
+			Ext:         ext,
+			Width:       img.Rect.Dx(),
+			Height:      img.Rect.Dy(),
+			Data:        encoded,
+		})
+	}
+
+	return variants, nil
+}
+
+func toRGBA(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Rect, img, b.Min, draw.Src)
+	return dst
+}
+
+// fit scales the image down so its longest side is at most maxSide, keeping
+// the aspect ratio. Smaller images are returned as they are.
+func fit(img *image.RGBA, maxSide int) *image.RGBA {
+	w, h := img.Rect.Dx(), img.Rect.Dy()
+	if w <= maxSide && h <= maxSide {
+		return img
+	}
+
+	if w >= h {
+		h = max(1, h*maxSide/w)
+		w = maxSide
+	} else {
+		w = max(1, w*maxSide/h)
+		h = maxSide
+	}
+
+	return shrink(img, w, h)
+}
+
+// shrink resizes with a box filter: every destination pixel is the average
+// of the source pixels it covers. Good enough for downscaling photos, and
+// averaging premultiplied colors keeps transparent edges clean.
+func shrink(src *image.RGBA, w, h int) *image.RGBA {
+	sw, sh := src.Rect.Dx(), src.Rect.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+
+	for y := 0; y < h; y++ {
+		y0 := y * sh / h
+		y1 := max((y+1)*sh/h, y0+1)
+
+		for x := 0; x < w; x++ {
+			x0 := x * sw / w
+			x1 := max((x+1)*sw/w, x0+1)
+
+			var sum [4]int
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride+x0*4 : sy*src.Stride+x1*4]
+				for i := 0; i < len(row); i += 4 {
+					sum[0] += int(row[i])
+					sum[1] += int(row[i+1])
+					sum[2] += int(row[i+2])
+					sum[3] += int(row[i+3])
+				}
+			}
+
+			n := (y1 - y0) * (x1 - x0)
+			px := dst.Pix[y*dst.Stride+x*4 : y*dst.Stride+x*4+4]
+			for c := range px {
+				px[c] = uint8((sum[c] + n/2) / n)
+			}
+		}
+	}
+
+	return dst
+}
+
+func encodeJPEG(img *image.RGBA) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func encodePNG(img *image.RGBA) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := png.Encoder{CompressionLevel: png.BestCompression}
+	if err := encoder.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,165 @@
+package images
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		name          string
+		width, height int
+		maxSide       int
+		wantW, wantH  int
+	}{
+		{name: "landscape", width: 2000, height: 1000, maxSide: 640, wantW: 640, wantH: 320},
+		{name: "portrait", width: 1000, height: 2000, maxSide: 640, wantW: 320, wantH: 640},
+		{name: "square", width: 900, height: 900, maxSide: 200, wantW: 200, wantH: 200},
+		{name: "rounds down", width: 1000, height: 333, maxSide: 200, wantW: 200, wantH: 66},
+		{name: "thin strip keeps a pixel", width: 5000, height: 2, maxSide: 200, wantW: 200, wantH: 1},
+		{name: "smaller stays", width: 150, height: 100, maxSide: 200, wantW: 150, wantH: 100},
+		{name: "exactly the limit stays", width: 200, height: 50, maxSide: 200, wantW: 200, wantH: 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fit(image.NewRGBA(image.Rect(0, 0, tt.width, tt.height)), tt.maxSide)
+			if got.Rect.Dx() != tt.wantW || got.Rect.Dy() != tt.wantH {
+				t.Errorf("fit(%dx%d, %d) = %dx%d, want %dx%d",
+					tt.width, tt.height, tt.maxSide, got.Rect.Dx(), got.Rect.Dy(), tt.wantW, tt.wantH)
+			}
+		})
+	}
+}
+
+func TestShrinkAverages(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	src.Set(0, 0, color.RGBA{255, 0, 0, 255})
+	src.Set(1, 0, color.RGBA{255, 0, 0, 255})
+	src.Set(0, 1, color.RGBA{0, 0, 255, 255})
+	src.Set(1, 1, color.RGBA{0, 0, 255, 255})
+
+	got := shrink(src, 1, 1).RGBAAt(0, 0)
+	if want := (color.RGBA{128, 0, 128, 255}); got != want {
+		t.Errorf("shrink = %v, want %v", got, want)
+	}
+}
+
+func TestProcess(t *testing.T) {
+	encode := func(format string, width, height int) []byte {
+		t.Helper()
+		img := image.NewRGBA(image.Rect(0, 0, width, height))
+		for i := range img.Pix {
+			img.Pix[i] = 200
+		}
+
+		var buf bytes.Buffer
+		var err error
+		switch format {
+		case "jpeg":
+			err = jpeg.Encode(&buf, img, nil)
+		case "png":
+			err = png.Encode(&buf, img)
+		case "gif":
+			err = gif.Encode(&buf, img, nil)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+
+	type size struct {
+		name          string
+		width, height int
+	}
+
+	tests := []struct {
+		name     string
+		data     []byte
+		wantType string
+		want     []size
+	}{
+		{
+			name:     "large JPEG",
+			data:     encode("jpeg", 3200, 2400),
+			wantType: "image/jpeg",
+			want:     []size{{"original", 1600, 1200}, {"medium", 640, 480}, {"thumb", 200, 150}},
+		},
+		{
+			name:     "portrait PNG",
+			data:     encode("png", 800, 1000),
+			wantType: "image/png",
+			want:     []size{{"original", 800, 1000}, {"medium", 512, 640}, {"thumb", 160, 200}},
+		},
+		{
+			name:     "small GIF becomes PNG",
+			data:     encode("gif", 120, 80),
+			wantType: "image/png",
+			want:     []size{{"original", 120, 80}, {"medium", 120, 80}, {"thumb", 120, 80}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			variants, err := Process(tt.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(variants) != len(tt.want) {
+				t.Fatalf("got %d variants, want %d", len(variants), len(tt.want))
+			}
+
+			for i, want := range tt.want {
+				v := variants[i]
+				if v.Name != want.name || v.Width != want.width || v.Height != want.height {
+					t.Errorf("variant %d = %s %dx%d, want %s %dx%d", i, v.Name, v.Width, v.Height, want.name, want.width, want.height)
+				}
+				if v.ContentType != tt.wantType {
+					t.Errorf("variant %s is %s, want %s", v.Name, v.ContentType, tt.wantType)
+				}
+
+				// the stored bytes must decode to the size we report
+				cfg, _, err := image.DecodeConfig(bytes.NewReader(v.Data))
+				if err != nil {
+					t.Fatalf("variant %s does not decode: %v", v.Name, err)
+				}
+				if cfg.Width != v.Width || cfg.Height != v.Height {
+					t.Errorf("variant %s decodes as %dx%d, reported %dx%d", v.Name, cfg.Width, cfg.Height, v.Width, v.Height)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessRejects(t *testing.T) {
+	var huge bytes.Buffer
+	if err := png.Encode(&huge, image.NewGray(image.Rect(0, 0, 8000, 6000))); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{name: "not an image", data: []byte("hello, world"), wantErr: ErrUnsupportedType},
+		{name: "truncated PNG", data: []byte("\x89PNG\r\n\x1a\n\x00\x00"), wantErr: ErrUnsupportedType},
+		{name: "too big a file", data: make([]byte, MaxFileSize+1), wantErr: ErrTooLarge},
+		{name: "too many pixels", data: huge.Bytes(), wantErr: ErrTooManyPixels},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Process(tt.data); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Process error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
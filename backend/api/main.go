@@ -11,6 +11,7 @@ import (
 	"github.com/kimashii-dan/food-delivery-app/backend/api/clients"
 	"github.com/kimashii-dan/food-delivery-app/backend/api/handlers"
 	"github.com/kimashii-dan/food-delivery-app/backend/api/middleware"
+	"github.com/kimashii-dan/food-delivery-app/backend/api/storage"
 	"github.com/kimashii-dan/food-delivery-app/backend/pkg"
 )
 
@@ -30,15 +31,70 @@ func main() {
 	restaurantClient, restaurantConn := clients.NewRestaurantServiceClient(restaurantServicePort)
 	defer restaurantConn.Close()
 
+	// init grpc connection with order service
+	orderServicePort := os.Getenv("ORDER_SERVICE_PORT")
+	orderClient, orderConn := clients.NewOrderServiceClient(orderServicePort)
+	defer orderConn.Close()
+
+	// init grpc connection with delivery service
+	deliveryServicePort := os.Getenv("DELIVERY_SERVICE_PORT")
+	deliveryClient, deliveryConn := clients.NewDeliveryServiceClient(deliveryServicePort)
+	defer deliveryConn.Close()
+
+	port := os.Getenv("API_PORT")
+
+	// init storage for uploaded images
+	uploadDir := os.Getenv("UPLOAD_DIR")
+	if uploadDir == "" {
+		uploadDir = "uploads"
+	}
+	uploadBaseURL := os.Getenv("UPLOAD_BASE_URL")
+	if uploadBaseURL == "" {
+		uploadBaseURL = "http://localhost:" + port + "/uploads"
+	}
+	store, err := storage.New(storage.Config{
+		Backend:           os.Getenv("STORAGE_BACKEND"),
+		Dir:               uploadDir,
+		BaseURL:           uploadBaseURL,
+		S3Endpoint:        os.Getenv("S3_ENDPOINT"),
+		S3Region:          os.Getenv("S3_REGION"),
+		S3Bucket:          os.Getenv("S3_BUCKET"),
+		S3AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+		S3SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+		S3PublicURL:       os.Getenv("S3_PUBLIC_URL"),
+		S3UsePathStyle:    os.Getenv("S3_USE_PATH_STYLE") == "true",
+	})
+	if err != nil {
+		log.Fatalf("Failed to init storage: %v", err)
+	}
+
 	// register handlers
 	userHandler := handlers.NewUserHandler(userClient)
-	restaurantHandler := handlers.NewRestaurantHandler(restaurantClient)
+	restaurantHandler := handlers.NewRestaurantHandler(restaurantClient, store)
+	orderHandler := handlers.NewOrderHandler(orderClient)
+	cartHandler := handlers.NewCartHandler(orderClient)
+	promoHandler := handlers.NewPromoHandler(orderClient)
+	deliveryHandler := handlers.NewDeliveryHandler(deliveryClient)
+
+	// webhooks are refused until the secret shared with the payment provider is set
+	paymentWebhookSecret := os.Getenv("PAYMENT_WEBHOOK_SECRET")
+	if paymentWebhookSecret == "" {
+		log.Println("PAYMENT_WEBHOOK_SECRET is not set, payment webhooks will be rejected")
+	}
+	paymentHandler := handlers.NewPaymentHandler(orderClient, paymentWebhookSecret)
 
 	// init default web server
 	r := gin.Default()
 
-	jwtSecret := os.Getenv("JWT_SECRET")
-	jwtService := pkg.NewJWTService(jwtSecret)
+	// the gateway only verifies tokens, with the public keys user-service signs with
+	jwtService, err := pkg.LoadJWTService(
+		os.Getenv("JWT_SECRET"),
+		"",
+		os.Getenv("JWT_PUBLIC_KEY_FILES"),
+	)
+	if err != nil {
+		log.Fatalf("Failed to load JWT keys: %v", err)
+	}
 
 	r.MaxMultipartMemory = 8 << 20
 
@@ -51,6 +107,14 @@ func main() {
 		MaxAge:           12 * time.Hour,
 	}))
 
+	// uploads kept on local disk are served by the gateway itself
+	if local, ok := store.(*storage.LocalStorage); ok {
+		r.Static("/uploads", local.Dir())
+	}
+
+	// public keys for verifying tokens outside the gateway
+	r.GET("/.well-known/jwks.json", handlers.NewJWKSHandler(jwtService).GetJWKS)
+
 	// api endpoints
 	api := r.Group("/api")
 	{
@@ -60,24 +124,111 @@ func main() {
 			users.POST("/login", userHandler.Login)
 			users.POST("/logout", userHandler.Logout)
 			users.POST("/refresh", userHandler.Refresh)
+			users.POST("/verify-email", userHandler.VerifyEmail)
+			users.POST("/verify-email/resend", userHandler.ResendVerificationEmail)
+			users.POST("/password-reset", userHandler.RequestPasswordReset)
+			users.POST("/password-reset/confirm", userHandler.ResetPassword)
 			users.GET("/me", middleware.CheckAuth(jwtService), userHandler.GetUser)
 			users.POST("/addresses", middleware.CheckAuth(jwtService), userHandler.AddAddress)
 			users.GET("/addresses", middleware.CheckAuth(jwtService), userHandler.GetAddresses)
+			users.GET("/sessions", middleware.CheckAuth(jwtService), userHandler.ListSessions)
+			users.DELETE("/sessions", middleware.CheckAuth(jwtService), userHandler.RevokeSession)
+			users.DELETE("/sessions/:id", middleware.CheckAuth(jwtService), userHandler.RevokeSession)
 		}
 
 		restaurants := api.Group("/restaurants")
 		{
-			restaurants.GET("", restaurantHandler.GetRestaurants)
+			restaurants.GET("", middleware.OptionalAuth(jwtService), restaurantHandler.GetRestaurants)
 			restaurants.GET("/:id", restaurantHandler.GetRestaurant)
+			restaurants.GET("/:id/deliverable", restaurantHandler.CheckDeliverable)
 			restaurants.GET("/:id/menu", restaurantHandler.GetMenu)
 			restaurants.GET("/menu-items/:id", restaurantHandler.GetMenuItem)
 			restaurants.GET("/:id/status", restaurantHandler.GetRestaurantStatus)
+			restaurants.GET("/:id/hours", restaurantHandler.GetOpeningHours)
 			restaurants.POST("/validate-items", restaurantHandler.ValidateMenuItems)
+
+			// management, restaurant-service checks ownership
+			manage := restaurants.Group("", middleware.CheckAuth(jwtService), middleware.RequirePermission(middleware.PermManageRestaurants))
+			{
+				manage.POST("", restaurantHandler.CreateRestaurant)
+				manage.PATCH("/:id", restaurantHandler.UpdateRestaurant)
+				manage.POST("/:id/logo", restaurantHandler.UploadRestaurantLogo)
+				manage.PUT("/:id/hours", restaurantHandler.SetOpeningHours)
+				manage.PUT("/:id/pause", restaurantHandler.SetRestaurantPaused)
+				manage.POST("/:id/menu", restaurantHandler.CreateMenuItem)
+				manage.POST("/:id/menu/import", restaurantHandler.ImportMenu)
+				manage.GET("/:id/menu/export", restaurantHandler.ExportMenu)
+				manage.PUT("/:id/menu/categories/order", restaurantHandler.ReorderMenuCategories)
+				manage.PUT("/:id/menu/items/order", restaurantHandler.ReorderMenuItems)
+				manage.PATCH("/menu-items/:id", restaurantHandler.UpdateMenuItem)
+				manage.DELETE("/menu-items/:id", restaurantHandler.DeleteMenuItem)
+				manage.PUT("/menu-items/:id/availability", restaurantHandler.SetItemAvailability)
+				manage.POST("/menu-items/:id/image", restaurantHandler.UploadMenuItemImage)
+				manage.PUT("/menu-items/:id/options", restaurantHandler.SetMenuItemOptions)
+				manage.GET("/:id/orders", orderHandler.ListRestaurantOrders)
+				manage.GET("/:id/orders/:order_id", orderHandler.GetRestaurantOrder)
+			}
+		}
+
+		api.GET("/search", restaurantHandler.Search)
+
+		// signed by the payment provider instead of a user token
+		api.POST("/payments/webhook", paymentHandler.Webhook)
+
+		orders := api.Group("/orders", middleware.CheckAuth(jwtService))
+		{
+			orders.POST("", middleware.RequirePermission(middleware.PermPlaceOrders), orderHandler.CreateOrder)
+			orders.POST("/quote", middleware.RequirePermission(middleware.PermPlaceOrders), orderHandler.Quote)
+			orders.GET("", orderHandler.ListOrders)
+			orders.GET("/:id", orderHandler.GetOrder)
+			orders.POST("/:id/cancel", middleware.RequirePermission(middleware.PermPlaceOrders), orderHandler.CancelOrder)
+			orders.PATCH("/:id/status", middleware.RequirePermission(middleware.PermUpdateOrderStatus), orderHandler.UpdateOrderStatus)
+		}
+
+		// order-service checks the role again
+		promoCodes := api.Group("/admin/promo-codes", middleware.CheckAuth(jwtService), middleware.RequirePermission(middleware.PermManagePromoCodes))
+		{
+			promoCodes.GET("", promoHandler.ListPromoCodes)
+			promoCodes.POST("", promoHandler.CreatePromoCode)
+			promoCodes.GET("/:id", promoHandler.GetPromoCode)
+			promoCodes.PUT("/:id", promoHandler.UpdatePromoCode)
+			promoCodes.DELETE("/:id", promoHandler.DeletePromoCode)
+		}
+
+		// user-service checks the role again, against the database
+		adminUsers := api.Group("/admin/users", middleware.CheckAuth(jwtService), middleware.RequirePermission(middleware.PermManageUsers))
+		{
+			adminUsers.PUT("/:id/role", userHandler.SetUserRole)
+		}
+
+		cart := api.Group("/cart", middleware.CheckAuth(jwtService), middleware.RequirePermission(middleware.PermPlaceOrders))
+		{
+			cart.GET("", cartHandler.GetCart)
+			cart.DELETE("", cartHandler.ClearCart)
+			cart.POST("/items", cartHandler.AddCartItem)
+			cart.PATCH("/items/:id", cartHandler.UpdateCartItem)
+			cart.DELETE("/items/:id", cartHandler.RemoveCartItem)
+		}
+
+		deliveries := api.Group("/deliveries", middleware.CheckAuth(jwtService))
+		{
+			deliveries.GET("/:id", deliveryHandler.GetDelivery)
+			deliveries.GET("/:id/watch", deliveryHandler.WatchDelivery)
+			deliveries.POST("/:id/assign", middleware.RequirePermission(middleware.PermDispatchDeliveries), deliveryHandler.AssignCourier)
+			deliveries.PATCH("/:id/status", middleware.RequirePermission(middleware.PermDeliver), deliveryHandler.UpdateDeliveryStatus)
+		}
+
+		couriers := api.Group("/couriers", middleware.CheckAuth(jwtService), middleware.RequirePermission(middleware.PermDeliver))
+		{
+			couriers.PUT("/availability", deliveryHandler.SetCourierAvailability)
+			couriers.POST("/location", deliveryHandler.UpdateLocation)
+			couriers.GET("/offer", deliveryHandler.GetCourierOffer)
+			couriers.POST("/offers/:id/accept", deliveryHandler.AcceptOffer)
+			couriers.POST("/offers/:id/decline", deliveryHandler.DeclineOffer)
 		}
 	}
 
 	// run server
-	port := os.Getenv("API_PORT")
 	log.Printf("API Gateway listening on port %s", port)
 	if err := r.Run(":" + port); err != nil {
 		log.Fatalf("Failed to start server: %v", err)
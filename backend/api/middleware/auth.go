@@ -17,7 +17,7 @@ func CheckAuth(jwtService *pkg.JWTService) gin.HandlerFunc {
 		}
 
 		claims, err := jwtService.ValidateToken(tokenStr)
-		if err != nil {
+		if err != nil || claims.IsRefresh() {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid or expired token"})
 			c.Abort()
 			return
@@ -26,6 +26,24 @@ func CheckAuth(jwtService *pkg.JWTService) gin.HandlerFunc {
 		c.Set("user_id", claims.UserID)
 		c.Set("user_email", claims.Email)
 		c.Set("user_role", claims.Role)
+		c.Set("session_id", claims.SessionID)
+
+		c.Next()
+	}
+}
+
+// OptionalAuth identifies the user like CheckAuth when a valid access token
+// is present, but lets anonymous requests through.
+func OptionalAuth(jwtService *pkg.JWTService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if tokenStr, err := c.Cookie("accessToken"); err == nil {
+			if claims, err := jwtService.ValidateToken(tokenStr); err == nil && !claims.IsRefresh() {
+				c.Set("user_id", claims.UserID)
+				c.Set("user_email", claims.Email)
+				c.Set("user_role", claims.Role)
+				c.Set("session_id", claims.SessionID)
+			}
+		}
 
 		c.Next()
 	}
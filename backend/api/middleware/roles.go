@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Roles as stored on users in user-service
+const (
+	RoleCustomer   = "customer"
+	RoleRestaurant = "restaurant"
+	RoleCourier    = "courier"
+	RoleAdmin      = "admin"
+)
+
+// Permission is something a route lets its caller do. Services still check
+// ownership, e.g. that a restaurant is the caller's own.
+type Permission string
+
+const (
+	PermPlaceOrders        Permission = "orders:place"
+	PermUpdateOrderStatus  Permission = "orders:update_status"
+	PermManageRestaurants  Permission = "restaurants:manage"
+	PermDeliver            Permission = "deliveries:deliver"
+	PermDispatchDeliveries Permission = "deliveries:dispatch"
+	PermManagePromoCodes   Permission = "promo_codes:manage"
+	PermManageUsers        Permission = "users:manage"
+)
+
+// permissions lists, for every role, what it is allowed to do. Routes open
+// to any signed-in user, like reading one's own orders, need no permission.
+var permissions = map[string][]Permission{
+	RoleCustomer: {
+		PermPlaceOrders,
+	},
+	RoleRestaurant: {
+		PermUpdateOrderStatus,
+		PermManageRestaurants,
+	},
+	RoleCourier: {
+		PermUpdateOrderStatus,
+		PermDeliver,
+	},
+	RoleAdmin: {
+		PermManageRestaurants,
+		PermManagePromoCodes,
+		PermManageUsers,
+		PermDispatchDeliveries,
+	},
+}
+
+// HasPermission reports whether role grants permission
+func HasPermission(role string, permission Permission) bool {
+	return slices.Contains(permissions[role], permission)
+}
+
+// RequirePermission lets through only users whose role grants permission.
+// It goes after CheckAuth.
+func RequirePermission(permission Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !HasPermission(c.GetString("user_role"), permission) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "your role is not allowed to do this"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
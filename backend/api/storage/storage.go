@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Storage keeps uploaded files and serves them under public URLs. Keys are
+// slash separated paths like "restaurants/<id>/logo-<name>.jpg".
+type Storage interface {
+	// Put stores the file under key, replacing any previous one, and returns
+	// its public URL.
+	Put(ctx context.Context, key, contentType string, data []byte) (string, error)
+	// Delete removes the file. Deleting a missing file is not an error.
+	Delete(ctx context.Context, key string) error
+	// KeyFromURL returns the key of a URL produced by Put, or false for URLs
+	// that point somewhere else.
+	KeyFromURL(url string) (string, bool)
+}
+
+// Config selects and configures the storage backend
+type Config struct {
+	Backend string // "local" (default) or "s3"
+
+	// local
+	Dir     string
+	BaseURL string
+
+	// s3
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+	S3PublicURL       string
+	S3UsePathStyle    bool
+}
+
+func New(cfg Config) (Storage, error) {
+	switch cfg.Backend {
+	case "", "local":
+		return NewLocalStorage(cfg.Dir, cfg.BaseURL)
+	case "s3":
+		return NewS3Storage(S3Config{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			PublicURL:       cfg.S3PublicURL,
+			UsePathStyle:    cfg.S3UsePathStyle,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.Backend)
+	}
+}
+
+// validKey rejects keys that could escape the storage root
+func validKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return false
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+func keyFromURL(baseURL, url string) (string, bool) {
+	key, ok := strings.CutPrefix(url, baseURL+"/")
+	if !ok || !validKey(key) {
+		return "", false
+	}
+	return key, true
+}
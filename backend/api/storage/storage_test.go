@@ -0,0 +1,59 @@
+package storage
+
+import "testing"
+
+func TestValidKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{key: "restaurants/r1/logo-a.jpg", want: true},
+		{key: "logo.jpg", want: true},
+		{key: "restaurants/r1/..logo.jpg", want: true},
+		{key: "", want: false},
+		{key: "/etc/passwd", want: false},
+		{key: "restaurants/../../etc/passwd", want: false},
+		{key: "restaurants/./logo.jpg", want: false},
+		{key: "..", want: false},
+		{key: "restaurants//logo.jpg", want: false},
+		{key: "restaurants/", want: false},
+		{key: `restaurants\..\logo.jpg`, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := validKey(tt.key); got != tt.want {
+				t.Errorf("validKey(%q) = %v, want %v", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeyFromURL(t *testing.T) {
+	const base = "https://cdn.example.com/uploads"
+
+	tests := []struct {
+		name    string
+		url     string
+		wantKey string
+		wantOK  bool
+	}{
+		{name: "own file", url: base + "/restaurants/r1/logo-a.jpg", wantKey: "restaurants/r1/logo-a.jpg", wantOK: true},
+		{name: "other host", url: "https://evil.example.com/uploads/restaurants/r1/logo-a.jpg"},
+		{name: "base as a prefix of another path", url: "https://cdn.example.com/uploads-old/logo-a.jpg"},
+		{name: "base itself", url: base},
+		{name: "base with a slash", url: base + "/"},
+		{name: "escapes the base", url: base + "/../secrets.txt"},
+		{name: "dot segment inside", url: base + "/restaurants/r1/../r2/logo-a.jpg"},
+		{name: "empty", url: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, ok := keyFromURL(base, tt.url)
+			if key != tt.wantKey || ok != tt.wantOK {
+				t.Errorf("keyFromURL(%q) = %q, %v, want %q, %v", tt.url, key, ok, tt.wantKey, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package pkg
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minRSABits is the smallest RSA key accepted for signing or verifying
+const minRSABits = 2048
+
+// JWK is a public key in JSON Web Key form (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS is the document served at /.well-known/jwks.json
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// LoadJWTService sets up the JWT service from key files. privateKeyFile is
+// a PEM private key (PKCS#8, or PKCS#1 for RSA) to sign with and
+// publicKeyFiles a comma-separated list of PEM public keys that are also
+// accepted, e.g. the previous key while rotating. Services that only verify
+// leave privateKeyFile empty. Without any key files tokens are HS256 with
+// secret.
+func LoadJWTService(secret, privateKeyFile, publicKeyFiles string) (*JWTService, error) {
+	var publicKeys []crypto.PublicKey
+	for _, file := range strings.Split(publicKeyFiles, ",") {
+		file = strings.TrimSpace(file)
+		if file == "" {
+			continue
+		}
+
+		key, err := readPublicKey(file)
+		if err != nil {
+			return nil, err
+		}
+		publicKeys = append(publicKeys, key)
+	}
+
+	if privateKeyFile == "" && len(publicKeys) == 0 {
+		return NewJWTService(secret), nil
+	}
+
+	var signingKey crypto.Signer
+	if privateKeyFile != "" {
+		key, err := readPrivateKey(privateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		signingKey = key
+	}
+
+	return NewAsymmetricJWTService(signingKey, publicKeys)
+}
+
+// JWKS lists the public keys tokens are verified with. It is empty in HS256
+// mode, where there is nothing that may be published.
+func (j *JWTService) JWKS() JWKS {
+	jwks := JWKS{Keys: []JWK{}}
+	for kid, key := range j.keys {
+		jwk := JWK{
+			Use: "sig",
+			Alg: key.method.Alg(),
+			Kid: kid,
+		}
+
+		switch pub := key.key.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N, jwk.E = rsaComponents(pub)
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		}
+
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+
+	sort.Slice(jwks.Keys, func(a, b int) bool {
+		return jwks.Keys[a].Kid < jwks.Keys[b].Kid
+	})
+
+	return jwks
+}
+
+func signingMethodFor(key crypto.PublicKey) (jwt.SigningMethod, error) {
+	switch pub := key.(type) {
+	case *rsa.PublicKey:
+		if pub.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("RSA keys must have at least %d bits", minRSABits)
+		}
+		return jwt.SigningMethodRS256, nil
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T, use RSA or Ed25519", key)
+	}
+}
+
+// keyID is the RFC 7638 thumbprint of the key, so every service derives the
+// same kid from the same key without configuring it
+func keyID(key crypto.PublicKey) (string, error) {
+	var canonical string
+	switch pub := key.(type) {
+	case *rsa.PublicKey:
+		n, e := rsaComponents(pub)
+		canonical = fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`, e, n)
+	case ed25519.PublicKey:
+		x := base64.RawURLEncoding.EncodeToString(pub)
+		canonical = fmt.Sprintf(`{"crv":"Ed25519","kty":"OKP","x":"%s"}`, x)
+	default:
+		return "", fmt.Errorf("unsupported key type %T, use RSA or Ed25519", key)
+	}
+
+	sum := sha256.Sum256([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+func rsaComponents(pub *rsa.PublicKey) (n, e string) {
+	n = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+	e = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	return n, e
+}
+
+func readPrivateKey(file string) (crypto.Signer, error) {
+	block, err := readPEM(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var key any
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%s: unexpected PEM block %q", file, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to parse private key: %w", file, err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%s: unsupported private key type %T", file, key)
+	}
+
+	return signer, nil
+}
+
+func readPublicKey(file string) (crypto.PublicKey, error) {
+	block, err := readPEM(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var key crypto.PublicKey
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%s: unexpected PEM block %q", file, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to parse public key: %w", file, err)
+	}
+
+	return key, nil
+}
+
+func readPEM(file string) (*pem.Block, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New(file + ": no PEM data found")
+	}
+
+	return block, nil
+}
@@ -1,32 +1,125 @@
 package pkg
 
 import (
+	"crypto"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// ErrNoSigningKey is returned when a service that only holds public keys is
+// asked to issue a token
+var ErrNoSigningKey = errors.New("no private key to sign tokens with")
+
 type Claims struct {
 	UserID string `json:"user_id"`
 	Email  string `json:"email"`
 	Role   string `json:"role"`
+	// SessionID is the login an access token was issued for
+	SessionID string `json:"sid,omitempty"`
+	// Family is only set on refresh tokens, whose jti (ID) is tracked by
+	// user-service
+	Family string `json:"fam,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// IsRefresh reports whether the claims belong to a refresh token, which
+// must not be accepted as an access token
+func (c *Claims) IsRefresh() bool {
+	return c.Family != ""
+}
+
+// JWTService issues and validates tokens. It signs either HS256 with a
+// shared secret, meant for local development, or with a private key
+// (RS256 or EdDSA) whose id is put in the kid header. Asymmetric tokens are
+// verified with any of the service's public keys, so keys can be rotated by
+// adding the new public key everywhere before signing with it.
 type JWTService struct {
-	secret string
+	secret     string
+	signingKey crypto.Signer
+	signingKID string
+	keys       map[string]*verificationKey // by kid, nil in HS256 mode
 }
 
+type verificationKey struct {
+	key    crypto.PublicKey
+	method jwt.SigningMethod
+}
+
+// NewJWTService signs and verifies HS256 with secret
 func NewJWTService(secret string) *JWTService {
 	return &JWTService{
 		secret: secret,
 	}
 }
 
-func (j *JWTService) GenerateToken(userID, email, role string, duration time.Duration) (string, error) {
+// NewAsymmetricJWTService signs with signingKey, which may be nil for
+// services that only verify, and verifies with its public key and
+// verificationKeys
+func NewAsymmetricJWTService(signingKey crypto.Signer, verificationKeys []crypto.PublicKey) (*JWTService, error) {
+	j := &JWTService{
+		signingKey: signingKey,
+		keys:       make(map[string]*verificationKey),
+	}
+
+	if signingKey != nil {
+		kid, err := j.addKey(signingKey.Public())
+		if err != nil {
+			return nil, err
+		}
+		j.signingKID = kid
+	}
+
+	for _, key := range verificationKeys {
+		if _, err := j.addKey(key); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(j.keys) == 0 {
+		return nil, errors.New("no keys to verify tokens with")
+	}
+
+	return j, nil
+}
+
+func (j *JWTService) addKey(key crypto.PublicKey) (string, error) {
+	method, err := signingMethodFor(key)
+	if err != nil {
+		return "", err
+	}
+
+	kid, err := keyID(key)
+	if err != nil {
+		return "", err
+	}
+
+	j.keys[kid] = &verificationKey{key: key, method: method}
+	return kid, nil
+}
+
+func (j *JWTService) GenerateToken(userID, email, role, sessionID string, duration time.Duration) (string, error) {
+	claims := newClaims(userID, email, role, duration)
+	claims.SessionID = sessionID
+
+	return j.sign(claims)
+}
+
+// GenerateRefreshToken issues a refresh token with the given id that
+// belongs to a token family
+func (j *JWTService) GenerateRefreshToken(userID, email, role, family, jti string, duration time.Duration) (string, error) {
+	claims := newClaims(userID, email, role, duration)
+	claims.Family = family
+	claims.ID = jti
+
+	return j.sign(claims)
+}
+
+func newClaims(userID, email, role string, duration time.Duration) *Claims {
 	now := time.Now()
-	claims := &Claims{
+	return &Claims{
 		UserID: userID,
 		Email:  email,
 		Role:   role,
@@ -36,9 +129,27 @@ func (j *JWTService) GenerateToken(userID, email, role string, duration time.Dur
 			NotBefore: jwt.NewNumericDate(now),
 		},
 	}
+}
+
+func (j *JWTService) sign(claims *Claims) (string, error) {
+	if j.keys == nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		tokenString, err := token.SignedString([]byte(j.secret))
+		if err != nil {
+			return "", fmt.Errorf("failed to sign token: %w", err)
+		}
+
+		return tokenString, nil
+	}
+
+	if j.signingKey == nil {
+		return "", ErrNoSigningKey
+	}
+
+	token := jwt.NewWithClaims(j.keys[j.signingKID].method, claims)
+	token.Header["kid"] = j.signingKID
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(j.secret))
+	tokenString, err := token.SignedString(j.signingKey)
 	if err != nil {
 		return "", fmt.Errorf("failed to sign token: %w", err)
 	}
@@ -47,12 +158,7 @@ func (j *JWTService) GenerateToken(userID, email, role string, duration time.Dur
 }
 
 func (j *JWTService) ValidateToken(tokenString string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(j.secret), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, j.keyFunc)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse token: %w", err)
@@ -65,6 +171,30 @@ func (j *JWTService) ValidateToken(tokenString string) (*Claims, error) {
 	return nil, fmt.Errorf("invalid token")
 }
 
+// keyFunc picks the key a token is verified with. Asymmetric tokens must
+// name a known key in kid and use that key's algorithm, so an HS256 token
+// can't pass itself off as signed by a public key.
+func (j *JWTService) keyFunc(token *jwt.Token) (interface{}, error) {
+	if j.keys == nil {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(j.secret), nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	key, ok := j.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+
+	return key.key, nil
+}
+
 func (j *JWTService) ExtractClaims(tokenString string) (*Claims, error) {
 	token, _, err := new(jwt.Parser).ParseUnverified(tokenString, &Claims{})
 	if err != nil {
@@ -0,0 +1,179 @@
+package pkg
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestJWTServiceKeyFunc(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	previousKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service, err := NewAsymmetricJWTService(rsaKey, []crypto.PublicKey{previousKey.Public(), edKey.Public()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kidOf := func(key crypto.PublicKey) string {
+		kid, err := keyID(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return kid
+	}
+
+	// sign makes a token with any method, key and kid, the way an attacker
+	// or another issuer could
+	sign := func(method jwt.SigningMethod, key any, kid string) string {
+		token := jwt.NewWithClaims(method, newClaims("user-1", "user@example.com", "customer", time.Minute))
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+
+	publicPEM, err := x509.MarshalPKIXPublicKey(rsaKey.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicPEM = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicPEM})
+
+	issued, err := service.GenerateToken("user-1", "user@example.com", "customer", "session-1", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "issued by the service", token: issued},
+		{name: "signed with a previous key", token: sign(jwt.SigningMethodRS256, previousKey, kidOf(previousKey.Public()))},
+		{name: "signed with an Ed25519 key", token: sign(jwt.SigningMethodEdDSA, edKey, kidOf(edKey.Public()))},
+		{name: "unknown kid", token: sign(jwt.SigningMethodRS256, otherKey, kidOf(otherKey.Public())), wantErr: true},
+		{name: "no kid", token: sign(jwt.SigningMethodRS256, rsaKey, ""), wantErr: true},
+		{name: "known kid, other key", token: sign(jwt.SigningMethodRS256, otherKey, kidOf(rsaKey.Public())), wantErr: true},
+		{name: "EdDSA token naming an RSA key", token: sign(jwt.SigningMethodEdDSA, edKey, kidOf(rsaKey.Public())), wantErr: true},
+		{name: "RS256 token naming an Ed25519 key", token: sign(jwt.SigningMethodRS256, rsaKey, kidOf(edKey.Public())), wantErr: true},
+		{name: "HS256 with the public key as secret", token: sign(jwt.SigningMethodHS256, publicPEM, kidOf(rsaKey.Public())), wantErr: true},
+		{name: "HS256 with the raw modulus as secret", token: sign(jwt.SigningMethodHS256, rsaKey.N.Bytes(), kidOf(rsaKey.Public())), wantErr: true},
+		{name: "unsigned", token: sign(jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, kidOf(rsaKey.Public())), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := service.ValidateToken(tt.token)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ValidateToken accepted the token with claims %+v", claims)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ValidateToken: %v", err)
+			}
+			if claims.UserID != "user-1" {
+				t.Errorf("user id = %q, want user-1", claims.UserID)
+			}
+		})
+	}
+}
+
+func TestJWTServiceKeyFuncHS256(t *testing.T) {
+	service := NewJWTService("secret")
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sign := func(method jwt.SigningMethod, key any) string {
+		signed, err := jwt.NewWithClaims(method, newClaims("user-1", "user@example.com", "customer", time.Minute)).SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+
+	issued, err := service.GenerateToken("user-1", "user@example.com", "customer", "session-1", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "issued by the service", token: issued},
+		{name: "other secret", token: sign(jwt.SigningMethodHS256, []byte("other")), wantErr: true},
+		{name: "RS256 token", token: sign(jwt.SigningMethodRS256, rsaKey), wantErr: true},
+		{name: "unsigned", token: sign(jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.ValidateToken(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateToken error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyOnlyJWTService(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuer, err := NewAsymmetricJWTService(rsaKey, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifier, err := NewAsymmetricJWTService(nil, []crypto.PublicKey{rsaKey.Public()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := issuer.GenerateToken("user-1", "user@example.com", "customer", "session-1", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := verifier.ValidateToken(token); err != nil {
+		t.Errorf("ValidateToken: %v", err)
+	}
+
+	if _, err := verifier.GenerateToken("user-1", "user@example.com", "customer", "session-1", time.Minute); !errors.Is(err, ErrNoSigningKey) {
+		t.Errorf("GenerateToken error = %v, want ErrNoSigningKey", err)
+	}
+}
@@ -0,0 +1,93 @@
+package pkg
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// MaxPageSize caps the page_size clients can ask for on list RPCs
+const MaxPageSize = 100
+
+var (
+	ErrInvalidPageSize  = errors.New("page_size must not be negative")
+	ErrInvalidPageToken = errors.New("invalid page_token")
+)
+
+// PageSize returns the page size to use for a requested page_size: 0 means
+// the default, anything above MaxPageSize is capped.
+func PageSize(requested, defaultSize int32) (int32, error) {
+	switch {
+	case requested < 0:
+		return 0, ErrInvalidPageSize
+	case requested == 0:
+		return defaultSize, nil
+	case requested > MaxPageSize:
+		return MaxPageSize, nil
+	}
+	return requested, nil
+}
+
+// pageToken is what a next_page_token holds: where the previous page ended
+// and a fingerprint of the request it belongs to.
+type pageToken struct {
+	Filter string          `json:"f"`
+	After  json.RawMessage `json:"a"`
+}
+
+// PageFilter fingerprints the request parameters that pick and sort the
+// results, so a token cannot be used with a different query. Pass values,
+// pointers would be fingerprinted by address.
+func PageFilter(parts ...any) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%#v", parts)))
+	return hex.EncodeToString(sum[:8])
+}
+
+// EncodePageToken turns the position after the last result of a page into
+// an opaque next_page_token. A nil after means there are no more pages and
+// gives an empty token.
+func EncodePageToken[T any](filter string, after *T) (string, error) {
+	if after == nil {
+		return "", nil
+	}
+
+	position, err := json.Marshal(after)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode page token: %w", err)
+	}
+
+	token, err := json.Marshal(pageToken{Filter: filter, After: position})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode page token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// DecodePageToken reads a page_token made by EncodePageToken for the same
+// filter. An empty token asks for the first page and gives nil.
+func DecodePageToken[T any](token, filter string) (*T, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var decoded pageToken
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.Filter != filter {
+		return nil, ErrInvalidPageToken
+	}
+
+	var after T
+	if err := json.Unmarshal(decoded.After, &after); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &after, nil
+}
@@ -0,0 +1,134 @@
+package pkg
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+)
+
+type testCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        string    `json:"i"`
+}
+
+func TestPageSize(t *testing.T) {
+	tests := []struct {
+		name      string
+		requested int32
+		want      int32
+		wantErr   error
+	}{
+		{name: "default", requested: 0, want: 20},
+		{name: "as asked", requested: 5, want: 5},
+		{name: "at the cap", requested: MaxPageSize, want: MaxPageSize},
+		{name: "capped", requested: MaxPageSize + 1, want: MaxPageSize},
+		{name: "negative", requested: -1, wantErr: ErrInvalidPageSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PageSize(tt.requested, 20)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PageSize(%d) = %d, want %d", tt.requested, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	filter := PageFilter("restaurant-1", "pending")
+	after := &testCursor{CreatedAt: time.Date(2026, 10, 18, 12, 30, 0, 0, time.UTC), ID: "order-42"}
+
+	token, err := EncodePageToken(filter, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token == "" {
+		t.Fatal("token is empty")
+	}
+
+	got, err := DecodePageToken[testCursor](token, filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got == nil || !got.CreatedAt.Equal(after.CreatedAt) || got.ID != after.ID {
+		t.Errorf("decoded %+v, want %+v", got, after)
+	}
+}
+
+func TestPageTokenLastPage(t *testing.T) {
+	token, err := EncodePageToken[testCursor]("filter", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "" {
+		t.Fatalf("token = %q, want empty", token)
+	}
+
+	got, err := DecodePageToken[testCursor]("", "filter")
+	if err != nil || got != nil {
+		t.Errorf("DecodePageToken(\"\") = %v, %v, want nil, nil", got, err)
+	}
+}
+
+func TestDecodePageTokenInvalid(t *testing.T) {
+	filter := PageFilter("restaurant-1", "pending")
+	valid, err := EncodePageToken(filter, &testCursor{ID: "order-42"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encode := func(raw string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+
+	tests := []struct {
+		name   string
+		token  string
+		filter string
+	}{
+		{name: "other filter", token: valid, filter: PageFilter("restaurant-2", "pending")},
+		{name: "not base64", token: "not a token!", filter: filter},
+		{name: "padded base64", token: base64.URLEncoding.EncodeToString([]byte(`{"f":"x"}`)), filter: "x"},
+		{name: "not json", token: encode("garbage"), filter: filter},
+		{name: "no filter", token: encode(`{"a":{"i":"order-42"}}`), filter: filter},
+		{name: "wrong cursor shape", token: encode(`{"f":"` + filter + `","a":{"t":"yesterday"}}`), filter: filter},
+		{name: "missing cursor", token: encode(`{"f":"` + filter + `"}`), filter: filter},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodePageToken[testCursor](tt.token, tt.filter)
+			if !errors.Is(err, ErrInvalidPageToken) {
+				t.Errorf("DecodePageToken = %v, %v, want ErrInvalidPageToken", got, err)
+			}
+		})
+	}
+}
+
+func TestPageFilter(t *testing.T) {
+	if PageFilter("a", int32(1), true) != PageFilter("a", int32(1), true) {
+		t.Error("same parameters give different fingerprints")
+	}
+
+	tests := []struct {
+		name string
+		a, b []any
+	}{
+		{name: "different value", a: []any{"a", int32(1)}, b: []any{"a", int32(2)}},
+		{name: "different order", a: []any{"a", "b"}, b: []any{"b", "a"}},
+		{name: "split differently", a: []any{"ab", ""}, b: []any{"a", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if PageFilter(tt.a...) == PageFilter(tt.b...) {
+				t.Errorf("PageFilter(%v) == PageFilter(%v)", tt.a, tt.b)
+			}
+		})
+	}
+}
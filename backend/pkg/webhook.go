@@ -0,0 +1,75 @@
+package pkg
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WebhookTolerance is how old a signed webhook may be before it is refused,
+// so captured requests can't be replayed later
+const WebhookTolerance = 5 * time.Minute
+
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// SignWebhook returns the signature header for a webhook body sent at
+// timestamp: "t=<unix seconds>,v1=<hex HMAC-SHA256 of "t.body">".
+func SignWebhook(secret []byte, timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", t, webhookMAC(secret, t, body))
+}
+
+// VerifyWebhook checks a signature header made by SignWebhook against the
+// body and refuses signatures older or newer than WebhookTolerance.
+func VerifyWebhook(secret []byte, header string, body []byte, now time.Time) error {
+	if len(secret) == 0 {
+		return ErrInvalidSignature
+	}
+
+	var t string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			t = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+
+	unix, err := strconv.ParseInt(t, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	age := now.Sub(time.Unix(unix, 0))
+	if age > WebhookTolerance || age < -WebhookTolerance {
+		return ErrInvalidSignature
+	}
+
+	expected := []byte(webhookMAC(secret, t, body))
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(signature), expected) {
+			return nil
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+func webhookMAC(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,64 @@
+package pkg
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVerifyWebhook(t *testing.T) {
+	secret := []byte("whsec_test")
+	body := []byte(`{"event_id":"evt_1","type":"payment.failed"}`)
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	valid := SignWebhook(secret, now, body)
+	_, validMAC, _ := strings.Cut(valid, ",v1=")
+	ts := strconv.FormatInt(now.Unix(), 10)
+
+	tests := []struct {
+		name    string
+		secret  []byte
+		header  string
+		body    []byte
+		now     time.Time
+		wantErr error
+	}{
+		{name: "valid", header: valid},
+		{name: "valid with spaces", header: strings.ReplaceAll(valid, ",", ", ")},
+		{name: "one of several signatures matches", header: valid + ",v1=" + strings.Repeat("0", 64)},
+		{name: "just within tolerance", header: valid, now: now.Add(WebhookTolerance)},
+		{name: "signed by another secret", header: SignWebhook([]byte("other"), now, body), wantErr: ErrInvalidSignature},
+		{name: "body changed", header: valid, body: []byte(`{"event_id":"evt_2"}`), wantErr: ErrInvalidSignature},
+		{name: "timestamp changed", header: "t=" + strconv.FormatInt(now.Unix()+1, 10) + ",v1=" + validMAC, wantErr: ErrInvalidSignature},
+		{name: "stale", header: valid, now: now.Add(WebhookTolerance + time.Second), wantErr: ErrInvalidSignature},
+		{name: "from the future", header: valid, now: now.Add(-WebhookTolerance - time.Second), wantErr: ErrInvalidSignature},
+		{name: "empty header", header: "", wantErr: ErrInvalidSignature},
+		{name: "no timestamp", header: "v1=" + validMAC, wantErr: ErrInvalidSignature},
+		{name: "timestamp not a number", header: "t=noon,v1=" + validMAC, wantErr: ErrInvalidSignature},
+		{name: "no signature", header: "t=" + ts, wantErr: ErrInvalidSignature},
+		{name: "parts without values", header: "t,v1", wantErr: ErrInvalidSignature},
+		{name: "other scheme only", header: "t=" + ts + ",v0=" + validMAC, wantErr: ErrInvalidSignature},
+		{name: "no secret configured", secret: []byte{}, header: valid, wantErr: ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.secret == nil {
+				tt.secret = secret
+			}
+			if tt.body == nil {
+				tt.body = body
+			}
+			if tt.now.IsZero() {
+				tt.now = now
+			}
+
+			err := VerifyWebhook(tt.secret, tt.header, tt.body, tt.now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("VerifyWebhook(%q) = %v, want %v", tt.header, err, tt.wantErr)
+			}
+		})
+	}
+}
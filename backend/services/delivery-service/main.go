@@ -1 +1,70 @@
 package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service/clients"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service/repository"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+func main() {
+	err := godotenv.Load()
+	if err != nil {
+		log.Println("Error loading .env file, using environment variables")
+	}
+
+	db, err := repository.Init(os.Getenv("DATABASE_URL"))
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	// init grpc connection with order service
+	orderServicePort := os.Getenv("ORDER_SERVICE_PORT")
+	orderClient, orderConn := clients.NewOrderServiceClient(orderServicePort)
+	defer orderConn.Close()
+
+	deliveryRepo := repository.NewDeliveryRepository(db)
+	courierRepo := repository.NewCourierRepository(db)
+	offerRepo := repository.NewOfferRepository(db)
+
+	// how long a courier has to answer an offer before it goes to the next one
+	offerTimeout := 30 * time.Second
+	if value := os.Getenv("DISPATCH_OFFER_TIMEOUT"); value != "" {
+		offerTimeout, err = time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("Invalid DISPATCH_OFFER_TIMEOUT: %v", err)
+		}
+	}
+
+	dispatcher := service.NewDispatcher(deliveryRepo, courierRepo, offerRepo, offerTimeout)
+	if err := dispatcher.ResumePending(context.Background()); err != nil {
+		log.Printf("Failed to resume dispatching: %v", err)
+	}
+
+	deliveryService := service.NewDeliveryService(deliveryRepo, courierRepo, offerRepo, dispatcher, orderClient)
+
+	port := os.Getenv("PORT")
+	lis, err := net.Listen("tcp", port)
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterDeliveryServiceServer(grpcServer, deliveryService)
+	reflection.Register(grpcServer)
+
+	log.Printf("Delivery service listening on port %s", port)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
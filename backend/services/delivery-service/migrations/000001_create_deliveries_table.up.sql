@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS deliveries (
+    id                  UUID PRIMARY KEY,
+    order_id            UUID UNIQUE NOT NULL,
+    customer_id         VARCHAR(36) NOT NULL,
+    restaurant_id       UUID NOT NULL,
+    courier_id          VARCHAR(36),
+    status              VARCHAR(20) NOT NULL,
+    pickup_latitude     DECIMAL(10,8) NOT NULL,
+    pickup_longitude    DECIMAL(11,8) NOT NULL,
+    dropoff_latitude    DECIMAL(10,8) NOT NULL,
+    dropoff_longitude   DECIMAL(11,8) NOT NULL,
+    assigned_at         TIMESTAMP,
+    picked_up_at        TIMESTAMP,
+    delivered_at        TIMESTAMP,
+    created_at          TIMESTAMP DEFAULT NOW(),
+    updated_at          TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_deliveries_courier_id ON deliveries(courier_id);
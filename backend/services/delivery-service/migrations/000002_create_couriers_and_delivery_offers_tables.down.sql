@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS delivery_offers;
+DROP TABLE IF EXISTS couriers;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS couriers (
+    courier_id              VARCHAR(36) PRIMARY KEY,
+    is_available            BOOLEAN DEFAULT FALSE,
+    latitude                DECIMAL(10,8),
+    longitude               DECIMAL(11,8),
+    location_updated_at     TIMESTAMP,
+    updated_at              TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS delivery_offers (
+    id              UUID PRIMARY KEY,
+    delivery_id     UUID NOT NULL REFERENCES deliveries(id) ON DELETE CASCADE,
+    courier_id      VARCHAR(36) NOT NULL,
+    status          VARCHAR(20) NOT NULL,
+    distance_km     DECIMAL(8,3) NOT NULL,
+    expires_at      TIMESTAMP NOT NULL,
+    responded_at    TIMESTAMP,
+    created_at      TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_delivery_offers_courier_id ON delivery_offers(courier_id, status);
+CREATE INDEX IF NOT EXISTS idx_delivery_offers_delivery_id ON delivery_offers(delivery_id);
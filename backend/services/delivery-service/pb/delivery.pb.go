@@ -0,0 +1,1553 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.4
+// source: delivery.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Messages
+type Delivery struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId          string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId       string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	RestaurantId     string                 `protobuf:"bytes,4,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	CourierId        string                 `protobuf:"bytes,5,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Status           string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	PickupLatitude   float64                `protobuf:"fixed64,7,opt,name=pickup_latitude,json=pickupLatitude,proto3" json:"pickup_latitude,omitempty"`
+	PickupLongitude  float64                `protobuf:"fixed64,8,opt,name=pickup_longitude,json=pickupLongitude,proto3" json:"pickup_longitude,omitempty"`
+	DropoffLatitude  float64                `protobuf:"fixed64,9,opt,name=dropoff_latitude,json=dropoffLatitude,proto3" json:"dropoff_latitude,omitempty"`
+	DropoffLongitude float64                `protobuf:"fixed64,10,opt,name=dropoff_longitude,json=dropoffLongitude,proto3" json:"dropoff_longitude,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Delivery) Reset() {
+	*x = Delivery{}
+	mi := &file_delivery_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Delivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delivery) ProtoMessage() {}
+
+func (x *Delivery) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Delivery.ProtoReflect.Descriptor instead.
+func (*Delivery) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Delivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Delivery) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Delivery) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *Delivery) GetRestaurantId() string {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return ""
+}
+
+func (x *Delivery) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *Delivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Delivery) GetPickupLatitude() float64 {
+	if x != nil {
+		return x.PickupLatitude
+	}
+	return 0
+}
+
+func (x *Delivery) GetPickupLongitude() float64 {
+	if x != nil {
+		return x.PickupLongitude
+	}
+	return 0
+}
+
+func (x *Delivery) GetDropoffLatitude() float64 {
+	if x != nil {
+		return x.DropoffLatitude
+	}
+	return 0
+}
+
+func (x *Delivery) GetDropoffLongitude() float64 {
+	if x != nil {
+		return x.DropoffLongitude
+	}
+	return 0
+}
+
+func (x *Delivery) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Delivery) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// CreateDelivery - Called by order-service once an order is ready for pickup; idempotent per order
+type CreateDeliveryRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrderId          string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId       string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	RestaurantId     string                 `protobuf:"bytes,3,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	PickupLatitude   float64                `protobuf:"fixed64,4,opt,name=pickup_latitude,json=pickupLatitude,proto3" json:"pickup_latitude,omitempty"`
+	PickupLongitude  float64                `protobuf:"fixed64,5,opt,name=pickup_longitude,json=pickupLongitude,proto3" json:"pickup_longitude,omitempty"`
+	DropoffLatitude  float64                `protobuf:"fixed64,6,opt,name=dropoff_latitude,json=dropoffLatitude,proto3" json:"dropoff_latitude,omitempty"`
+	DropoffLongitude float64                `protobuf:"fixed64,7,opt,name=dropoff_longitude,json=dropoffLongitude,proto3" json:"dropoff_longitude,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateDeliveryRequest) Reset() {
+	*x = CreateDeliveryRequest{}
+	mi := &file_delivery_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDeliveryRequest) ProtoMessage() {}
+
+func (x *CreateDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*CreateDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateDeliveryRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CreateDeliveryRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *CreateDeliveryRequest) GetRestaurantId() string {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return ""
+}
+
+func (x *CreateDeliveryRequest) GetPickupLatitude() float64 {
+	if x != nil {
+		return x.PickupLatitude
+	}
+	return 0
+}
+
+func (x *CreateDeliveryRequest) GetPickupLongitude() float64 {
+	if x != nil {
+		return x.PickupLongitude
+	}
+	return 0
+}
+
+func (x *CreateDeliveryRequest) GetDropoffLatitude() float64 {
+	if x != nil {
+		return x.DropoffLatitude
+	}
+	return 0
+}
+
+func (x *CreateDeliveryRequest) GetDropoffLongitude() float64 {
+	if x != nil {
+		return x.DropoffLongitude
+	}
+	return 0
+}
+
+type CreateDeliveryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *Delivery              `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDeliveryResponse) Reset() {
+	*x = CreateDeliveryResponse{}
+	mi := &file_delivery_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDeliveryResponse) ProtoMessage() {}
+
+func (x *CreateDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*CreateDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateDeliveryResponse) GetDelivery() *Delivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+// AssignCourier - A dispatcher hands a pending delivery to a courier, bypassing offers
+type AssignCourierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	CourierId     string                 `protobuf:"bytes,2,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignCourierRequest) Reset() {
+	*x = AssignCourierRequest{}
+	mi := &file_delivery_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignCourierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignCourierRequest) ProtoMessage() {}
+
+func (x *AssignCourierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignCourierRequest.ProtoReflect.Descriptor instead.
+func (*AssignCourierRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AssignCourierRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+func (x *AssignCourierRequest) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+type AssignCourierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *Delivery              `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignCourierResponse) Reset() {
+	*x = AssignCourierResponse{}
+	mi := &file_delivery_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignCourierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignCourierResponse) ProtoMessage() {}
+
+func (x *AssignCourierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignCourierResponse.ProtoReflect.Descriptor instead.
+func (*AssignCourierResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AssignCourierResponse) GetDelivery() *Delivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+// UpdateDeliveryStatus - Courier reports pickup and drop-off; the order status follows
+type UpdateDeliveryStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	CourierId     string                 `protobuf:"bytes,2,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDeliveryStatusRequest) Reset() {
+	*x = UpdateDeliveryStatusRequest{}
+	mi := &file_delivery_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeliveryStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeliveryStatusRequest) ProtoMessage() {}
+
+func (x *UpdateDeliveryStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeliveryStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDeliveryStatusRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateDeliveryStatusRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+func (x *UpdateDeliveryStatusRequest) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *UpdateDeliveryStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpdateDeliveryStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *Delivery              `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDeliveryStatusResponse) Reset() {
+	*x = UpdateDeliveryStatusResponse{}
+	mi := &file_delivery_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeliveryStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeliveryStatusResponse) ProtoMessage() {}
+
+func (x *UpdateDeliveryStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeliveryStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDeliveryStatusResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateDeliveryStatusResponse) GetDelivery() *Delivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+// GetDelivery - Look up a delivery by its id or by the order it belongs to
+type GetDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeliveryRequest) Reset() {
+	*x = GetDeliveryRequest{}
+	mi := &file_delivery_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeliveryRequest) ProtoMessage() {}
+
+func (x *GetDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*GetDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetDeliveryRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+func (x *GetDeliveryRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetDeliveryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *Delivery              `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeliveryResponse) Reset() {
+	*x = GetDeliveryResponse{}
+	mi := &file_delivery_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeliveryResponse) ProtoMessage() {}
+
+func (x *GetDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*GetDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetDeliveryResponse) GetDelivery() *Delivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+type Courier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourierId     string                 `protobuf:"bytes,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	IsAvailable   bool                   `protobuf:"varint,2,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Courier) Reset() {
+	*x = Courier{}
+	mi := &file_delivery_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Courier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Courier) ProtoMessage() {}
+
+func (x *Courier) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Courier.ProtoReflect.Descriptor instead.
+func (*Courier) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Courier) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *Courier) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *Courier) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Courier) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Courier) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// DeliveryOffer - A job offered by the dispatcher to the nearest idle courier
+type DeliveryOffer struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeliveryId       string                 `protobuf:"bytes,2,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	CourierId        string                 `protobuf:"bytes,3,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Status           string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	DistanceKm       float64                `protobuf:"fixed64,5,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	TripDistanceKm   float64                `protobuf:"fixed64,6,opt,name=trip_distance_km,json=tripDistanceKm,proto3" json:"trip_distance_km,omitempty"`
+	ExpiresInSeconds int32                  `protobuf:"varint,7,opt,name=expires_in_seconds,json=expiresInSeconds,proto3" json:"expires_in_seconds,omitempty"`
+	Delivery         *Delivery              `protobuf:"bytes,8,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeliveryOffer) Reset() {
+	*x = DeliveryOffer{}
+	mi := &file_delivery_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryOffer) ProtoMessage() {}
+
+func (x *DeliveryOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryOffer.ProtoReflect.Descriptor instead.
+func (*DeliveryOffer) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeliveryOffer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeliveryOffer) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+func (x *DeliveryOffer) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *DeliveryOffer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeliveryOffer) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *DeliveryOffer) GetTripDistanceKm() float64 {
+	if x != nil {
+		return x.TripDistanceKm
+	}
+	return 0
+}
+
+func (x *DeliveryOffer) GetExpiresInSeconds() int32 {
+	if x != nil {
+		return x.ExpiresInSeconds
+	}
+	return 0
+}
+
+func (x *DeliveryOffer) GetDelivery() *Delivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+func (x *DeliveryOffer) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// SetCourierAvailability - Courier goes on or off shift and reports where they are.
+// Going on shift needs the position; without one the stored position is kept.
+type SetCourierAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourierId     string                 `protobuf:"bytes,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	IsAvailable   bool                   `protobuf:"varint,2,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	Latitude      *float64               `protobuf:"fixed64,3,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64               `protobuf:"fixed64,4,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCourierAvailabilityRequest) Reset() {
+	*x = SetCourierAvailabilityRequest{}
+	mi := &file_delivery_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCourierAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCourierAvailabilityRequest) ProtoMessage() {}
+
+func (x *SetCourierAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCourierAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*SetCourierAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetCourierAvailabilityRequest) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *SetCourierAvailabilityRequest) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *SetCourierAvailabilityRequest) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *SetCourierAvailabilityRequest) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+type SetCourierAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courier       *Courier               `protobuf:"bytes,1,opt,name=courier,proto3" json:"courier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCourierAvailabilityResponse) Reset() {
+	*x = SetCourierAvailabilityResponse{}
+	mi := &file_delivery_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCourierAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCourierAvailabilityResponse) ProtoMessage() {}
+
+func (x *SetCourierAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCourierAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*SetCourierAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetCourierAvailabilityResponse) GetCourier() *Courier {
+	if x != nil {
+		return x.Courier
+	}
+	return nil
+}
+
+type GetCourierOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourierId     string                 `protobuf:"bytes,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourierOfferRequest) Reset() {
+	*x = GetCourierOfferRequest{}
+	mi := &file_delivery_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourierOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourierOfferRequest) ProtoMessage() {}
+
+func (x *GetCourierOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourierOfferRequest.ProtoReflect.Descriptor instead.
+func (*GetCourierOfferRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetCourierOfferRequest) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+// offer is unset when nothing is waiting for the courier
+type GetCourierOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offer         *DeliveryOffer         `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourierOfferResponse) Reset() {
+	*x = GetCourierOfferResponse{}
+	mi := &file_delivery_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourierOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourierOfferResponse) ProtoMessage() {}
+
+func (x *GetCourierOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourierOfferResponse.ProtoReflect.Descriptor instead.
+func (*GetCourierOfferResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetCourierOfferResponse) GetOffer() *DeliveryOffer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+type RespondToOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OfferId       string                 `protobuf:"bytes,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	CourierId     string                 `protobuf:"bytes,2,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Accept        bool                   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToOfferRequest) Reset() {
+	*x = RespondToOfferRequest{}
+	mi := &file_delivery_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToOfferRequest) ProtoMessage() {}
+
+func (x *RespondToOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToOfferRequest.ProtoReflect.Descriptor instead.
+func (*RespondToOfferRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RespondToOfferRequest) GetOfferId() string {
+	if x != nil {
+		return x.OfferId
+	}
+	return ""
+}
+
+func (x *RespondToOfferRequest) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *RespondToOfferRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type RespondToOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offer         *DeliveryOffer         `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToOfferResponse) Reset() {
+	*x = RespondToOfferResponse{}
+	mi := &file_delivery_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToOfferResponse) ProtoMessage() {}
+
+func (x *RespondToOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToOfferResponse.ProtoReflect.Descriptor instead.
+func (*RespondToOfferResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RespondToOfferResponse) GetOffer() *DeliveryOffer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+// UpdateLocation - GPS pings streamed by a courier; every ping must carry the same courier_id
+type LocationUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourierId     string                 `protobuf:"bytes,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationUpdate) Reset() {
+	*x = LocationUpdate{}
+	mi := &file_delivery_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationUpdate) ProtoMessage() {}
+
+func (x *LocationUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationUpdate.ProtoReflect.Descriptor instead.
+func (*LocationUpdate) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LocationUpdate) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *LocationUpdate) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *LocationUpdate) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type UpdateLocationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accepted      int32                  `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLocationResponse) Reset() {
+	*x = UpdateLocationResponse{}
+	mi := &file_delivery_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLocationResponse) ProtoMessage() {}
+
+func (x *UpdateLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLocationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLocationResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateLocationResponse) GetAccepted() int32 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+// WatchDelivery - Streams the delivery's status and its courier's position until it is delivered
+type WatchDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchDeliveryRequest) Reset() {
+	*x = WatchDeliveryRequest{}
+	mi := &file_delivery_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDeliveryRequest) ProtoMessage() {}
+
+func (x *WatchDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*WatchDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WatchDeliveryRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+// DeliveryEvent - A status change or courier ping; has_location is false when the event carries no position
+type DeliveryEvent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId       string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	Status           string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	CourierId        string                 `protobuf:"bytes,3,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	HasLocation      bool                   `protobuf:"varint,4,opt,name=has_location,json=hasLocation,proto3" json:"has_location,omitempty"`
+	CourierLatitude  float64                `protobuf:"fixed64,5,opt,name=courier_latitude,json=courierLatitude,proto3" json:"courier_latitude,omitempty"`
+	CourierLongitude float64                `protobuf:"fixed64,6,opt,name=courier_longitude,json=courierLongitude,proto3" json:"courier_longitude,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeliveryEvent) Reset() {
+	*x = DeliveryEvent{}
+	mi := &file_delivery_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryEvent) ProtoMessage() {}
+
+func (x *DeliveryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryEvent.ProtoReflect.Descriptor instead.
+func (*DeliveryEvent) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeliveryEvent) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+func (x *DeliveryEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeliveryEvent) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *DeliveryEvent) GetHasLocation() bool {
+	if x != nil {
+		return x.HasLocation
+	}
+	return false
+}
+
+func (x *DeliveryEvent) GetCourierLatitude() float64 {
+	if x != nil {
+		return x.CourierLatitude
+	}
+	return 0
+}
+
+func (x *DeliveryEvent) GetCourierLongitude() float64 {
+	if x != nil {
+		return x.CourierLongitude
+	}
+	return 0
+}
+
+func (x *DeliveryEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+var File_delivery_proto protoreflect.FileDescriptor
+
+const file_delivery_proto_rawDesc = "" +
+	"\n" +
+	"\x0edelivery.proto\x12\bdelivery\"\x9c\x03\n" +
+	"\bDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\tR\n" +
+	"customerId\x12#\n" +
+	"\rrestaurant_id\x18\x04 \x01(\tR\frestaurantId\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x05 \x01(\tR\tcourierId\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12'\n" +
+	"\x0fpickup_latitude\x18\a \x01(\x01R\x0epickupLatitude\x12)\n" +
+	"\x10pickup_longitude\x18\b \x01(\x01R\x0fpickupLongitude\x12)\n" +
+	"\x10dropoff_latitude\x18\t \x01(\x01R\x0fdropoffLatitude\x12+\n" +
+	"\x11dropoff_longitude\x18\n" +
+	" \x01(\x01R\x10dropoffLongitude\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\"\xa4\x02\n" +
+	"\x15CreateDeliveryRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12#\n" +
+	"\rrestaurant_id\x18\x03 \x01(\tR\frestaurantId\x12'\n" +
+	"\x0fpickup_latitude\x18\x04 \x01(\x01R\x0epickupLatitude\x12)\n" +
+	"\x10pickup_longitude\x18\x05 \x01(\x01R\x0fpickupLongitude\x12)\n" +
+	"\x10dropoff_latitude\x18\x06 \x01(\x01R\x0fdropoffLatitude\x12+\n" +
+	"\x11dropoff_longitude\x18\a \x01(\x01R\x10dropoffLongitude\"H\n" +
+	"\x16CreateDeliveryResponse\x12.\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x12.delivery.DeliveryR\bdelivery\"V\n" +
+	"\x14AssignCourierRequest\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\tR\n" +
+	"deliveryId\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x02 \x01(\tR\tcourierId\"G\n" +
+	"\x15AssignCourierResponse\x12.\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x12.delivery.DeliveryR\bdelivery\"u\n" +
+	"\x1bUpdateDeliveryStatusRequest\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\tR\n" +
+	"deliveryId\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x02 \x01(\tR\tcourierId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"N\n" +
+	"\x1cUpdateDeliveryStatusResponse\x12.\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x12.delivery.DeliveryR\bdelivery\"P\n" +
+	"\x12GetDeliveryRequest\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\tR\n" +
+	"deliveryId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\"E\n" +
+	"\x13GetDeliveryResponse\x12.\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x12.delivery.DeliveryR\bdelivery\"\xa4\x01\n" +
+	"\aCourier\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x01 \x01(\tR\tcourierId\x12!\n" +
+	"\fis_available\x18\x02 \x01(\bR\visAvailable\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x01R\tlongitude\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\"\xbf\x02\n" +
+	"\rDeliveryOffer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vdelivery_id\x18\x02 \x01(\tR\n" +
+	"deliveryId\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x03 \x01(\tR\tcourierId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdistance_km\x18\x05 \x01(\x01R\n" +
+	"distanceKm\x12(\n" +
+	"\x10trip_distance_km\x18\x06 \x01(\x01R\x0etripDistanceKm\x12,\n" +
+	"\x12expires_in_seconds\x18\a \x01(\x05R\x10expiresInSeconds\x12.\n" +
+	"\bdelivery\x18\b \x01(\v2\x12.delivery.DeliveryR\bdelivery\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"\xc0\x01\n" +
+	"\x1dSetCourierAvailabilityRequest\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x01 \x01(\tR\tcourierId\x12!\n" +
+	"\fis_available\x18\x02 \x01(\bR\visAvailable\x12\x1f\n" +
+	"\blatitude\x18\x03 \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\x04 \x01(\x01H\x01R\tlongitude\x88\x01\x01B\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"M\n" +
+	"\x1eSetCourierAvailabilityResponse\x12+\n" +
+	"\acourier\x18\x01 \x01(\v2\x11.delivery.CourierR\acourier\"7\n" +
+	"\x16GetCourierOfferRequest\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x01 \x01(\tR\tcourierId\"H\n" +
+	"\x17GetCourierOfferResponse\x12-\n" +
+	"\x05offer\x18\x01 \x01(\v2\x17.delivery.DeliveryOfferR\x05offer\"i\n" +
+	"\x15RespondToOfferRequest\x12\x19\n" +
+	"\boffer_id\x18\x01 \x01(\tR\aofferId\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x02 \x01(\tR\tcourierId\x12\x16\n" +
+	"\x06accept\x18\x03 \x01(\bR\x06accept\"G\n" +
+	"\x16RespondToOfferResponse\x12-\n" +
+	"\x05offer\x18\x01 \x01(\v2\x17.delivery.DeliveryOfferR\x05offer\"i\n" +
+	"\x0eLocationUpdate\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x01 \x01(\tR\tcourierId\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\"4\n" +
+	"\x16UpdateLocationResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\x05R\baccepted\"7\n" +
+	"\x14WatchDeliveryRequest\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\tR\n" +
+	"deliveryId\"\x81\x02\n" +
+	"\rDeliveryEvent\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\tR\n" +
+	"deliveryId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x03 \x01(\tR\tcourierId\x12!\n" +
+	"\fhas_location\x18\x04 \x01(\bR\vhasLocation\x12)\n" +
+	"\x10courier_latitude\x18\x05 \x01(\x01R\x0fcourierLatitude\x12+\n" +
+	"\x11courier_longitude\x18\x06 \x01(\x01R\x10courierLongitude\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt2\xa1\x06\n" +
+	"\x0fDeliveryService\x12S\n" +
+	"\x0eCreateDelivery\x12\x1f.delivery.CreateDeliveryRequest\x1a .delivery.CreateDeliveryResponse\x12P\n" +
+	"\rAssignCourier\x12\x1e.delivery.AssignCourierRequest\x1a\x1f.delivery.AssignCourierResponse\x12e\n" +
+	"\x14UpdateDeliveryStatus\x12%.delivery.UpdateDeliveryStatusRequest\x1a&.delivery.UpdateDeliveryStatusResponse\x12J\n" +
+	"\vGetDelivery\x12\x1c.delivery.GetDeliveryRequest\x1a\x1d.delivery.GetDeliveryResponse\x12k\n" +
+	"\x16SetCourierAvailability\x12'.delivery.SetCourierAvailabilityRequest\x1a(.delivery.SetCourierAvailabilityResponse\x12V\n" +
+	"\x0fGetCourierOffer\x12 .delivery.GetCourierOfferRequest\x1a!.delivery.GetCourierOfferResponse\x12S\n" +
+	"\x0eRespondToOffer\x12\x1f.delivery.RespondToOfferRequest\x1a .delivery.RespondToOfferResponse\x12N\n" +
+	"\x0eUpdateLocation\x12\x18.delivery.LocationUpdate\x1a .delivery.UpdateLocationResponse(\x01\x12J\n" +
+	"\rWatchDelivery\x12\x1e.delivery.WatchDeliveryRequest\x1a\x17.delivery.DeliveryEvent0\x01B\x06Z\x04./pbb\x06proto3"
+
+var (
+	file_delivery_proto_rawDescOnce sync.Once
+	file_delivery_proto_rawDescData []byte
+)
+
+func file_delivery_proto_rawDescGZIP() []byte {
+	file_delivery_proto_rawDescOnce.Do(func() {
+		file_delivery_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_delivery_proto_rawDesc), len(file_delivery_proto_rawDesc)))
+	})
+	return file_delivery_proto_rawDescData
+}
+
+var file_delivery_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_delivery_proto_goTypes = []any{
+	(*Delivery)(nil),                       // 0: delivery.Delivery
+	(*CreateDeliveryRequest)(nil),          // 1: delivery.CreateDeliveryRequest
+	(*CreateDeliveryResponse)(nil),         // 2: delivery.CreateDeliveryResponse
+	(*AssignCourierRequest)(nil),           // 3: delivery.AssignCourierRequest
+	(*AssignCourierResponse)(nil),          // 4: delivery.AssignCourierResponse
+	(*UpdateDeliveryStatusRequest)(nil),    // 5: delivery.UpdateDeliveryStatusRequest
+	(*UpdateDeliveryStatusResponse)(nil),   // 6: delivery.UpdateDeliveryStatusResponse
+	(*GetDeliveryRequest)(nil),             // 7: delivery.GetDeliveryRequest
+	(*GetDeliveryResponse)(nil),            // 8: delivery.GetDeliveryResponse
+	(*Courier)(nil),                        // 9: delivery.Courier
+	(*DeliveryOffer)(nil),                  // 10: delivery.DeliveryOffer
+	(*SetCourierAvailabilityRequest)(nil),  // 11: delivery.SetCourierAvailabilityRequest
+	(*SetCourierAvailabilityResponse)(nil), // 12: delivery.SetCourierAvailabilityResponse
+	(*GetCourierOfferRequest)(nil),         // 13: delivery.GetCourierOfferRequest
+	(*GetCourierOfferResponse)(nil),        // 14: delivery.GetCourierOfferResponse
+	(*RespondToOfferRequest)(nil),          // 15: delivery.RespondToOfferRequest
+	(*RespondToOfferResponse)(nil),         // 16: delivery.RespondToOfferResponse
+	(*LocationUpdate)(nil),                 // 17: delivery.LocationUpdate
+	(*UpdateLocationResponse)(nil),         // 18: delivery.UpdateLocationResponse
+	(*WatchDeliveryRequest)(nil),           // 19: delivery.WatchDeliveryRequest
+	(*DeliveryEvent)(nil),                  // 20: delivery.DeliveryEvent
+}
+var file_delivery_proto_depIdxs = []int32{
+	0,  // 0: delivery.CreateDeliveryResponse.delivery:type_name -> delivery.Delivery
+	0,  // 1: delivery.AssignCourierResponse.delivery:type_name -> delivery.Delivery
+	0,  // 2: delivery.UpdateDeliveryStatusResponse.delivery:type_name -> delivery.Delivery
+	0,  // 3: delivery.GetDeliveryResponse.delivery:type_name -> delivery.Delivery
+	0,  // 4: delivery.DeliveryOffer.delivery:type_name -> delivery.Delivery
+	9,  // 5: delivery.SetCourierAvailabilityResponse.courier:type_name -> delivery.Courier
+	10, // 6: delivery.GetCourierOfferResponse.offer:type_name -> delivery.DeliveryOffer
+	10, // 7: delivery.RespondToOfferResponse.offer:type_name -> delivery.DeliveryOffer
+	1,  // 8: delivery.DeliveryService.CreateDelivery:input_type -> delivery.CreateDeliveryRequest
+	3,  // 9: delivery.DeliveryService.AssignCourier:input_type -> delivery.AssignCourierRequest
+	5,  // 10: delivery.DeliveryService.UpdateDeliveryStatus:input_type -> delivery.UpdateDeliveryStatusRequest
+	7,  // 11: delivery.DeliveryService.GetDelivery:input_type -> delivery.GetDeliveryRequest
+	11, // 12: delivery.DeliveryService.SetCourierAvailability:input_type -> delivery.SetCourierAvailabilityRequest
+	13, // 13: delivery.DeliveryService.GetCourierOffer:input_type -> delivery.GetCourierOfferRequest
+	15, // 14: delivery.DeliveryService.RespondToOffer:input_type -> delivery.RespondToOfferRequest
+	17, // 15: delivery.DeliveryService.UpdateLocation:input_type -> delivery.LocationUpdate
+	19, // 16: delivery.DeliveryService.WatchDelivery:input_type -> delivery.WatchDeliveryRequest
+	2,  // 17: delivery.DeliveryService.CreateDelivery:output_type -> delivery.CreateDeliveryResponse
+	4,  // 18: delivery.DeliveryService.AssignCourier:output_type -> delivery.AssignCourierResponse
+	6,  // 19: delivery.DeliveryService.UpdateDeliveryStatus:output_type -> delivery.UpdateDeliveryStatusResponse
+	8,  // 20: delivery.DeliveryService.GetDelivery:output_type -> delivery.GetDeliveryResponse
+	12, // 21: delivery.DeliveryService.SetCourierAvailability:output_type -> delivery.SetCourierAvailabilityResponse
+	14, // 22: delivery.DeliveryService.GetCourierOffer:output_type -> delivery.GetCourierOfferResponse
+	16, // 23: delivery.DeliveryService.RespondToOffer:output_type -> delivery.RespondToOfferResponse
+	18, // 24: delivery.DeliveryService.UpdateLocation:output_type -> delivery.UpdateLocationResponse
+	20, // 25: delivery.DeliveryService.WatchDelivery:output_type -> delivery.DeliveryEvent
+	17, // [17:26] is the sub-list for method output_type
+	8,  // [8:17] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_delivery_proto_init() }
+func file_delivery_proto_init() {
+	if File_delivery_proto != nil {
+		return
+	}
+	file_delivery_proto_msgTypes[11].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_delivery_proto_rawDesc), len(file_delivery_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_delivery_proto_goTypes,
+		DependencyIndexes: file_delivery_proto_depIdxs,
+		MessageInfos:      file_delivery_proto_msgTypes,
+	}.Build()
+	File_delivery_proto = out.File
+	file_delivery_proto_goTypes = nil
+	file_delivery_proto_depIdxs = nil
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CourierRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCourierRepository(db *pgxpool.Pool) *CourierRepository {
+	return &CourierRepository{db: db}
+}
+
+type Courier struct {
+	CourierID   string
+	IsAvailable bool
+	Latitude    float64
+	Longitude   float64
+	HasLocation bool
+	UpdatedAt   string
+}
+
+// SetAvailability puts the courier on or off shift. Their position is only
+// updated when courier.HasLocation is set.
+func (r *CourierRepository) SetAvailability(ctx context.Context, courier *Courier) error {
+	query := `
+		INSERT INTO couriers (courier_id, is_available, latitude, longitude, location_updated_at, updated_at)
+		VALUES ($1, $2, CASE WHEN $5 THEN $3::DECIMAL END, CASE WHEN $5 THEN $4::DECIMAL END,
+		        CASE WHEN $5 THEN NOW() END, NOW())
+		ON CONFLICT (courier_id) DO UPDATE
+		SET is_available = EXCLUDED.is_available,
+		    latitude = COALESCE(EXCLUDED.latitude, couriers.latitude),
+		    longitude = COALESCE(EXCLUDED.longitude, couriers.longitude),
+		    location_updated_at = COALESCE(EXCLUDED.location_updated_at, couriers.location_updated_at),
+		    updated_at = NOW()
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		courier.CourierID, courier.IsAvailable, courier.Latitude, courier.Longitude, courier.HasLocation,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set courier availability: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateLocation records the courier's last known position without changing
+// whether they are on shift.
+func (r *CourierRepository) UpdateLocation(ctx context.Context, courierID string, latitude, longitude float64) error {
+	query := `
+		INSERT INTO couriers (courier_id, is_available, latitude, longitude, location_updated_at, updated_at)
+		VALUES ($1, FALSE, $2, $3, NOW(), NOW())
+		ON CONFLICT (courier_id) DO UPDATE
+		SET latitude = EXCLUDED.latitude,
+		    longitude = EXCLUDED.longitude,
+		    location_updated_at = NOW(),
+		    updated_at = NOW()
+	`
+
+	_, err := r.db.Exec(ctx, query, courierID, latitude, longitude)
+	if err != nil {
+		return fmt.Errorf("failed to update courier location: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CourierRepository) GetByID(ctx context.Context, courierID string) (*Courier, error) {
+	var courier Courier
+
+	query := `
+		SELECT courier_id, is_available, COALESCE(latitude, 0), COALESCE(longitude, 0),
+		       latitude IS NOT NULL, to_char(updated_at, 'YYYY-MM-DD HH24:MI:SS')
+		FROM couriers
+		WHERE courier_id = $1
+	`
+
+	err := r.db.QueryRow(ctx, query, courierID).Scan(
+		&courier.CourierID, &courier.IsAvailable,
+		&courier.Latitude, &courier.Longitude, &courier.HasLocation, &courier.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get courier: %w", err)
+	}
+
+	return &courier, nil
+}
+
+// GetIdle returns couriers that are on shift, have a known position and are
+// neither delivering nor holding an open offer.
+func (r *CourierRepository) GetIdle(ctx context.Context) ([]*Courier, error) {
+	query := `
+		SELECT c.courier_id, c.is_available, c.latitude, c.longitude,
+		       TRUE, to_char(c.updated_at, 'YYYY-MM-DD HH24:MI:SS')
+		FROM couriers c
+		WHERE c.is_available
+		  AND c.latitude IS NOT NULL AND c.longitude IS NOT NULL
+		  AND NOT EXISTS (
+		      SELECT 1 FROM deliveries d
+		      WHERE d.courier_id = c.courier_id AND d.status IN ('assigned', 'picked_up')
+		  )
+		  AND NOT EXISTS (
+		      SELECT 1 FROM delivery_offers o
+		      WHERE o.courier_id = c.courier_id AND o.status = 'pending' AND o.expires_at > NOW()
+		  )
+	`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query idle couriers: %w", err)
+	}
+	defer rows.Close()
+
+	couriers := []*Courier{}
+	for rows.Next() {
+		var courier Courier
+		err := rows.Scan(
+			&courier.CourierID, &courier.IsAvailable,
+			&courier.Latitude, &courier.Longitude, &courier.HasLocation, &courier.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan courier: %w", err)
+		}
+		couriers = append(couriers, &courier)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating couriers: %w", err)
+	}
+
+	return couriers, nil
+}
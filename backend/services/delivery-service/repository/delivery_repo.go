@@ -1 +1,196 @@
 package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type DeliveryRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewDeliveryRepository(db *pgxpool.Pool) *DeliveryRepository {
+	return &DeliveryRepository{db: db}
+}
+
+type Delivery struct {
+	ID               string
+	OrderID          string
+	CustomerID       string
+	RestaurantID     string
+	CourierID        string
+	Status           string
+	PickupLatitude   float64
+	PickupLongitude  float64
+	DropoffLatitude  float64
+	DropoffLongitude float64
+	CreatedAt        string
+	UpdatedAt        string
+}
+
+const deliveryColumns = `
+	id, order_id, customer_id, restaurant_id, COALESCE(courier_id, ''), status,
+	pickup_latitude, pickup_longitude, dropoff_latitude, dropoff_longitude,
+	to_char(created_at, 'YYYY-MM-DD HH24:MI:SS'),
+	to_char(updated_at, 'YYYY-MM-DD HH24:MI:SS')
+`
+
+// Create stores a new delivery. A second delivery for the same order is
+// ignored, so callers can safely retry.
+func (r *DeliveryRepository) Create(ctx context.Context, delivery *Delivery) error {
+	query := `
+		INSERT INTO deliveries (id, order_id, customer_id, restaurant_id, status,
+		                        pickup_latitude, pickup_longitude, dropoff_latitude, dropoff_longitude,
+		                        created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
+		ON CONFLICT (order_id) DO NOTHING
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		delivery.ID, delivery.OrderID, delivery.CustomerID, delivery.RestaurantID, delivery.Status,
+		delivery.PickupLatitude, delivery.PickupLongitude,
+		delivery.DropoffLatitude, delivery.DropoffLongitude,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (r *DeliveryRepository) GetByID(ctx context.Context, id string) (*Delivery, error) {
+	query := `SELECT ` + deliveryColumns + ` FROM deliveries WHERE id = $1`
+
+	delivery, err := scanDelivery(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+func (r *DeliveryRepository) GetByOrderID(ctx context.Context, orderID string) (*Delivery, error) {
+	query := `SELECT ` + deliveryColumns + ` FROM deliveries WHERE order_id = $1`
+
+	delivery, err := scanDelivery(r.db.QueryRow(ctx, query, orderID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+// GetActiveByCourierID returns the deliveries the courier is currently working on.
+func (r *DeliveryRepository) GetActiveByCourierID(ctx context.Context, courierID string) ([]*Delivery, error) {
+	query := `SELECT ` + deliveryColumns + ` FROM deliveries WHERE courier_id = $1 AND status IN ('assigned', 'picked_up')`
+
+	return r.queryDeliveries(ctx, query, courierID)
+}
+
+// GetByStatus returns deliveries in status, oldest first.
+func (r *DeliveryRepository) GetByStatus(ctx context.Context, status string) ([]*Delivery, error) {
+	query := `SELECT ` + deliveryColumns + ` FROM deliveries WHERE status = $1 ORDER BY created_at`
+
+	return r.queryDeliveries(ctx, query, status)
+}
+
+// AssignCourier sets the courier of a delivery that is still waiting for one.
+// It reports false when the delivery already has a courier.
+func (r *DeliveryRepository) AssignCourier(ctx context.Context, id, courierID, fromStatus, status string) (bool, error) {
+	query := `
+		UPDATE deliveries
+		SET courier_id = $1, status = $2, assigned_at = NOW(), updated_at = NOW()
+		WHERE id = $3 AND status = $4 AND courier_id IS NULL
+	`
+
+	tag, err := r.db.Exec(ctx, query, courierID, status, id, fromStatus)
+	if err != nil {
+		return false, fmt.Errorf("failed to assign courier: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// UpdateStatus moves the delivery to status only if it is still in fromStatus
+// and stamps the matching picked_up_at or delivered_at column.
+func (r *DeliveryRepository) UpdateStatus(ctx context.Context, id, fromStatus, status string) (bool, error) {
+	query := `
+		UPDATE deliveries
+		SET status = $1,
+		    picked_up_at = CASE WHEN $1 = 'picked_up' THEN NOW() ELSE picked_up_at END,
+		    delivered_at = CASE WHEN $1 = 'delivered' THEN NOW() ELSE delivered_at END,
+		    updated_at = NOW()
+		WHERE id = $2 AND status = $3
+	`
+
+	tag, err := r.db.Exec(ctx, query, status, id, fromStatus)
+	if err != nil {
+		return false, fmt.Errorf("failed to update delivery status: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// RevertStatus moves a delivery back from fromStatus to an earlier status
+// and clears the times of the steps it undoes
+func (r *DeliveryRepository) RevertStatus(ctx context.Context, id, fromStatus, status string) (bool, error) {
+	query := `
+		UPDATE deliveries
+		SET status = $1,
+		    picked_up_at = CASE WHEN $1 IN ('pending', 'assigned') THEN NULL ELSE picked_up_at END,
+		    delivered_at = CASE WHEN $1 <> 'delivered' THEN NULL ELSE delivered_at END,
+		    updated_at = NOW()
+		WHERE id = $2 AND status = $3
+	`
+
+	tag, err := r.db.Exec(ctx, query, status, id, fromStatus)
+	if err != nil {
+		return false, fmt.Errorf("failed to revert delivery status: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *DeliveryRepository) queryDeliveries(ctx context.Context, query string, args ...any) ([]*Delivery, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []*Delivery{}
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanDelivery(row rowScanner) (*Delivery, error) {
+	var delivery Delivery
+	err := row.Scan(
+		&delivery.ID, &delivery.OrderID, &delivery.CustomerID, &delivery.RestaurantID,
+		&delivery.CourierID, &delivery.Status,
+		&delivery.PickupLatitude, &delivery.PickupLongitude,
+		&delivery.DropoffLatitude, &delivery.DropoffLongitude,
+		&delivery.CreatedAt, &delivery.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrCourierBusy is returned by OfferRepository.Create when the courier went
+// offline, took a delivery or got another offer since they were picked
+var ErrCourierBusy = errors.New("courier is no longer idle")
+
+type OfferRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewOfferRepository(db *pgxpool.Pool) *OfferRepository {
+	return &OfferRepository{db: db}
+}
+
+type Offer struct {
+	ID               string
+	DeliveryID       string
+	CourierID        string
+	Status           string
+	DistanceKm       float64
+	ExpiresInSeconds int32
+	CreatedAt        string
+}
+
+const offerColumns = `
+	id, delivery_id, courier_id, status, distance_km,
+	GREATEST(CEIL(EXTRACT(EPOCH FROM expires_at - NOW())), 0)::int,
+	to_char(created_at, 'YYYY-MM-DD HH24:MI:SS')
+`
+
+// Create stores an offer that expires timeout from now. The courier row is
+// locked first and their idleness re-checked after, in statements of their
+// own so they see offers committed while waiting for the lock; two
+// dispatchers cannot offer the same courier at once. ErrCourierBusy means
+// someone else got there first.
+func (r *OfferRepository) Create(ctx context.Context, offer *Offer, timeout time.Duration) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	lockQuery := `SELECT 1 FROM couriers WHERE courier_id = $1 AND is_available FOR UPDATE`
+
+	var available int
+	err = tx.QueryRow(ctx, lockQuery, offer.CourierID).Scan(&available)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrCourierBusy
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock courier: %w", err)
+	}
+
+	deliveryQuery := `
+		SELECT EXISTS (
+			SELECT 1 FROM deliveries
+			WHERE courier_id = $1 AND status IN ('assigned', 'picked_up')
+		)
+	`
+
+	var busy bool
+	if err := tx.QueryRow(ctx, deliveryQuery, offer.CourierID).Scan(&busy); err != nil {
+		return fmt.Errorf("failed to check deliveries of courier: %w", err)
+	}
+	if busy {
+		return ErrCourierBusy
+	}
+
+	pendingQuery := `
+		SELECT EXISTS (
+			SELECT 1 FROM delivery_offers
+			WHERE courier_id = $1 AND status = 'pending' AND expires_at > NOW()
+		)
+	`
+
+	if err := tx.QueryRow(ctx, pendingQuery, offer.CourierID).Scan(&busy); err != nil {
+		return fmt.Errorf("failed to check offers of courier: %w", err)
+	}
+	if busy {
+		return ErrCourierBusy
+	}
+
+	query := `
+		INSERT INTO delivery_offers (id, delivery_id, courier_id, status, distance_km, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW() + make_interval(secs => $6), NOW())
+	`
+
+	_, err = tx.Exec(ctx, query,
+		offer.ID, offer.DeliveryID, offer.CourierID,
+		offer.Status, offer.DistanceKm, timeout.Seconds(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create delivery offer: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *OfferRepository) GetByID(ctx context.Context, id string) (*Offer, error) {
+	query := `SELECT ` + offerColumns + `
+		FROM delivery_offers
+		WHERE id = $1
+	`
+
+	offer, err := scanOffer(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delivery offer: %w", err)
+	}
+
+	return offer, nil
+}
+
+// GetOpenForCourier returns the courier's unexpired offer awaiting an answer.
+func (r *OfferRepository) GetOpenForCourier(ctx context.Context, courierID, status string) (*Offer, error) {
+	query := `SELECT ` + offerColumns + `
+		FROM delivery_offers
+		WHERE courier_id = $1 AND status = $2 AND expires_at > NOW()
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+
+	offer, err := scanOffer(r.db.QueryRow(ctx, query, courierID, status))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delivery offer: %w", err)
+	}
+
+	return offer, nil
+}
+
+// Resolve moves the offer out of fromStatus. It reports false when the offer
+// was already resolved, e.g. it expired while the courier was answering.
+func (r *OfferRepository) Resolve(ctx context.Context, id, fromStatus, status string) (bool, error) {
+	query := `
+		UPDATE delivery_offers
+		SET status = $1, responded_at = NOW()
+		WHERE id = $2 AND status = $3
+	`
+
+	tag, err := r.db.Exec(ctx, query, status, id, fromStatus)
+	if err != nil {
+		return false, fmt.Errorf("failed to resolve delivery offer: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func scanOffer(row rowScanner) (*Offer, error) {
+	var offer Offer
+	err := row.Scan(
+		&offer.ID, &offer.DeliveryID, &offer.CourierID, &offer.Status,
+		&offer.DistanceKm, &offer.ExpiresInSeconds, &offer.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &offer, nil
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// testDB connects to TEST_DATABASE_URL and migrates it; tests that need a
+// database are skipped without one
+func testDB(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	m, err := migrate.New("file://../migrations", url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		t.Fatal(err)
+	}
+
+	db, err := pgxpool.New(context.Background(), url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(db.Close)
+
+	return db
+}
+
+func TestOfferCreateWaitsForConcurrentOffer(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	repo := NewOfferRepository(db)
+
+	courierID := uuid.New().String()
+	deliveryIDs := []string{uuid.New().String(), uuid.New().String()}
+
+	if _, err := db.Exec(ctx, `INSERT INTO couriers (courier_id, is_available) VALUES ($1, TRUE)`, courierID); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range deliveryIDs {
+		_, err := db.Exec(ctx, `
+			INSERT INTO deliveries (id, order_id, customer_id, restaurant_id, status,
+			                        pickup_latitude, pickup_longitude, dropoff_latitude, dropoff_longitude)
+			VALUES ($1, $2, 'customer', $3, 'pending', 0, 0, 0, 0)
+		`, id, uuid.New().String(), uuid.New().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Cleanup(func() {
+		db.Exec(ctx, `DELETE FROM deliveries WHERE id = ANY($1)`, deliveryIDs)
+		db.Exec(ctx, `DELETE FROM couriers WHERE courier_id = $1`, courierID)
+	})
+
+	// the first dispatcher holds the courier and has written its offer, but
+	// not committed yet
+	first, err := db.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer first.Rollback(ctx)
+
+	if _, err := first.Exec(ctx, `SELECT 1 FROM couriers WHERE courier_id = $1 FOR UPDATE`, courierID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = first.Exec(ctx, `
+		INSERT INTO delivery_offers (id, delivery_id, courier_id, status, distance_km, expires_at)
+		VALUES ($1, $2, $3, 'pending', 1, NOW() + INTERVAL '1 minute')
+	`, uuid.New().String(), deliveryIDs[0], courierID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- repo.Create(ctx, &Offer{
+			ID:         uuid.New().String(),
+			DeliveryID: deliveryIDs[1],
+			CourierID:  courierID,
+			Status:     "pending",
+			DistanceKm: 1,
+		}, time.Minute)
+	}()
+
+	// let the second dispatcher queue up behind the lock
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		var waiting bool
+		err := db.QueryRow(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM pg_stat_activity
+				WHERE wait_event_type = 'Lock' AND query LIKE '%FROM couriers WHERE courier_id%'
+			)
+		`).Scan(&waiting)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if waiting {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("second offer never waited for the courier lock")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if err := first.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := <-done; !errors.Is(err, ErrCourierBusy) {
+		t.Fatalf("Create = %v, want ErrCourierBusy", err)
+	}
+
+	var pending int
+	err = db.QueryRow(ctx, `SELECT COUNT(*) FROM delivery_offers WHERE courier_id = $1 AND status = 'pending'`, courierID).Scan(&pending)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pending != 1 {
+		t.Errorf("courier has %d pending offers, want 1", pending)
+	}
+}
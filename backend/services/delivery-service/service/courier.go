@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kimashii-dan/food-delivery-app/backend/pkg"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *DeliveryService) SetCourierAvailability(ctx context.Context, req *pb.SetCourierAvailabilityRequest) (*pb.SetCourierAvailabilityResponse, error) {
+	if req.CourierId == "" {
+		return nil, status.Error(codes.InvalidArgument, "courier id is required")
+	}
+
+	// without a position the stored one is kept, but a courier on shift must
+	// have one to be ranked by distance
+	hasLocation := req.Latitude != nil && req.Longitude != nil
+	if (req.Latitude != nil) != (req.Longitude != nil) {
+		return nil, status.Error(codes.InvalidArgument, "latitude and longitude go together")
+	}
+	if req.IsAvailable && !hasLocation {
+		return nil, status.Error(codes.InvalidArgument, "latitude and longitude are required to go on shift")
+	}
+	if hasLocation && (req.GetLatitude() < -90 || req.GetLatitude() > 90 || req.GetLongitude() < -180 || req.GetLongitude() > 180) {
+		return nil, status.Error(codes.InvalidArgument, "invalid coordinates")
+	}
+
+	err := s.courierRepo.SetAvailability(ctx, &repository.Courier{
+		CourierID:   req.CourierId,
+		IsAvailable: req.IsAvailable,
+		Latitude:    req.GetLatitude(),
+		Longitude:   req.GetLongitude(),
+		HasLocation: hasLocation,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set courier availability: %w", err)
+	}
+
+	courier, err := s.courierRepo.GetByID(ctx, req.CourierId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get courier: %w", err)
+	}
+
+	return &pb.SetCourierAvailabilityResponse{
+		Courier: &pb.Courier{
+			CourierId:   courier.CourierID,
+			IsAvailable: courier.IsAvailable,
+			Latitude:    courier.Latitude,
+			Longitude:   courier.Longitude,
+			UpdatedAt:   courier.UpdatedAt,
+		},
+	}, nil
+}
+
+func (s *DeliveryService) GetCourierOffer(ctx context.Context, req *pb.GetCourierOfferRequest) (*pb.GetCourierOfferResponse, error) {
+	if req.CourierId == "" {
+		return nil, status.Error(codes.InvalidArgument, "courier id is required")
+	}
+
+	offer, err := s.offerRepo.GetOpenForCourier(ctx, req.CourierId, OfferPending)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &pb.GetCourierOfferResponse{}, nil
+		}
+		return nil, fmt.Errorf("failed to get courier offer: %w", err)
+	}
+
+	pbOffer, err := s.toPbOffer(ctx, offer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCourierOfferResponse{
+		Offer: pbOffer,
+	}, nil
+}
+
+func (s *DeliveryService) RespondToOffer(ctx context.Context, req *pb.RespondToOfferRequest) (*pb.RespondToOfferResponse, error) {
+	if req.OfferId == "" || req.CourierId == "" {
+		return nil, status.Error(codes.InvalidArgument, "offer id and courier id are required")
+	}
+
+	offer, err := s.offerRepo.GetByID(ctx, req.OfferId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "offer not found")
+		}
+		return nil, fmt.Errorf("failed to get offer: %w", err)
+	}
+
+	if offer.CourierID != req.CourierId {
+		return nil, status.Error(codes.NotFound, "offer not found")
+	}
+
+	if offer.Status != OfferPending || offer.ExpiresInSeconds == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "offer is no longer open")
+	}
+
+	to := OfferDeclined
+	if req.Accept {
+		to = OfferAccepted
+	}
+
+	resolved, err := s.offerRepo.Resolve(ctx, offer.ID, OfferPending, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to respond to offer: %w", err)
+	}
+	if !resolved {
+		return nil, status.Error(codes.FailedPrecondition, "offer is no longer open")
+	}
+
+	if req.Accept {
+		if _, err := s.assignCourier(ctx, offer.DeliveryID, offer.CourierID); err != nil {
+			s.dispatcher.Answer(offer.ID, false)
+			return nil, err
+		}
+	}
+	s.dispatcher.Answer(offer.ID, req.Accept)
+
+	offer.Status = to
+	pbOffer, err := s.toPbOffer(ctx, offer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RespondToOfferResponse{
+		Offer: pbOffer,
+	}, nil
+}
+
+// toPbOffer attaches the offered delivery and how far the courier would ride
+// from the restaurant to the customer.
+func (s *DeliveryService) toPbOffer(ctx context.Context, offer *repository.Offer) (*pb.DeliveryOffer, error) {
+	delivery, err := s.getDelivery(ctx, offer.DeliveryID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeliveryOffer{
+		Id:               offer.ID,
+		DeliveryId:       offer.DeliveryID,
+		CourierId:        offer.CourierID,
+		Status:           offer.Status,
+		DistanceKm:       offer.DistanceKm,
+		TripDistanceKm:   pkg.HaversineKm(delivery.PickupLatitude, delivery.PickupLongitude, delivery.DropoffLatitude, delivery.DropoffLongitude),
+		ExpiresInSeconds: offer.ExpiresInSeconds,
+		Delivery:         toPbDelivery(delivery),
+		CreatedAt:        offer.CreatedAt,
+	}, nil
+}
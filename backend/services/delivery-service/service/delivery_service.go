@@ -1 +1,259 @@
 package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service/repository"
+	orderpb "github.com/kimashii-dan/food-delivery-app/backend/services/order-service/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Delivery statuses
+const (
+	StatusPending   = "pending"
+	StatusAssigned  = "assigned"
+	StatusPickedUp  = "picked_up"
+	StatusDelivered = "delivered"
+)
+
+// courierTransitions lists the statuses a courier can move their delivery to.
+var courierTransitions = map[string]string{
+	StatusAssigned: StatusPickedUp,
+	StatusPickedUp: StatusDelivered,
+}
+
+const roleCourier = "courier"
+
+type DeliveryService struct {
+	pb.UnimplementedDeliveryServiceServer
+	deliveryRepo *repository.DeliveryRepository
+	courierRepo  *repository.CourierRepository
+	offerRepo    *repository.OfferRepository
+	dispatcher   *Dispatcher
+	hub          *Hub
+	orderClient  orderpb.OrderServiceClient
+}
+
+func NewDeliveryService(
+	deliveryRepo *repository.DeliveryRepository,
+	courierRepo *repository.CourierRepository,
+	offerRepo *repository.OfferRepository,
+	dispatcher *Dispatcher,
+	orderClient orderpb.OrderServiceClient,
+) *DeliveryService {
+	return &DeliveryService{
+		deliveryRepo: deliveryRepo,
+		courierRepo:  courierRepo,
+		offerRepo:    offerRepo,
+		dispatcher:   dispatcher,
+		hub:          NewHub(),
+		orderClient:  orderClient,
+	}
+}
+
+func (s *DeliveryService) CreateDelivery(ctx context.Context, req *pb.CreateDeliveryRequest) (*pb.CreateDeliveryResponse, error) {
+	if req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order id is required")
+	}
+
+	if req.CustomerId == "" || req.RestaurantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "customer id and restaurant id are required")
+	}
+
+	delivery := &repository.Delivery{
+		ID:               uuid.New().String(),
+		OrderID:          req.OrderId,
+		CustomerID:       req.CustomerId,
+		RestaurantID:     req.RestaurantId,
+		Status:           StatusPending,
+		PickupLatitude:   req.PickupLatitude,
+		PickupLongitude:  req.PickupLongitude,
+		DropoffLatitude:  req.DropoffLatitude,
+		DropoffLongitude: req.DropoffLongitude,
+	}
+
+	if err := s.deliveryRepo.Create(ctx, delivery); err != nil {
+		return nil, fmt.Errorf("failed to create delivery: %w", err)
+	}
+
+	// Read back by order so a retried call returns the delivery created first
+	created, err := s.deliveryRepo.GetByOrderID(ctx, req.OrderId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get created delivery: %w", err)
+	}
+
+	if created.Status == StatusPending {
+		s.dispatcher.Dispatch(created)
+	}
+
+	return &pb.CreateDeliveryResponse{
+		Delivery: toPbDelivery(created),
+	}, nil
+}
+
+// AssignCourier lets a dispatcher pick the courier; the gateway only lets
+// admins call it
+func (s *DeliveryService) AssignCourier(ctx context.Context, req *pb.AssignCourierRequest) (*pb.AssignCourierResponse, error) {
+	if req.DeliveryId == "" || req.CourierId == "" {
+		return nil, status.Error(codes.InvalidArgument, "delivery id and courier id are required")
+	}
+
+	delivery, err := s.assignCourier(ctx, req.DeliveryId, req.CourierId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AssignCourierResponse{
+		Delivery: toPbDelivery(delivery),
+	}, nil
+}
+
+func (s *DeliveryService) UpdateDeliveryStatus(ctx context.Context, req *pb.UpdateDeliveryStatusRequest) (*pb.UpdateDeliveryStatusResponse, error) {
+	if req.DeliveryId == "" || req.CourierId == "" {
+		return nil, status.Error(codes.InvalidArgument, "delivery id and courier id are required")
+	}
+
+	delivery, err := s.getDelivery(ctx, req.DeliveryId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if delivery.CourierID != req.CourierId {
+		return nil, status.Error(codes.PermissionDenied, "delivery is assigned to another courier")
+	}
+
+	if next, ok := courierTransitions[delivery.Status]; !ok || next != req.Status {
+		return nil, status.Errorf(codes.FailedPrecondition, "delivery cannot move from %s to %s", delivery.Status, req.Status)
+	}
+
+	// Claim the transition first, so of two concurrent requests only one
+	// reaches order-service
+	updated, err := s.deliveryRepo.UpdateStatus(ctx, delivery.ID, delivery.Status, req.Status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update delivery status: %w", err)
+	}
+	if !updated {
+		return nil, status.Error(codes.Aborted, "delivery status changed concurrently, retry")
+	}
+
+	// The order follows the delivery; order-service checks the courier is assigned
+	_, err = s.orderClient.UpdateOrderStatus(ctx, &orderpb.UpdateOrderStatusRequest{
+		OrderId:     delivery.OrderID,
+		Status:      req.Status,
+		ActorUserId: req.CourierId,
+		ActorRole:   roleCourier,
+	})
+	if err != nil {
+		// Undo only what order-service refused; after a timeout or an
+		// unreachable service it may have applied the change
+		if orderRefused(err) {
+			if _, revertErr := s.deliveryRepo.RevertStatus(ctx, delivery.ID, req.Status, delivery.Status); revertErr != nil {
+				log.Printf("Failed to revert delivery %s to %s: %v", delivery.ID, delivery.Status, revertErr)
+			}
+		} else {
+			log.Printf("Order %s may not follow delivery %s to %s: %v", delivery.OrderID, delivery.ID, req.Status, err)
+		}
+		return nil, err
+	}
+
+	delivery, err = s.deliveryRepo.GetByID(ctx, delivery.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get updated delivery: %w", err)
+	}
+
+	s.hub.Publish(newDeliveryEvent(delivery.ID, delivery.Status, delivery.CourierID))
+
+	return &pb.UpdateDeliveryStatusResponse{
+		Delivery: toPbDelivery(delivery),
+	}, nil
+}
+
+// orderRefused reports whether order-service answered and rejected a
+// status change, as opposed to failing to answer
+func orderRefused(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.PermissionDenied,
+		codes.FailedPrecondition, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *DeliveryService) GetDelivery(ctx context.Context, req *pb.GetDeliveryRequest) (*pb.GetDeliveryResponse, error) {
+	if req.DeliveryId == "" && req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "delivery id or order id is required")
+	}
+
+	delivery, err := s.getDelivery(ctx, req.DeliveryId, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetDeliveryResponse{
+		Delivery: toPbDelivery(delivery),
+	}, nil
+}
+
+func (s *DeliveryService) assignCourier(ctx context.Context, deliveryID, courierID string) (*repository.Delivery, error) {
+	assigned, err := s.deliveryRepo.AssignCourier(ctx, deliveryID, courierID, StatusPending, StatusAssigned)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign courier: %w", err)
+	}
+
+	delivery, err := s.getDelivery(ctx, deliveryID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if !assigned {
+		return nil, status.Errorf(codes.FailedPrecondition, "delivery in status %s cannot take a courier", delivery.Status)
+	}
+
+	s.hub.Publish(newDeliveryEvent(delivery.ID, delivery.Status, delivery.CourierID))
+
+	return delivery, nil
+}
+
+func (s *DeliveryService) getDelivery(ctx context.Context, deliveryID, orderID string) (*repository.Delivery, error) {
+	var delivery *repository.Delivery
+	var err error
+	if deliveryID != "" {
+		delivery, err = s.deliveryRepo.GetByID(ctx, deliveryID)
+	} else {
+		delivery, err = s.deliveryRepo.GetByOrderID(ctx, orderID)
+	}
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "delivery not found")
+		}
+		return nil, fmt.Errorf("failed to get delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+func toPbDelivery(delivery *repository.Delivery) *pb.Delivery {
+	return &pb.Delivery{
+		Id:               delivery.ID,
+		OrderId:          delivery.OrderID,
+		CustomerId:       delivery.CustomerID,
+		RestaurantId:     delivery.RestaurantID,
+		CourierId:        delivery.CourierID,
+		Status:           delivery.Status,
+		PickupLatitude:   delivery.PickupLatitude,
+		PickupLongitude:  delivery.PickupLongitude,
+		DropoffLatitude:  delivery.DropoffLatitude,
+		DropoffLongitude: delivery.DropoffLongitude,
+		CreatedAt:        delivery.CreatedAt,
+		UpdatedAt:        delivery.UpdatedAt,
+	}
+}
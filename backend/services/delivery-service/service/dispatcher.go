@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kimashii-dan/food-delivery-app/backend/pkg"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service/repository"
+)
+
+// Offer statuses
+const (
+	OfferPending  = "pending"
+	OfferAccepted = "accepted"
+	OfferDeclined = "declined"
+	OfferExpired  = "expired"
+)
+
+// Dispatcher finds couriers for deliveries. It offers each delivery to the
+// idle courier nearest to the restaurant and moves on to the next nearest one
+// when the offer is declined or times out.
+type Dispatcher struct {
+	deliveryRepo  *repository.DeliveryRepository
+	courierRepo   *repository.CourierRepository
+	offerRepo     *repository.OfferRepository
+	offerTimeout  time.Duration
+	retryInterval time.Duration
+
+	mu      sync.Mutex
+	answers map[string]chan bool // open offer id -> courier's answer
+	running map[string]bool      // delivery ids being dispatched
+}
+
+func NewDispatcher(
+	deliveryRepo *repository.DeliveryRepository,
+	courierRepo *repository.CourierRepository,
+	offerRepo *repository.OfferRepository,
+	offerTimeout time.Duration,
+) *Dispatcher {
+	return &Dispatcher{
+		deliveryRepo:  deliveryRepo,
+		courierRepo:   courierRepo,
+		offerRepo:     offerRepo,
+		offerTimeout:  offerTimeout,
+		retryInterval: 10 * time.Second,
+		answers:       make(map[string]chan bool),
+		running:       make(map[string]bool),
+	}
+}
+
+// Dispatch starts looking for a courier for the delivery in the background.
+// Calling it again for a delivery that is already being dispatched is a no-op.
+func (d *Dispatcher) Dispatch(delivery *repository.Delivery) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.running[delivery.ID] {
+		return
+	}
+	d.running[delivery.ID] = true
+
+	go d.run(delivery)
+}
+
+// ResumePending restarts dispatching for deliveries that were still waiting
+// for a courier, e.g. when the service was restarted.
+func (d *Dispatcher) ResumePending(ctx context.Context) error {
+	deliveries, err := d.deliveryRepo.GetByStatus(ctx, StatusPending)
+	if err != nil {
+		return fmt.Errorf("failed to get pending deliveries: %w", err)
+	}
+
+	for _, delivery := range deliveries {
+		d.Dispatch(delivery)
+	}
+
+	return nil
+}
+
+// Answer passes a courier's response to the dispatcher waiting on the offer.
+func (d *Dispatcher) Answer(offerID string, accepted bool) {
+	d.mu.Lock()
+	answer, ok := d.answers[offerID]
+	d.mu.Unlock()
+
+	if ok {
+		select {
+		case answer <- accepted:
+		default:
+		}
+	}
+}
+
+func (d *Dispatcher) run(delivery *repository.Delivery) {
+	defer func() {
+		d.mu.Lock()
+		delete(d.running, delivery.ID)
+		d.mu.Unlock()
+	}()
+
+	ctx := context.Background()
+	passed := make(map[string]bool)
+
+	for {
+		current, err := d.deliveryRepo.GetByID(ctx, delivery.ID)
+		if err != nil {
+			log.Printf("dispatcher: failed to reload delivery %s: %v", delivery.ID, err)
+			return
+		}
+		if current.Status != StatusPending {
+			return
+		}
+
+		couriers, err := d.courierRepo.GetIdle(ctx)
+		if err != nil {
+			log.Printf("dispatcher: failed to get idle couriers: %v", err)
+			time.Sleep(d.retryInterval)
+			continue
+		}
+
+		courier, distanceKm := nearestCourier(couriers, delivery.PickupLatitude, delivery.PickupLongitude, passed)
+		if courier == nil {
+			// Everyone idle has passed on this delivery; ask them again later
+			clear(passed)
+			time.Sleep(d.retryInterval)
+			continue
+		}
+
+		if d.offer(ctx, delivery, courier, distanceKm) {
+			return
+		}
+		passed[courier.CourierID] = true
+	}
+}
+
+// offer proposes the delivery to one courier and waits for an answer until
+// the offer expires. It reports whether the courier accepted.
+func (d *Dispatcher) offer(ctx context.Context, delivery *repository.Delivery, courier *repository.Courier, distanceKm float64) bool {
+	offer := &repository.Offer{
+		ID:         uuid.New().String(),
+		DeliveryID: delivery.ID,
+		CourierID:  courier.CourierID,
+		Status:     OfferPending,
+		DistanceKm: distanceKm,
+	}
+
+	answer := make(chan bool, 1)
+	d.mu.Lock()
+	d.answers[offer.ID] = answer
+	d.mu.Unlock()
+
+	defer func() {
+		d.mu.Lock()
+		delete(d.answers, offer.ID)
+		d.mu.Unlock()
+	}()
+
+	err := d.offerRepo.Create(ctx, offer, d.offerTimeout)
+	if errors.Is(err, repository.ErrCourierBusy) {
+		// Another dispatcher offered them something first
+		return false
+	}
+	if err != nil {
+		log.Printf("dispatcher: failed to offer delivery %s: %v", delivery.ID, err)
+		return false
+	}
+
+	timer := time.NewTimer(d.offerTimeout)
+	defer timer.Stop()
+
+	select {
+	case accepted := <-answer:
+		return accepted
+	case <-timer.C:
+	}
+
+	expired, err := d.offerRepo.Resolve(ctx, offer.ID, OfferPending, OfferExpired)
+	if err != nil {
+		log.Printf("dispatcher: failed to expire offer %s: %v", offer.ID, err)
+		return false
+	}
+	if expired {
+		return false
+	}
+
+	// The courier answered just as the offer ran out
+	resolved, err := d.offerRepo.GetByID(ctx, offer.ID)
+	if err != nil {
+		log.Printf("dispatcher: failed to reload offer %s: %v", offer.ID, err)
+		return false
+	}
+
+	return resolved.Status == OfferAccepted
+}
+
+// nearestCourier picks the courier closest to the pickup point by haversine
+// distance, skipping couriers that already passed on the delivery.
+func nearestCourier(couriers []*repository.Courier, lat, lng float64, passed map[string]bool) (*repository.Courier, float64) {
+	var nearest *repository.Courier
+	var nearestKm float64
+
+	for _, courier := range couriers {
+		if passed[courier.CourierID] {
+			continue
+		}
+
+		km := pkg.HaversineKm(lat, lng, courier.Latitude, courier.Longitude)
+		if nearest == nil || km < nearestKm {
+			nearest = courier
+			nearestKm = km
+		}
+	}
+
+	return nearest, nearestKm
+}
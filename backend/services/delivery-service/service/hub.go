@@ -0,0 +1,76 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service/pb"
+)
+
+// Hub fans delivery events out to everyone watching a delivery. It lives in
+// memory, so watchers only see events handled by the same instance.
+type Hub struct {
+	mu       sync.Mutex
+	watchers map[string]map[chan *pb.DeliveryEvent]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		watchers: make(map[string]map[chan *pb.DeliveryEvent]struct{}),
+	}
+}
+
+// Subscribe starts receiving events for the delivery. The returned function
+// stops the subscription and must be called once the watcher is done. The
+// channel is closed if the watcher falls too far behind.
+func (h *Hub) Subscribe(deliveryID string) (<-chan *pb.DeliveryEvent, func()) {
+	events := make(chan *pb.DeliveryEvent, 16)
+
+	h.mu.Lock()
+	if h.watchers[deliveryID] == nil {
+		h.watchers[deliveryID] = make(map[chan *pb.DeliveryEvent]struct{})
+	}
+	h.watchers[deliveryID][events] = struct{}{}
+	h.mu.Unlock()
+
+	unsubscribe := func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		delete(h.watchers[deliveryID], events)
+		if len(h.watchers[deliveryID]) == 0 {
+			delete(h.watchers, deliveryID)
+		}
+	}
+
+	return events, unsubscribe
+}
+
+// Publish sends the event to the delivery's watchers. A watcher that falls
+// behind is dropped and its channel closed rather than slowing down the
+// courier's stream, so it knows to catch up from the database.
+func (h *Hub) Publish(event *pb.DeliveryEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for events := range h.watchers[event.DeliveryId] {
+		select {
+		case events <- event:
+		default:
+			delete(h.watchers[event.DeliveryId], events)
+			close(events)
+		}
+	}
+	if len(h.watchers[event.DeliveryId]) == 0 {
+		delete(h.watchers, event.DeliveryId)
+	}
+}
+
+func newDeliveryEvent(deliveryID, status, courierID string) *pb.DeliveryEvent {
+	return &pb.DeliveryEvent{
+		DeliveryId: deliveryID,
+		Status:     status,
+		CourierId:  courierID,
+		CreatedAt:  time.Now().UTC().Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *DeliveryService) UpdateLocation(stream pb.DeliveryService_UpdateLocationServer) error {
+	ctx := stream.Context()
+
+	var courierID string
+	var accepted int32
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&pb.UpdateLocationResponse{
+				Accepted: accepted,
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		if update.CourierId == "" {
+			return status.Error(codes.InvalidArgument, "courier id is required")
+		}
+
+		if courierID == "" {
+			courierID = update.CourierId
+		} else if update.CourierId != courierID {
+			return status.Error(codes.InvalidArgument, "all pings in a stream must come from the same courier")
+		}
+
+		if update.Latitude < -90 || update.Latitude > 90 || update.Longitude < -180 || update.Longitude > 180 {
+			return status.Error(codes.InvalidArgument, "invalid coordinates")
+		}
+
+		if err := s.courierRepo.UpdateLocation(ctx, courierID, update.Latitude, update.Longitude); err != nil {
+			return fmt.Errorf("failed to update courier location: %w", err)
+		}
+
+		deliveries, err := s.deliveryRepo.GetActiveByCourierID(ctx, courierID)
+		if err != nil {
+			return fmt.Errorf("failed to get courier deliveries: %w", err)
+		}
+
+		for _, delivery := range deliveries {
+			event := newDeliveryEvent(delivery.ID, delivery.Status, courierID)
+			event.HasLocation = true
+			event.CourierLatitude = update.Latitude
+			event.CourierLongitude = update.Longitude
+			s.hub.Publish(event)
+		}
+
+		accepted++
+	}
+}
+
+func (s *DeliveryService) WatchDelivery(req *pb.WatchDeliveryRequest, stream pb.DeliveryService_WatchDeliveryServer) error {
+	if req.DeliveryId == "" {
+		return status.Error(codes.InvalidArgument, "delivery id is required")
+	}
+
+	ctx := stream.Context()
+
+	for {
+		done, err := s.watchDelivery(ctx, req.DeliveryId, stream)
+		if err != nil || done {
+			return err
+		}
+		// The watcher fell behind and missed events; start over from the
+		// current state
+	}
+}
+
+// watchDelivery streams the delivery's current state and then its events
+// until it is delivered or the client leaves, which it reports as done. It
+// returns early, not done, when the hub dropped the watcher for falling
+// behind.
+func (s *DeliveryService) watchDelivery(ctx context.Context, deliveryID string, stream pb.DeliveryService_WatchDeliveryServer) (bool, error) {
+	// Subscribe before reading the current state so no change slips in between
+	events, unsubscribe := s.hub.Subscribe(deliveryID)
+	defer unsubscribe()
+
+	delivery, err := s.getDelivery(ctx, deliveryID, "")
+	if err != nil {
+		return true, err
+	}
+
+	current, err := s.currentEvent(ctx, delivery)
+	if err != nil {
+		return true, err
+	}
+
+	if err := stream.Send(current); err != nil {
+		return true, err
+	}
+
+	if delivery.Status == StatusDelivered {
+		return true, nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return true, nil
+		case event, ok := <-events:
+			if !ok {
+				return false, nil
+			}
+			if err := stream.Send(event); err != nil {
+				return true, err
+			}
+			if event.Status == StatusDelivered {
+				return true, nil
+			}
+		}
+	}
+}
+
+// currentEvent describes the delivery as it is now, with the courier's last
+// known position once a courier is assigned.
+func (s *DeliveryService) currentEvent(ctx context.Context, delivery *repository.Delivery) (*pb.DeliveryEvent, error) {
+	event := newDeliveryEvent(delivery.ID, delivery.Status, delivery.CourierID)
+	if delivery.CourierID == "" {
+		return event, nil
+	}
+
+	courier, err := s.courierRepo.GetByID(ctx, delivery.CourierID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return event, nil
+		}
+		return nil, fmt.Errorf("failed to get courier: %w", err)
+	}
+
+	event.HasLocation = courier.HasLocation
+	event.CourierLatitude = courier.Latitude
+	event.CourierLongitude = courier.Longitude
+
+	return event, nil
+}
@@ -1,3 +1,37 @@
 module github.com/kimashii-dan/food-delivery-app/backend/services/order-service
 
 go 1.25.5
+
+require (
+	github.com/golang-migrate/migrate/v4 v4.19.1
+	github.com/google/uuid v1.6.0
+	github.com/jackc/pgx/v5 v5.8.0
+	github.com/joho/godotenv v1.5.1
+	github.com/kimashii-dan/food-delivery-app/backend/pkg v0.0.0
+	github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service v0.0.0
+	github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service v0.0.0
+	github.com/kimashii-dan/food-delivery-app/backend/services/user-service v0.0.0
+	google.golang.org/grpc v1.78.0
+	google.golang.org/protobuf v1.36.10
+)
+
+require (
+	github.com/golang-jwt/jwt/v5 v5.3.0 // indirect
+	github.com/jackc/pgpassfile v1.0.0 // indirect
+	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
+	github.com/jackc/puddle/v2 v2.2.2 // indirect
+	github.com/lib/pq v1.10.9 // indirect
+	golang.org/x/net v0.48.0 // indirect
+	golang.org/x/sync v0.19.0 // indirect
+	golang.org/x/sys v0.40.0 // indirect
+	golang.org/x/text v0.33.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20251029180050-ab9386a59fda // indirect
+)
+
+replace github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service => ../restaurant-service
+
+replace github.com/kimashii-dan/food-delivery-app/backend/services/user-service => ../user-service
+
+replace github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service => ../delivery-service
+
+replace github.com/kimashii-dan/food-delivery-app/backend/pkg => ../../pkg
@@ -1 +1,114 @@
 package main
+
+import (
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/clients"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/payments"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/repository"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+func main() {
+	err := godotenv.Load()
+	if err != nil {
+		log.Println("Error loading .env file, using environment variables")
+	}
+
+	db, err := repository.Init(os.Getenv("DATABASE_URL"))
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	// init grpc connection with restaurant service
+	restaurantServicePort := os.Getenv("RESTAURANT_SERVICE_PORT")
+	restaurantClient, restaurantConn := clients.NewRestaurantServiceClient(restaurantServicePort)
+	defer restaurantConn.Close()
+
+	// init grpc connection with user service
+	userServicePort := os.Getenv("USER_SERVICE_PORT")
+	userClient, userConn := clients.NewUserServiceClient(userServicePort)
+	defer userConn.Close()
+
+	// init grpc connection with delivery service
+	deliveryServicePort := os.Getenv("DELIVERY_SERVICE_PORT")
+	deliveryClient, deliveryConn := clients.NewDeliveryServiceClient(deliveryServicePort)
+	defer deliveryConn.Close()
+
+	orderRepo := repository.NewOrderRepository(db)
+	cartRepo := repository.NewCartRepository(db)
+	quoteRepo := repository.NewQuoteRepository(db)
+	paymentRepo := repository.NewPaymentRepository(db)
+	promoRepo := repository.NewPromoRepository(db)
+
+	// fees and taxes in minor units and basis points, see service.Pricing
+	pricing := service.DefaultPricing
+	if value := os.Getenv("PRICING_CURRENCY"); value != "" {
+		pricing.Currency = value
+	}
+	pricing.DeliveryBaseFee = int64FromEnv("PRICING_DELIVERY_BASE_FEE", pricing.DeliveryBaseFee)
+	pricing.DeliveryFeePerKm = int64FromEnv("PRICING_DELIVERY_FEE_PER_KM", pricing.DeliveryFeePerKm)
+	pricing.SmallOrderMinimum = int64FromEnv("PRICING_SMALL_ORDER_MINIMUM", pricing.SmallOrderMinimum)
+	pricing.SmallOrderFee = int64FromEnv("PRICING_SMALL_ORDER_FEE", pricing.SmallOrderFee)
+	pricing.ServiceFeeRate = int64FromEnv("PRICING_SERVICE_FEE_RATE", pricing.ServiceFeeRate)
+	pricing.TaxRate = int64FromEnv("PRICING_TAX_RATE", pricing.TaxRate)
+
+	// how long CreateOrder honours a quote
+	if value := os.Getenv("QUOTE_TTL"); value != "" {
+		pricing.QuoteTTL, err = time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("Invalid QUOTE_TTL: %v", err)
+		}
+	}
+
+	// "mock" (default) authorizes every payment method except the mock_* test ones
+	paymentProvider, err := payments.New(os.Getenv("PAYMENT_PROVIDER"))
+	if err != nil {
+		log.Fatalf("Failed to init payment provider: %v", err)
+	}
+
+	orderService := service.NewOrderService(
+		orderRepo, cartRepo, quoteRepo, paymentRepo, promoRepo,
+		restaurantClient, userClient, deliveryClient,
+		pricing, paymentProvider,
+	)
+
+	port := os.Getenv("PORT")
+	lis, err := net.Listen("tcp", port)
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterOrderServiceServer(grpcServer, orderService)
+	reflection.Register(grpcServer)
+
+	log.Printf("Order service listening on port %s", port)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
+
+// int64FromEnv reads a non-negative integer setting, falling back when it is unset
+func int64FromEnv(name string, fallback int64) int64 {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n < 0 {
+		log.Fatalf("Invalid %s: %q", name, value)
+	}
+
+	return n
+}
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS orders (
+    id                      UUID PRIMARY KEY,
+    user_id                 VARCHAR(36) NOT NULL,
+    restaurant_id           UUID NOT NULL,
+    delivery_address_id     VARCHAR(36) NOT NULL,
+    status                  VARCHAR(20) NOT NULL,
+    total_price             DECIMAL(10,2) NOT NULL,
+    created_at              TIMESTAMP DEFAULT NOW(),
+    updated_at              TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_orders_user_id ON orders(user_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS order_items (
+    id              UUID PRIMARY KEY,
+    order_id        UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    menu_item_id    UUID NOT NULL,
+    name            VARCHAR(255) NOT NULL,
+    price           DECIMAL(10,2) NOT NULL,
+    quantity        INT NOT NULL CHECK (quantity > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_items_order_id ON order_items(order_id);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS order_status_history (
+    id              UUID PRIMARY KEY,
+    order_id        UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    from_status     VARCHAR(20),
+    to_status       VARCHAR(20) NOT NULL,
+    actor_user_id   VARCHAR(36) NOT NULL,
+    actor_role      VARCHAR(20) NOT NULL,
+    created_at      TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_status_history_order_id ON order_status_history(order_id, created_at);
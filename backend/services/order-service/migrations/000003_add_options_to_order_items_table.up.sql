@@ -0,0 +1,3 @@
+-- Snapshot of the options chosen for the item: [{"group_name", "name", "price_delta"}]
+ALTER TABLE order_items
+ADD COLUMN options JSONB NOT NULL DEFAULT '[]';
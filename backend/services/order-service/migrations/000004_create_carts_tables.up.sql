@@ -0,0 +1,21 @@
+-- One cart per user, tied to the restaurant its items come from
+CREATE TABLE IF NOT EXISTS carts (
+    user_id         VARCHAR(36) PRIMARY KEY,
+    restaurant_id   UUID NOT NULL,
+    created_at      TIMESTAMP DEFAULT NOW(),
+    updated_at      TIMESTAMP DEFAULT NOW()
+);
+
+-- unit_price and name are what the item cost when it was added, option_ids are sorted
+CREATE TABLE IF NOT EXISTS cart_items (
+    id              UUID PRIMARY KEY,
+    user_id         VARCHAR(36) NOT NULL REFERENCES carts(user_id) ON DELETE CASCADE,
+    menu_item_id    UUID NOT NULL,
+    option_ids      TEXT[] NOT NULL DEFAULT '{}',
+    name            VARCHAR(255) NOT NULL,
+    unit_price      DECIMAL(10,2) NOT NULL,
+    quantity        INT NOT NULL CHECK (quantity > 0),
+    created_at      TIMESTAMP DEFAULT NOW(),
+    updated_at      TIMESTAMP DEFAULT NOW(),
+    UNIQUE (user_id, menu_item_id, option_ids)
+);
@@ -0,0 +1,11 @@
+ALTER TABLE orders
+DROP COLUMN quote_id,
+DROP COLUMN currency,
+DROP COLUMN subtotal,
+DROP COLUMN delivery_fee,
+DROP COLUMN small_order_fee,
+DROP COLUMN service_fee,
+DROP COLUMN tax,
+DROP COLUMN total;
+
+DROP TABLE IF EXISTS quotes;
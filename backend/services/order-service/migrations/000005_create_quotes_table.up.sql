@@ -0,0 +1,36 @@
+-- Amounts are integer minor units of currency.
+-- lines snapshots the priced items: [{"menu_item_id", "option_ids", "name", "quantity", "unit_price", "options"}]
+CREATE TABLE IF NOT EXISTS quotes (
+    id                      UUID PRIMARY KEY,
+    user_id                 VARCHAR(36) NOT NULL,
+    restaurant_id           UUID NOT NULL,
+    delivery_address_id     VARCHAR(36) NOT NULL,
+    lines                   JSONB NOT NULL,
+    currency                VARCHAR(3) NOT NULL,
+    subtotal                BIGINT NOT NULL,
+    delivery_fee            BIGINT NOT NULL,
+    small_order_fee         BIGINT NOT NULL,
+    service_fee             BIGINT NOT NULL,
+    tax                     BIGINT NOT NULL,
+    total                   BIGINT NOT NULL,
+    distance_km             DOUBLE PRECISION NOT NULL,
+    order_id                UUID REFERENCES orders(id) ON DELETE SET NULL,
+    expires_at              TIMESTAMPTZ NOT NULL,
+    created_at              TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_quotes_user_id ON quotes(user_id);
+
+-- Price breakdown of the order in minor units; total_price stays the total in major units
+ALTER TABLE orders
+ADD COLUMN quote_id UUID,
+ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'KZT',
+ADD COLUMN subtotal BIGINT NOT NULL DEFAULT 0,
+ADD COLUMN delivery_fee BIGINT NOT NULL DEFAULT 0,
+ADD COLUMN small_order_fee BIGINT NOT NULL DEFAULT 0,
+ADD COLUMN service_fee BIGINT NOT NULL DEFAULT 0,
+ADD COLUMN tax BIGINT NOT NULL DEFAULT 0,
+ADD COLUMN total BIGINT NOT NULL DEFAULT 0;
+
+UPDATE orders
+SET subtotal = ROUND(total_price * 100), total = ROUND(total_price * 100);
@@ -0,0 +1,24 @@
+-- Amounts are integer minor units of currency; provider_ref is the provider's id for the payment
+CREATE TABLE IF NOT EXISTS payments (
+    id                  UUID PRIMARY KEY,
+    order_id            UUID NOT NULL UNIQUE REFERENCES orders(id) ON DELETE CASCADE,
+    provider            VARCHAR(20) NOT NULL,
+    provider_ref        VARCHAR(100) NOT NULL,
+    status              VARCHAR(20) NOT NULL,
+    amount              BIGINT NOT NULL,
+    currency            VARCHAR(3) NOT NULL,
+    failure_reason      VARCHAR(255) NOT NULL DEFAULT '',
+    created_at          TIMESTAMP DEFAULT NOW(),
+    updated_at          TIMESTAMP DEFAULT NOW(),
+    UNIQUE (provider, provider_ref)
+);
+
+-- Webhook events already handled, so a redelivered event is not applied twice
+CREATE TABLE IF NOT EXISTS payment_events (
+    provider            VARCHAR(20) NOT NULL,
+    id                  VARCHAR(100) NOT NULL,
+    type                VARCHAR(40) NOT NULL,
+    provider_ref        VARCHAR(100) NOT NULL,
+    received_at         TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (provider, id)
+);
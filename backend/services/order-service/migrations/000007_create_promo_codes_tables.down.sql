@@ -0,0 +1,9 @@
+ALTER TABLE orders
+DROP COLUMN discount;
+
+ALTER TABLE quotes
+DROP COLUMN promos,
+DROP COLUMN discount;
+
+DROP TABLE IF EXISTS promo_redemptions;
+DROP TABLE IF EXISTS promo_codes;
@@ -0,0 +1,43 @@
+-- Amounts are integer minor units. kind is percent_off, fixed_amount or free_delivery;
+-- zero limits and empty restaurant_ids mean no restriction, NULL times an open window.
+CREATE TABLE IF NOT EXISTS promo_codes (
+    id                  UUID PRIMARY KEY,
+    code                VARCHAR(40) NOT NULL UNIQUE,
+    description         TEXT NOT NULL DEFAULT '',
+    kind                VARCHAR(20) NOT NULL,
+    percent_off         INT NOT NULL DEFAULT 0,
+    amount_off          BIGINT NOT NULL DEFAULT 0,
+    max_discount        BIGINT NOT NULL DEFAULT 0,
+    min_subtotal        BIGINT NOT NULL DEFAULT 0,
+    first_order_only    BOOLEAN NOT NULL DEFAULT FALSE,
+    restaurant_ids      UUID[] NOT NULL DEFAULT '{}',
+    starts_at           TIMESTAMPTZ,
+    ends_at             TIMESTAMPTZ,
+    max_uses            INT NOT NULL DEFAULT 0,
+    max_uses_per_user   INT NOT NULL DEFAULT 0,
+    stackable           BOOLEAN NOT NULL DEFAULT FALSE,
+    active              BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at          TIMESTAMP DEFAULT NOW(),
+    updated_at          TIMESTAMP DEFAULT NOW()
+);
+
+-- Redemptions of cancelled and rejected orders don't count towards the limits
+CREATE TABLE IF NOT EXISTS promo_redemptions (
+    promo_id            UUID NOT NULL REFERENCES promo_codes(id),
+    order_id            UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    user_id             VARCHAR(36) NOT NULL,
+    code                VARCHAR(40) NOT NULL,
+    discount            BIGINT NOT NULL,
+    created_at          TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (promo_id, order_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_promo_redemptions_user_id ON promo_redemptions(promo_id, user_id);
+
+-- promos snapshots the applied codes: [{"promo_id", "code", "kind", "discount"}]
+ALTER TABLE quotes
+ADD COLUMN promos JSONB NOT NULL DEFAULT '[]',
+ADD COLUMN discount BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE orders
+ADD COLUMN discount BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- Restaurants list the orders placed with them, newest first
+CREATE INDEX IF NOT EXISTS idx_orders_restaurant_id ON orders(restaurant_id, created_at DESC);
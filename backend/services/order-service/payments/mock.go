@@ -0,0 +1,79 @@
+package payments
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Payment methods the mock provider treats specially; any other method,
+// including none, is authorized.
+const (
+	MockMethodDeclined          = "mock_declined"
+	MockMethodInsufficientFunds = "mock_insufficient_funds"
+	MockMethodPending           = "mock_pending"
+)
+
+const mockRefPrefix = "mock_"
+
+// MockProvider is a deterministic provider for development and tests: it
+// keeps no state, the outcome of every call follows from its arguments and
+// the same order always gets the same ref.
+type MockProvider struct{}
+
+func NewMockProvider() *MockProvider {
+	return &MockProvider{}
+}
+
+func (p *MockProvider) Name() string {
+	return "mock"
+}
+
+func (p *MockProvider) Authorize(ctx context.Context, req AuthorizeRequest) (*Result, error) {
+	if req.Amount <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+
+	sum := sha256.Sum256([]byte(req.OrderID))
+	result := &Result{
+		Ref:    mockRefPrefix + hex.EncodeToString(sum[:8]),
+		Status: StatusAuthorized,
+	}
+
+	switch req.PaymentMethod {
+	case MockMethodDeclined:
+		result.Status, result.FailureReason = StatusFailed, "card_declined"
+	case MockMethodInsufficientFunds:
+		result.Status, result.FailureReason = StatusFailed, "insufficient_funds"
+	case MockMethodPending:
+		result.Status = StatusPending
+	}
+
+	return result, nil
+}
+
+func (p *MockProvider) Capture(ctx context.Context, ref string, amount int64) (*Result, error) {
+	return p.settle(ref, amount, StatusCaptured)
+}
+
+func (p *MockProvider) Refund(ctx context.Context, ref string, amount int64) (*Result, error) {
+	return p.settle(ref, amount, StatusRefunded)
+}
+
+func (p *MockProvider) Void(ctx context.Context, ref string) (*Result, error) {
+	return p.settle(ref, 0, StatusVoided)
+}
+
+func (p *MockProvider) settle(ref string, amount int64, status string) (*Result, error) {
+	if !strings.HasPrefix(ref, mockRefPrefix) {
+		return nil, ErrUnknownPayment
+	}
+
+	if amount < 0 {
+		return nil, fmt.Errorf("amount must not be negative")
+	}
+
+	return &Result{Ref: ref, Status: status}, nil
+}
@@ -0,0 +1,67 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Payment statuses, as stored on payments and reported by providers
+const (
+	StatusPending    = "pending"
+	StatusAuthorized = "authorized"
+	StatusFailed     = "failed"
+	StatusCaptured   = "captured"
+	StatusVoided     = "voided"
+	StatusRefunded   = "refunded"
+)
+
+// Webhook event types, each moves a payment to the status of the same name
+const (
+	EventAuthorized = "payment.authorized"
+	EventFailed     = "payment.failed"
+	EventCaptured   = "payment.captured"
+	EventVoided     = "payment.voided"
+	EventRefunded   = "payment.refunded"
+)
+
+var ErrUnknownPayment = errors.New("payment is unknown to the provider")
+
+// Provider moves money for orders. Amounts are minor units of the order's
+// currency; ref is the provider's id for the payment, returned by Authorize.
+type Provider interface {
+	// Name is stored with every payment made through the provider
+	Name() string
+	// Authorize holds the amount on the customer's payment method. It may
+	// answer StatusPending, with a webhook settling the payment later, or
+	// StatusFailed with the reason.
+	Authorize(ctx context.Context, req AuthorizeRequest) (*Result, error)
+	// Capture takes an authorized amount
+	Capture(ctx context.Context, ref string, amount int64) (*Result, error)
+	// Refund returns a captured amount
+	Refund(ctx context.Context, ref string, amount int64) (*Result, error)
+	// Void releases an authorization that was not captured
+	Void(ctx context.Context, ref string) (*Result, error)
+}
+
+type AuthorizeRequest struct {
+	OrderID       string // also the idempotency key, so retries don't hold twice
+	Amount        int64
+	Currency      string
+	PaymentMethod string // provider token for the customer's card or wallet
+}
+
+type Result struct {
+	Ref           string
+	Status        string
+	FailureReason string
+}
+
+func New(name string) (Provider, error) {
+	switch name {
+	case "", "mock":
+		return NewMockProvider(), nil
+	default:
+		return nil, fmt.Errorf("unknown payment provider %q", name)
+	}
+}
@@ -0,0 +1,267 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrCartRestaurantMismatch is returned by AddItem when the cart already
+// holds items from another restaurant
+var ErrCartRestaurantMismatch = errors.New("cart holds items from another restaurant")
+
+type CartRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCartRepository(db *pgxpool.Pool) *CartRepository {
+	return &CartRepository{db: db}
+}
+
+type Cart struct {
+	UserID       string
+	RestaurantID string
+	Items        []*CartItem
+	UpdatedAt    string
+}
+
+// CartItem keeps the name and unit price the item had when it was added, so
+// later menu changes can be pointed out to the user
+type CartItem struct {
+	ID         string
+	MenuItemID string
+	OptionIDs  []string // sorted
+	Name       string
+	UnitPrice  float64 // per unit, options included
+	Quantity   int32
+}
+
+// Get returns the user's cart. A user without a cart gets an empty one.
+func (r *CartRepository) Get(ctx context.Context, userID string) (*Cart, error) {
+	cart := Cart{UserID: userID, Items: []*CartItem{}}
+
+	query := `
+		SELECT restaurant_id, to_char(updated_at, 'YYYY-MM-DD HH24:MI:SS')
+		FROM carts
+		WHERE user_id = $1
+	`
+
+	err := r.db.QueryRow(ctx, query, userID).Scan(&cart.RestaurantID, &cart.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &cart, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cart: %w", err)
+	}
+
+	itemQuery := `
+		SELECT id, menu_item_id, option_ids, name, unit_price, quantity
+		FROM cart_items
+		WHERE user_id = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.db.Query(ctx, itemQuery, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query cart items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item CartItem
+		err := rows.Scan(
+			&item.ID, &item.MenuItemID, &item.OptionIDs,
+			&item.Name, &item.UnitPrice, &item.Quantity,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan cart item: %w", err)
+		}
+		cart.Items = append(cart.Items, &item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating cart items: %w", err)
+	}
+
+	return &cart, nil
+}
+
+// AddItem puts item in the user's cart for restaurantID, adding to the
+// quantity of a line with the same menu item and options. A cart holding
+// items from another restaurant is emptied first when replace is set,
+// otherwise ErrCartRestaurantMismatch is returned.
+func (r *CartRepository) AddItem(ctx context.Context, userID, restaurantID string, item *CartItem, replace bool) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	cartQuery := `
+		INSERT INTO carts (user_id, restaurant_id, created_at, updated_at)
+		VALUES ($1, $2, NOW(), NOW())
+		ON CONFLICT (user_id) DO NOTHING
+	`
+
+	if _, err := tx.Exec(ctx, cartQuery, userID, restaurantID); err != nil {
+		return fmt.Errorf("failed to create cart: %w", err)
+	}
+
+	// Lock the cart so concurrent adds agree on its restaurant
+	lockQuery := `
+		SELECT restaurant_id = $2,
+		       EXISTS (SELECT 1 FROM cart_items WHERE user_id = $1)
+		FROM carts
+		WHERE user_id = $1
+		FOR UPDATE
+	`
+
+	var sameRestaurant, hasItems bool
+	if err := tx.QueryRow(ctx, lockQuery, userID, restaurantID).Scan(&sameRestaurant, &hasItems); err != nil {
+		return fmt.Errorf("failed to lock cart: %w", err)
+	}
+
+	if !sameRestaurant {
+		if hasItems && !replace {
+			return ErrCartRestaurantMismatch
+		}
+
+		if _, err := tx.Exec(ctx, `DELETE FROM cart_items WHERE user_id = $1`, userID); err != nil {
+			return fmt.Errorf("failed to empty cart: %w", err)
+		}
+	}
+
+	updateQuery := `
+		UPDATE carts
+		SET restaurant_id = $2, updated_at = NOW()
+		WHERE user_id = $1
+	`
+
+	if _, err := tx.Exec(ctx, updateQuery, userID, restaurantID); err != nil {
+		return fmt.Errorf("failed to update cart: %w", err)
+	}
+
+	optionIDs := item.OptionIDs
+	if optionIDs == nil {
+		optionIDs = []string{}
+	}
+
+	// Adding more of a line keeps the price it was first added with, so a
+	// price change is still pointed out until the user accepts it
+	itemQuery := `
+		INSERT INTO cart_items (id, user_id, menu_item_id, option_ids, name, unit_price, quantity, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		ON CONFLICT (user_id, menu_item_id, option_ids) DO UPDATE
+		SET quantity = cart_items.quantity + EXCLUDED.quantity,
+		    updated_at = NOW()
+	`
+
+	_, err = tx.Exec(ctx, itemQuery,
+		item.ID, userID, item.MenuItemID, optionIDs,
+		item.Name, item.UnitPrice, item.Quantity,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add cart item: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit cart: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateItem sets the quantity, name and unit price of a line in the user's
+// cart. It reports false when the user has no such line.
+func (r *CartRepository) UpdateItem(ctx context.Context, userID string, item *CartItem) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE cart_items
+		SET quantity = $3, name = $4, unit_price = $5, updated_at = NOW()
+		WHERE id = $1 AND user_id = $2
+	`
+
+	tag, err := tx.Exec(ctx, query, item.ID, userID, item.Quantity, item.Name, item.UnitPrice)
+	if err != nil {
+		return false, fmt.Errorf("failed to update cart item: %w", err)
+	}
+
+	if tag.RowsAffected() != 1 {
+		return false, nil
+	}
+
+	if err := touchCart(ctx, tx, userID); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit cart: %w", err)
+	}
+
+	return true, nil
+}
+
+// RemoveItem deletes a line from the user's cart and drops the cart once it
+// is empty. It reports false when the user has no such line.
+func (r *CartRepository) RemoveItem(ctx context.Context, userID, itemID string) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `DELETE FROM cart_items WHERE id = $1 AND user_id = $2`, itemID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove cart item: %w", err)
+	}
+
+	if tag.RowsAffected() != 1 {
+		return false, nil
+	}
+
+	emptyQuery := `
+		DELETE FROM carts
+		WHERE user_id = $1 AND NOT EXISTS (SELECT 1 FROM cart_items WHERE user_id = $1)
+	`
+
+	if _, err := tx.Exec(ctx, emptyQuery, userID); err != nil {
+		return false, fmt.Errorf("failed to delete empty cart: %w", err)
+	}
+
+	if err := touchCart(ctx, tx, userID); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit cart: %w", err)
+	}
+
+	return true, nil
+}
+
+// Clear deletes the user's cart with all its items
+func (r *CartRepository) Clear(ctx context.Context, userID string) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM carts WHERE user_id = $1`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to clear cart: %w", err)
+	}
+
+	return nil
+}
+
+func touchCart(ctx context.Context, tx pgx.Tx, userID string) error {
+	_, err := tx.Exec(ctx, `UPDATE carts SET updated_at = NOW() WHERE user_id = $1`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to update cart: %w", err)
+	}
+
+	return nil
+}
@@ -1 +1,450 @@
 package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrQuoteUnavailable is returned by Create when the order's quote has
+// expired or was used by another order
+var ErrQuoteUnavailable = errors.New("quote has expired or was already used")
+
+type OrderRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewOrderRepository(db *pgxpool.Pool) *OrderRepository {
+	return &OrderRepository{db: db}
+}
+
+type Order struct {
+	ID                string
+	UserID            string
+	RestaurantID      string
+	DeliveryAddressID string
+	Status            string
+	TotalPrice        float64
+	QuoteID           string
+	Price             PriceBreakdown
+	PaymentStatus     string         // empty for orders placed before payments
+	Payment           *Payment       // only set when creating the order
+	Promos            []AppliedPromo // only set when creating the order
+	Items             []*OrderItem
+	CreatedAt         string
+	UpdatedAt         string
+}
+
+// PriceBreakdown holds amounts in minor units of Currency
+type PriceBreakdown struct {
+	Currency      string
+	Subtotal      int64
+	DeliveryFee   int64
+	SmallOrderFee int64
+	ServiceFee    int64
+	Discount      int64 // promo codes, taken off before tax
+	Tax           int64
+	Total         int64
+}
+
+type OrderItem struct {
+	ID         string
+	OrderID    string
+	MenuItemID string
+	Name       string
+	Price      float64 // per unit, options included
+	Quantity   int32
+	Options    []OrderItemOption
+}
+
+// OrderItemOption is stored as JSON on the order item
+type OrderItemOption struct {
+	GroupName  string  `json:"group_name"`
+	Name       string  `json:"name"`
+	PriceDelta float64 `json:"price_delta"`
+}
+
+type StatusChange struct {
+	ID          string
+	OrderID     string
+	FromStatus  string
+	ToStatus    string
+	ActorUserID string
+	ActorRole   string
+	CreatedAt   string
+}
+
+// orderColumns are read by scanOrder
+const orderColumns = `
+	id, user_id, restaurant_id, delivery_address_id, status, total_price,
+	COALESCE(quote_id::text, ''), currency, subtotal, delivery_fee,
+	small_order_fee, service_fee, discount, tax, total,
+	COALESCE((SELECT p.status FROM payments p WHERE p.order_id = orders.id), ''),
+	to_char(created_at, 'YYYY-MM-DD HH24:MI:SS'),
+	to_char(updated_at, 'YYYY-MM-DD HH24:MI:SS')
+`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanOrder reads orderColumns, followed by any extra columns into extra
+func scanOrder(row rowScanner, extra ...any) (*Order, error) {
+	var order Order
+	dest := []any{
+		&order.ID, &order.UserID, &order.RestaurantID,
+		&order.DeliveryAddressID, &order.Status, &order.TotalPrice,
+		&order.QuoteID, &order.Price.Currency, &order.Price.Subtotal, &order.Price.DeliveryFee,
+		&order.Price.SmallOrderFee, &order.Price.ServiceFee, &order.Price.Discount, &order.Price.Tax, &order.Price.Total,
+		&order.PaymentStatus, &order.CreatedAt, &order.UpdatedAt,
+	}
+
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+// Create stores the order with its items, payment and promo redemptions and
+// records change as the first entry of its status history. An order placed
+// from a quote uses it up; when the quote has expired or is taken,
+// ErrQuoteUnavailable is returned, and ErrPromoUnavailable when a promo code
+// ran out of uses.
+func (r *OrderRepository) Create(ctx context.Context, order *Order, change *StatusChange) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO orders (
+			id, user_id, restaurant_id, delivery_address_id, status, total_price,
+			quote_id, currency, subtotal, delivery_fee, small_order_fee, service_fee, discount, tax, total,
+			created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, '')::uuid, $8, $9, $10, $11, $12, $13, $14, $15, NOW(), NOW())
+	`
+
+	_, err = tx.Exec(ctx, query,
+		order.ID, order.UserID, order.RestaurantID,
+		order.DeliveryAddressID, order.Status, order.TotalPrice,
+		order.QuoteID, order.Price.Currency, order.Price.Subtotal, order.Price.DeliveryFee,
+		order.Price.SmallOrderFee, order.Price.ServiceFee, order.Price.Discount, order.Price.Tax, order.Price.Total,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create order: %w", err)
+	}
+
+	if order.QuoteID != "" {
+		quoteQuery := `
+			UPDATE quotes
+			SET order_id = $1
+			WHERE id = $2 AND order_id IS NULL AND expires_at > NOW()
+		`
+
+		tag, err := tx.Exec(ctx, quoteQuery, order.ID, order.QuoteID)
+		if err != nil {
+			return fmt.Errorf("failed to use quote: %w", err)
+		}
+		if tag.RowsAffected() != 1 {
+			return ErrQuoteUnavailable
+		}
+	}
+
+	itemQuery := `
+		INSERT INTO order_items (id, order_id, menu_item_id, name, price, quantity, options)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	for _, item := range order.Items {
+		options := item.Options
+		if options == nil {
+			options = []OrderItemOption{}
+		}
+
+		_, err = tx.Exec(ctx, itemQuery,
+			item.ID, order.ID, item.MenuItemID,
+			item.Name, item.Price, item.Quantity, options,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create order item: %w", err)
+		}
+	}
+
+	if order.Payment != nil {
+		if err := insertPayment(ctx, tx, order.Payment); err != nil {
+			return err
+		}
+	}
+
+	if err := insertRedemptions(ctx, tx, order); err != nil {
+		return err
+	}
+
+	if err := insertStatusChange(ctx, tx, change); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit order: %w", err)
+	}
+
+	return nil
+}
+
+func (r *OrderRepository) GetByID(ctx context.Context, id string) (*Order, error) {
+	query := `SELECT ` + orderColumns + ` FROM orders WHERE id = $1`
+
+	order, err := scanOrder(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	items, err := r.getItems(ctx, []string{order.ID})
+	if err != nil {
+		return nil, err
+	}
+	order.Items = items[order.ID]
+
+	return order, nil
+}
+
+// OrderCursor is where a page of GetByUserID ended, newest orders first
+type OrderCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        string    `json:"i"`
+}
+
+// GetByUserID returns up to limit of the user's orders after the cursor, or
+// from the start when after is nil, and the cursor of the next page, which
+// is nil on the last page.
+func (r *OrderRepository) GetByUserID(ctx context.Context, userID string, after *OrderCursor, limit int32) ([]*Order, *OrderCursor, error) {
+	return r.list(ctx, "user_id", userID, after, limit)
+}
+
+// GetByRestaurantID pages through the orders placed at a restaurant like
+// GetByUserID
+func (r *OrderRepository) GetByRestaurantID(ctx context.Context, restaurantID string, after *OrderCursor, limit int32) ([]*Order, *OrderCursor, error) {
+	return r.list(ctx, "restaurant_id", restaurantID, after, limit)
+}
+
+// list pages through the orders whose column, user_id or restaurant_id,
+// equals value
+func (r *OrderRepository) list(ctx context.Context, column, value string, after *OrderCursor, limit int32) ([]*Order, *OrderCursor, error) {
+	args := []any{value, limit + 1}
+	where := column + " = $1"
+	if after != nil {
+		args = append(args, after.CreatedAt, after.ID)
+		where += " AND (created_at, id) < ($3, $4)"
+	}
+
+	// One extra row tells whether there is a next page
+	query := `
+		SELECT ` + orderColumns + `, created_at
+		FROM orders
+		WHERE ` + where + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query orders: %w", err)
+	}
+	defer rows.Close()
+
+	orders := []*Order{}
+	orderIDs := []string{}
+	var createdAt []time.Time
+	for rows.Next() {
+		var created time.Time
+		order, err := scanOrder(rows, &created)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan order: %w", err)
+		}
+		orders = append(orders, order)
+		orderIDs = append(orderIDs, order.ID)
+		createdAt = append(createdAt, created)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("error iterating orders: %w", err)
+	}
+
+	var next *OrderCursor
+	if len(orders) > int(limit) {
+		orders, orderIDs = orders[:limit], orderIDs[:limit]
+		next = &OrderCursor{CreatedAt: createdAt[limit-1], ID: orders[limit-1].ID}
+	}
+
+	items, err := r.getItems(ctx, orderIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, order := range orders {
+		order.Items = items[order.ID]
+	}
+
+	return orders, next, nil
+}
+
+// HasOrders reports whether the user placed an order that was not cancelled
+// or rejected
+func (r *OrderRepository) HasOrders(ctx context.Context, userID string) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM orders
+			WHERE user_id = $1 AND status NOT IN ('cancelled', 'rejected')
+		)
+	`
+
+	var exists bool
+	if err := r.db.QueryRow(ctx, query, userID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check orders of user: %w", err)
+	}
+
+	return exists, nil
+}
+
+// UpdateStatus moves the order from change.FromStatus to change.ToStatus and
+// records the change in its history. It reports false without touching
+// anything when the order is no longer in change.FromStatus, so two
+// concurrent updates cannot both succeed.
+//
+// A non-nil effect runs once the change is claimed, with the order row still
+// locked, and the change is rolled back if it fails. Effects must be safe to
+// repeat, as a failed commit leaves them done without the change.
+func (r *OrderRepository) UpdateStatus(ctx context.Context, change *StatusChange, effect func() error) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE orders
+		SET status = $1, updated_at = NOW()
+		WHERE id = $2 AND status = $3
+	`
+
+	tag, err := tx.Exec(ctx, query, change.ToStatus, change.OrderID, change.FromStatus)
+	if err != nil {
+		return false, fmt.Errorf("failed to update order status: %w", err)
+	}
+
+	if tag.RowsAffected() != 1 {
+		return false, nil
+	}
+
+	if err := insertStatusChange(ctx, tx, change); err != nil {
+		return false, err
+	}
+
+	if effect != nil {
+		if err := effect(); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit order status: %w", err)
+	}
+
+	return true, nil
+}
+
+func (r *OrderRepository) GetStatusHistory(ctx context.Context, orderID string) ([]*StatusChange, error) {
+	query := `
+		SELECT id, order_id, COALESCE(from_status, ''), to_status, actor_user_id, actor_role,
+		       to_char(created_at, 'YYYY-MM-DD HH24:MI:SS')
+		FROM order_status_history
+		WHERE order_id = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.db.Query(ctx, query, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query order status history: %w", err)
+	}
+	defer rows.Close()
+
+	history := []*StatusChange{}
+	for rows.Next() {
+		var change StatusChange
+		err := rows.Scan(
+			&change.ID, &change.OrderID, &change.FromStatus, &change.ToStatus,
+			&change.ActorUserID, &change.ActorRole, &change.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan order status change: %w", err)
+		}
+		history = append(history, &change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating order status history: %w", err)
+	}
+
+	return history, nil
+}
+
+func insertStatusChange(ctx context.Context, tx pgx.Tx, change *StatusChange) error {
+	query := `
+		INSERT INTO order_status_history (id, order_id, from_status, to_status, actor_user_id, actor_role, created_at)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, clock_timestamp())
+	`
+
+	_, err := tx.Exec(ctx, query,
+		change.ID, change.OrderID, change.FromStatus, change.ToStatus,
+		change.ActorUserID, change.ActorRole,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record order status change: %w", err)
+	}
+
+	return nil
+}
+
+func (r *OrderRepository) getItems(ctx context.Context, orderIDs []string) (map[string][]*OrderItem, error) {
+	items := make(map[string][]*OrderItem)
+	if len(orderIDs) == 0 {
+		return items, nil
+	}
+
+	query := `
+		SELECT id, order_id, menu_item_id, name, price, quantity, options
+		FROM order_items
+		WHERE order_id = ANY($1)
+		ORDER BY name
+	`
+
+	rows, err := r.db.Query(ctx, query, orderIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query order items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item OrderItem
+		err := rows.Scan(
+			&item.ID, &item.OrderID, &item.MenuItemID,
+			&item.Name, &item.Price, &item.Quantity, &item.Options,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan order item: %w", err)
+		}
+		items[item.OrderID] = append(items[item.OrderID], &item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating order items: %w", err)
+	}
+
+	return items, nil
+}
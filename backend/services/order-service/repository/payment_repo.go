@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrDuplicatePaymentEvent is returned by ApplyEvent for an event that was
+// already handled
+var ErrDuplicatePaymentEvent = errors.New("payment event was already handled")
+
+type PaymentRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPaymentRepository(db *pgxpool.Pool) *PaymentRepository {
+	return &PaymentRepository{db: db}
+}
+
+// Payment is the money side of an order, amounts in minor units
+type Payment struct {
+	ID            string
+	OrderID       string
+	Provider      string
+	ProviderRef   string
+	Status        string
+	Amount        int64
+	Currency      string
+	FailureReason string
+}
+
+// PaymentEvent is a webhook event from the payment provider
+type PaymentEvent struct {
+	ID            string
+	Provider      string
+	Type          string
+	ProviderRef   string
+	FailureReason string
+}
+
+const paymentColumns = `id, order_id, provider, provider_ref, status, amount, currency, failure_reason`
+
+func scanPayment(row rowScanner) (*Payment, error) {
+	var payment Payment
+	err := row.Scan(
+		&payment.ID, &payment.OrderID, &payment.Provider, &payment.ProviderRef,
+		&payment.Status, &payment.Amount, &payment.Currency, &payment.FailureReason,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &payment, nil
+}
+
+func (r *PaymentRepository) GetByOrderID(ctx context.Context, orderID string) (*Payment, error) {
+	query := `SELECT ` + paymentColumns + ` FROM payments WHERE order_id = $1`
+
+	payment, err := scanPayment(r.db.QueryRow(ctx, query, orderID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+
+	return payment, nil
+}
+
+func (r *PaymentRepository) GetByProviderRef(ctx context.Context, provider, providerRef string) (*Payment, error) {
+	query := `SELECT ` + paymentColumns + ` FROM payments WHERE provider = $1 AND provider_ref = $2`
+
+	payment, err := scanPayment(r.db.QueryRow(ctx, query, provider, providerRef))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+
+	return payment, nil
+}
+
+// UpdateStatus moves the payment from one status to another. It reports
+// false without touching anything when the payment is no longer in from.
+func (r *PaymentRepository) UpdateStatus(ctx context.Context, id, from, to string) (bool, error) {
+	query := `
+		UPDATE payments
+		SET status = $3, updated_at = NOW()
+		WHERE id = $1 AND status = $2
+	`
+
+	tag, err := r.db.Exec(ctx, query, id, from, to)
+	if err != nil {
+		return false, fmt.Errorf("failed to update payment status: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// ApplyEvent records a webhook event and moves its payment to status to when
+// the payment is in one of the from statuses; events arriving out of order
+// leave the payment alone. It returns the payment as it is afterwards, or
+// ErrDuplicatePaymentEvent when the event was handled before.
+func (r *PaymentRepository) ApplyEvent(ctx context.Context, event *PaymentEvent, from []string, to string) (*Payment, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	eventQuery := `
+		INSERT INTO payment_events (provider, id, type, provider_ref, received_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (provider, id) DO NOTHING
+	`
+
+	tag, err := tx.Exec(ctx, eventQuery, event.Provider, event.ID, event.Type, event.ProviderRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record payment event: %w", err)
+	}
+	if tag.RowsAffected() != 1 {
+		return nil, ErrDuplicatePaymentEvent
+	}
+
+	query := `SELECT ` + paymentColumns + ` FROM payments WHERE provider = $1 AND provider_ref = $2 FOR UPDATE`
+
+	payment, err := scanPayment(tx.QueryRow(ctx, query, event.Provider, event.ProviderRef))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+
+	if slices.Contains(from, payment.Status) {
+		updateQuery := `
+			UPDATE payments
+			SET status = $2, failure_reason = $3, updated_at = NOW()
+			WHERE id = $1
+		`
+
+		if _, err := tx.Exec(ctx, updateQuery, payment.ID, to, event.FailureReason); err != nil {
+			return nil, fmt.Errorf("failed to update payment status: %w", err)
+		}
+		payment.Status = to
+		payment.FailureReason = event.FailureReason
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit payment event: %w", err)
+	}
+
+	return payment, nil
+}
+
+func insertPayment(ctx context.Context, tx pgx.Tx, payment *Payment) error {
+	query := `
+		INSERT INTO payments (id, order_id, provider, provider_ref, status, amount, currency, failure_reason, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+	`
+
+	_, err := tx.Exec(ctx, query,
+		payment.ID, payment.OrderID, payment.Provider, payment.ProviderRef,
+		payment.Status, payment.Amount, payment.Currency, payment.FailureReason,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create payment: %w", err)
+	}
+
+	return nil
+}
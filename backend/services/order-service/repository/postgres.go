@@ -1 +1,52 @@
 package repository
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var pool *pgxpool.Pool
+var ctx = context.Background()
+
+func Init(url string) (*pgxpool.Pool, error) {
+	var err error
+
+	pool, err = pgxpool.New(ctx, url)
+	if err != nil {
+		log.Fatal("Unable to connect to database:", err)
+	}
+
+	if err := pool.Ping(ctx); err != nil {
+		log.Fatal("Unable to ping database:", err)
+	}
+
+	fmt.Println("Connected to PostgreSQL database!")
+
+	runMigrations(url)
+
+	return pool, nil
+}
+
+func runMigrations(databaseURL string) {
+	log.Println("Running database migrations...")
+
+	m, err := migrate.New(
+		"file://migrations",
+		databaseURL,
+	)
+	if err != nil {
+		log.Fatal("Failed to create migrate instance:", err)
+	}
+
+	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		log.Fatal("Failed to apply migrations:", err)
+	}
+
+	log.Println("Migrations applied successfully.")
+}
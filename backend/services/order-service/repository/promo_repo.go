@@ -0,0 +1,369 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrPromoUnavailable is returned by OrderRepository.Create when a promo
+// code was deactivated, expired, reached a usage limit or stopped being the
+// user's first order after it was applied
+var ErrPromoUnavailable = errors.New("promo code can no longer be used")
+
+type PromoRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPromoRepository(db *pgxpool.Pool) *PromoRepository {
+	return &PromoRepository{db: db}
+}
+
+// PromoCode amounts are minor units. Zero limits and no restaurant ids mean
+// no restriction, nil times an open validity window.
+type PromoCode struct {
+	ID             string
+	Code           string
+	Description    string
+	Kind           string
+	PercentOff     int32
+	AmountOff      int64
+	MaxDiscount    int64 // caps percent_off discounts
+	MinSubtotal    int64
+	FirstOrderOnly bool
+	RestaurantIDs  []string
+	StartsAt       *time.Time
+	EndsAt         *time.Time
+	MaxUses        int32
+	MaxUsesPerUser int32
+	Stackable      bool
+	Active         bool
+	TimesUsed      int32
+	CreatedAt      string
+	UpdatedAt      string
+}
+
+// AppliedPromo is a promo code applied to a quote or order, stored as JSON
+// on quotes and as a redemption of orders
+type AppliedPromo struct {
+	PromoID  string `json:"promo_id"`
+	Code     string `json:"code"`
+	Kind     string `json:"kind"`
+	Discount int64  `json:"discount"`
+}
+
+// PromoCursor is where a page of List ended, newest codes first
+type PromoCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        string    `json:"i"`
+}
+
+// countedRedemptions are the redemptions that count towards usage limits
+const countedRedemptions = `
+	SELECT r.promo_id, r.user_id
+	FROM promo_redemptions r
+	JOIN orders o ON o.id = r.order_id
+	WHERE o.status NOT IN ('cancelled', 'rejected')
+`
+
+const promoColumns = `
+	id, code, description, kind, percent_off, amount_off, max_discount, min_subtotal,
+	first_order_only, restaurant_ids::text[], starts_at, ends_at, max_uses, max_uses_per_user,
+	stackable, active,
+	(SELECT COUNT(*) FROM (` + countedRedemptions + `) used WHERE used.promo_id = promo_codes.id),
+	to_char(created_at, 'YYYY-MM-DD HH24:MI:SS'),
+	to_char(updated_at, 'YYYY-MM-DD HH24:MI:SS')
+`
+
+func scanPromo(row rowScanner, extra ...any) (*PromoCode, error) {
+	var promo PromoCode
+	dest := []any{
+		&promo.ID, &promo.Code, &promo.Description, &promo.Kind,
+		&promo.PercentOff, &promo.AmountOff, &promo.MaxDiscount, &promo.MinSubtotal,
+		&promo.FirstOrderOnly, &promo.RestaurantIDs, &promo.StartsAt, &promo.EndsAt,
+		&promo.MaxUses, &promo.MaxUsesPerUser, &promo.Stackable, &promo.Active,
+		&promo.TimesUsed, &promo.CreatedAt, &promo.UpdatedAt,
+	}
+
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	return &promo, nil
+}
+
+func (r *PromoRepository) Create(ctx context.Context, promo *PromoCode) error {
+	query := `
+		INSERT INTO promo_codes (
+			id, code, description, kind, percent_off, amount_off, max_discount, min_subtotal,
+			first_order_only, restaurant_ids, starts_at, ends_at, max_uses, max_uses_per_user,
+			stackable, active, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10::text[]::uuid[], $11, $12, $13, $14, $15, $16, NOW(), NOW())
+	`
+
+	_, err := r.db.Exec(ctx, query, promoArgs(promo)...)
+	if err != nil {
+		return fmt.Errorf("failed to create promo code: %w", err)
+	}
+
+	return nil
+}
+
+// Update replaces every field of the promo code but its id
+func (r *PromoRepository) Update(ctx context.Context, promo *PromoCode) (bool, error) {
+	query := `
+		UPDATE promo_codes
+		SET code = $2, description = $3, kind = $4, percent_off = $5, amount_off = $6,
+		    max_discount = $7, min_subtotal = $8, first_order_only = $9,
+		    restaurant_ids = $10::text[]::uuid[], starts_at = $11, ends_at = $12,
+		    max_uses = $13, max_uses_per_user = $14, stackable = $15, active = $16,
+		    updated_at = NOW()
+		WHERE id = $1
+	`
+
+	tag, err := r.db.Exec(ctx, query, promoArgs(promo)...)
+	if err != nil {
+		return false, fmt.Errorf("failed to update promo code: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func promoArgs(promo *PromoCode) []any {
+	restaurantIDs := promo.RestaurantIDs
+	if restaurantIDs == nil {
+		restaurantIDs = []string{}
+	}
+
+	return []any{
+		promo.ID, promo.Code, promo.Description, promo.Kind,
+		promo.PercentOff, promo.AmountOff, promo.MaxDiscount, promo.MinSubtotal,
+		promo.FirstOrderOnly, restaurantIDs, promo.StartsAt, promo.EndsAt,
+		promo.MaxUses, promo.MaxUsesPerUser, promo.Stackable, promo.Active,
+	}
+}
+
+// Delete removes a promo code that was never redeemed. It reports false when
+// there is no such code or it has redemptions.
+func (r *PromoRepository) Delete(ctx context.Context, id string) (bool, error) {
+	query := `
+		DELETE FROM promo_codes
+		WHERE id = $1 AND NOT EXISTS (SELECT 1 FROM promo_redemptions WHERE promo_id = $1)
+	`
+
+	tag, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete promo code: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *PromoRepository) GetByID(ctx context.Context, id string) (*PromoCode, error) {
+	query := `SELECT ` + promoColumns + ` FROM promo_codes WHERE id = $1`
+
+	promo, err := scanPromo(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get promo code: %w", err)
+	}
+
+	return promo, nil
+}
+
+// GetByCodes returns the promo codes with the given codes by code
+func (r *PromoRepository) GetByCodes(ctx context.Context, codes []string) (map[string]*PromoCode, error) {
+	promos := make(map[string]*PromoCode, len(codes))
+	if len(codes) == 0 {
+		return promos, nil
+	}
+
+	query := `SELECT ` + promoColumns + ` FROM promo_codes WHERE code = ANY($1)`
+
+	rows, err := r.db.Query(ctx, query, codes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query promo codes: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		promo, err := scanPromo(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan promo code: %w", err)
+		}
+		promos[promo.Code] = promo
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating promo codes: %w", err)
+	}
+
+	return promos, nil
+}
+
+// List returns up to limit promo codes after the cursor, newest first, and
+// the cursor of the next page, which is nil on the last page.
+func (r *PromoRepository) List(ctx context.Context, after *PromoCursor, limit int32) ([]*PromoCode, *PromoCursor, error) {
+	args := []any{limit + 1}
+	where := "TRUE"
+	if after != nil {
+		args = append(args, after.CreatedAt, after.ID)
+		where = "(created_at, id) < ($2, $3)"
+	}
+
+	// One extra row tells whether there is a next page
+	query := `
+		SELECT ` + promoColumns + `, created_at
+		FROM promo_codes
+		WHERE ` + where + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT $1
+	`
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query promo codes: %w", err)
+	}
+	defer rows.Close()
+
+	promos := []*PromoCode{}
+	var createdAt []time.Time
+	for rows.Next() {
+		var created time.Time
+		promo, err := scanPromo(rows, &created)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan promo code: %w", err)
+		}
+		promos = append(promos, promo)
+		createdAt = append(createdAt, created)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("error iterating promo codes: %w", err)
+	}
+
+	var next *PromoCursor
+	if len(promos) > int(limit) {
+		promos = promos[:limit]
+		next = &PromoCursor{CreatedAt: createdAt[limit-1], ID: promos[limit-1].ID}
+	}
+
+	return promos, next, nil
+}
+
+// CountUserRedemptions returns how often userID redeemed each of the promo
+// codes, leaving out cancelled and rejected orders
+func (r *PromoRepository) CountUserRedemptions(ctx context.Context, promoIDs []string, userID string) (map[string]int32, error) {
+	counts := make(map[string]int32, len(promoIDs))
+	if len(promoIDs) == 0 {
+		return counts, nil
+	}
+
+	query := `
+		SELECT used.promo_id, COUNT(*)
+		FROM (` + countedRedemptions + `) used
+		WHERE used.promo_id = ANY($1::text[]::uuid[]) AND used.user_id = $2
+		GROUP BY used.promo_id
+	`
+
+	rows, err := r.db.Query(ctx, query, promoIDs, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count promo redemptions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var promoID string
+		var count int32
+		if err := rows.Scan(&promoID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan promo redemptions: %w", err)
+		}
+		counts[promoID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating promo redemptions: %w", err)
+	}
+
+	return counts, nil
+}
+
+// insertRedemptions records the promo codes used by an order, which must be
+// stored in tx already. Each code is locked while it is checked again, so an
+// admin deactivating it or concurrent orders can't slip past what was checked
+// when it was applied; ErrPromoUnavailable is returned when the code can no
+// longer be used.
+func insertRedemptions(ctx context.Context, tx pgx.Tx, order *Order) error {
+	for _, promo := range order.Promos {
+		lockQuery := `
+			SELECT max_uses, max_uses_per_user,
+			       active AND (starts_at IS NULL OR starts_at <= NOW()) AND (ends_at IS NULL OR ends_at > NOW()),
+			       first_order_only
+			FROM promo_codes
+			WHERE id = $1
+			FOR UPDATE
+		`
+
+		var maxUses, maxUsesPerUser int32
+		var usable, firstOrderOnly bool
+		err := tx.QueryRow(ctx, lockQuery, promo.PromoID).Scan(&maxUses, &maxUsesPerUser, &usable, &firstOrderOnly)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: %s", ErrPromoUnavailable, promo.Code)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock promo code: %w", err)
+		}
+
+		if !usable {
+			return fmt.Errorf("%w: %s", ErrPromoUnavailable, promo.Code)
+		}
+
+		if firstOrderOnly {
+			otherQuery := `
+				SELECT EXISTS (
+					SELECT 1 FROM orders
+					WHERE user_id = $1 AND id <> $2 AND status NOT IN ('cancelled', 'rejected')
+				)
+			`
+
+			var hasOrders bool
+			if err := tx.QueryRow(ctx, otherQuery, order.UserID, order.ID).Scan(&hasOrders); err != nil {
+				return fmt.Errorf("failed to check orders of user: %w", err)
+			}
+			if hasOrders {
+				return fmt.Errorf("%w: %s", ErrPromoUnavailable, promo.Code)
+			}
+		}
+
+		countQuery := `
+			SELECT COUNT(*), COUNT(*) FILTER (WHERE used.user_id = $2)
+			FROM (` + countedRedemptions + `) used
+			WHERE used.promo_id = $1
+		`
+
+		var uses, userUses int32
+		if err := tx.QueryRow(ctx, countQuery, promo.PromoID, order.UserID).Scan(&uses, &userUses); err != nil {
+			return fmt.Errorf("failed to count promo redemptions: %w", err)
+		}
+
+		if (maxUses > 0 && uses >= maxUses) || (maxUsesPerUser > 0 && userUses >= maxUsesPerUser) {
+			return fmt.Errorf("%w: %s", ErrPromoUnavailable, promo.Code)
+		}
+
+		query := `
+			INSERT INTO promo_redemptions (promo_id, order_id, user_id, code, discount, created_at)
+			VALUES ($1, $2, $3, $4, $5, NOW())
+		`
+
+		_, err = tx.Exec(ctx, query, promo.PromoID, order.ID, order.UserID, promo.Code, promo.Discount)
+		if err != nil {
+			return fmt.Errorf("failed to record promo redemption: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type QuoteRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewQuoteRepository(db *pgxpool.Pool) *QuoteRepository {
+	return &QuoteRepository{db: db}
+}
+
+// Quote fixes the price of an order until ExpiresAt. OrderID is set once an
+// order has been placed with it.
+type Quote struct {
+	ID                string
+	UserID            string
+	RestaurantID      string
+	DeliveryAddressID string
+	Lines             []QuoteLine
+	Price             PriceBreakdown
+	Promos            []AppliedPromo
+	DistanceKm        float64
+	OrderID           string
+	ExpiresAt         time.Time
+}
+
+// QuoteLine is stored as JSON on the quote
+type QuoteLine struct {
+	MenuItemID string            `json:"menu_item_id"`
+	OptionIDs  []string          `json:"option_ids"` // sorted
+	Name       string            `json:"name"`
+	Quantity   int32             `json:"quantity"`
+	UnitPrice  int64             `json:"unit_price"` // minor units, options included
+	Options    []OrderItemOption `json:"options"`
+}
+
+func (r *QuoteRepository) Create(ctx context.Context, quote *Quote) error {
+	query := `
+		INSERT INTO quotes (
+			id, user_id, restaurant_id, delivery_address_id, lines, currency,
+			subtotal, delivery_fee, small_order_fee, service_fee, discount, tax, total,
+			promos, distance_km, expires_at, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, NOW())
+	`
+
+	promos := quote.Promos
+	if promos == nil {
+		promos = []AppliedPromo{}
+	}
+
+	_, err := r.db.Exec(ctx, query,
+		quote.ID, quote.UserID, quote.RestaurantID, quote.DeliveryAddressID, quote.Lines,
+		quote.Price.Currency, quote.Price.Subtotal, quote.Price.DeliveryFee,
+		quote.Price.SmallOrderFee, quote.Price.ServiceFee, quote.Price.Discount, quote.Price.Tax, quote.Price.Total,
+		promos, quote.DistanceKm, quote.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create quote: %w", err)
+	}
+
+	return nil
+}
+
+func (r *QuoteRepository) GetByID(ctx context.Context, id string) (*Quote, error) {
+	var quote Quote
+
+	query := `
+		SELECT id, user_id, restaurant_id, delivery_address_id, lines, currency,
+		       subtotal, delivery_fee, small_order_fee, service_fee, discount, tax, total,
+		       promos, distance_km, COALESCE(order_id::text, ''), expires_at
+		FROM quotes
+		WHERE id = $1
+	`
+
+	err := r.db.QueryRow(ctx, query, id).Scan(
+		&quote.ID, &quote.UserID, &quote.RestaurantID, &quote.DeliveryAddressID, &quote.Lines,
+		&quote.Price.Currency, &quote.Price.Subtotal, &quote.Price.DeliveryFee,
+		&quote.Price.SmallOrderFee, &quote.Price.ServiceFee, &quote.Price.Discount, &quote.Price.Tax, &quote.Price.Total,
+		&quote.Promos, &quote.DistanceKm, &quote.OrderID, &quote.ExpiresAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quote: %w", err)
+	}
+
+	return &quote, nil
+}
@@ -0,0 +1,332 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/repository"
+	restaurantpb "github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Codes reported in CartWarning
+const (
+	WarningPriceChanged    = "price_changed"
+	WarningItemUnavailable = "item_unavailable"
+)
+
+func (s *OrderService) GetCart(ctx context.Context, req *pb.GetCartRequest) (*pb.GetCartResponse, error) {
+	cart, err := s.getCart(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCartResponse{
+		Cart: cart,
+	}, nil
+}
+
+func (s *OrderService) AddCartItem(ctx context.Context, req *pb.AddCartItemRequest) (*pb.AddCartItemResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	if req.RestaurantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "restaurant id is required")
+	}
+
+	if req.MenuItemId == "" {
+		return nil, status.Error(codes.InvalidArgument, "menu item id is required")
+	}
+
+	quantity := req.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+	if quantity < 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be positive")
+	}
+
+	optionIDs := slices.Clone(req.OptionIds)
+	slices.Sort(optionIDs)
+
+	validation, err := s.restaurantClient.ValidateMenuItems(ctx, &restaurantpb.ValidateMenuItemsRequest{
+		RestaurantId: req.RestaurantId,
+		Lines: []*restaurantpb.ItemSelection{{
+			MenuItemId: req.MenuItemId,
+			OptionIds:  optionIDs,
+			Quantity:   quantity,
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate menu item: %w", err)
+	}
+
+	line := validation.Lines[0]
+	if !line.Valid {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot add item to cart: %s", strings.Join(line.Errors, "; "))
+	}
+
+	item := &repository.CartItem{
+		ID:         uuid.New().String(),
+		MenuItemID: req.MenuItemId,
+		OptionIDs:  optionIDs,
+		Name:       line.Name,
+		UnitPrice:  line.UnitPrice,
+		Quantity:   quantity,
+	}
+
+	if err := s.cartRepo.AddItem(ctx, req.UserId, req.RestaurantId, item, req.Replace); err != nil {
+		if errors.Is(err, repository.ErrCartRestaurantMismatch) {
+			return nil, status.Error(codes.FailedPrecondition, "cart holds items from another restaurant, clear it or add with replace")
+		}
+		return nil, err
+	}
+
+	cart, err := s.getCart(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddCartItemResponse{
+		Cart: cart,
+	}, nil
+}
+
+func (s *OrderService) UpdateCartItem(ctx context.Context, req *pb.UpdateCartItemRequest) (*pb.UpdateCartItemResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	if req.CartItemId == "" {
+		return nil, status.Error(codes.InvalidArgument, "cart item id is required")
+	}
+
+	if req.Quantity < 1 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be positive")
+	}
+
+	cart, err := s.cartRepo.Get(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	index := slices.IndexFunc(cart.Items, func(item *repository.CartItem) bool {
+		return item.ID == req.CartItemId
+	})
+	if index < 0 {
+		return nil, status.Error(codes.NotFound, "cart item not found")
+	}
+
+	item := cart.Items[index]
+	item.Quantity = req.Quantity
+
+	// The line keeps the price it was added with, and its price_changed
+	// warning, until the user accepts the new one
+	if req.AcceptPrice {
+		validation, err := s.restaurantClient.ValidateMenuItems(ctx, &restaurantpb.ValidateMenuItemsRequest{
+			RestaurantId: cart.RestaurantID,
+			Lines: []*restaurantpb.ItemSelection{{
+				MenuItemId: item.MenuItemID,
+				OptionIds:  item.OptionIDs,
+				Quantity:   item.Quantity,
+			}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to validate menu item: %w", err)
+		}
+
+		// Lines that can't be ordered have no current price to accept
+		if line := validation.Lines[0]; line.Valid {
+			item.Name = line.Name
+			item.UnitPrice = line.UnitPrice
+		}
+	}
+
+	updated, err := s.cartRepo.UpdateItem(ctx, req.UserId, item)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, status.Error(codes.NotFound, "cart item not found")
+	}
+
+	pbCart, err := s.getCart(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateCartItemResponse{
+		Cart: pbCart,
+	}, nil
+}
+
+func (s *OrderService) RemoveCartItem(ctx context.Context, req *pb.RemoveCartItemRequest) (*pb.RemoveCartItemResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	if req.CartItemId == "" {
+		return nil, status.Error(codes.InvalidArgument, "cart item id is required")
+	}
+
+	removed, err := s.cartRepo.RemoveItem(ctx, req.UserId, req.CartItemId)
+	if err != nil {
+		return nil, err
+	}
+	if !removed {
+		return nil, status.Error(codes.NotFound, "cart item not found")
+	}
+
+	cart, err := s.getCart(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveCartItemResponse{
+		Cart: cart,
+	}, nil
+}
+
+func (s *OrderService) ClearCart(ctx context.Context, req *pb.ClearCartRequest) (*pb.ClearCartResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	if err := s.cartRepo.Clear(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.ClearCartResponse{
+		Cart: &pb.Cart{
+			UserId:   req.UserId,
+			Items:    []*pb.CartItem{},
+			Warnings: []*pb.CartWarning{},
+		},
+	}, nil
+}
+
+// getCart loads the user's cart and checks every line against the current
+// menu.
+func (s *OrderService) getCart(ctx context.Context, userID string) (*pb.Cart, error) {
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	cart, err := s.cartRepo.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cart.Items) == 0 {
+		return checkCart(cart, nil), nil
+	}
+
+	lines := make([]*restaurantpb.ItemSelection, len(cart.Items))
+	for i, item := range cart.Items {
+		lines[i] = &restaurantpb.ItemSelection{
+			MenuItemId: item.MenuItemID,
+			OptionIds:  item.OptionIDs,
+			Quantity:   item.Quantity,
+		}
+	}
+
+	validation, err := s.restaurantClient.ValidateMenuItems(ctx, &restaurantpb.ValidateMenuItemsRequest{
+		RestaurantId: cart.RestaurantID,
+		Lines:        lines,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate cart items: %w", err)
+	}
+
+	return checkCart(cart, validation.Lines), nil
+}
+
+// checkCart shows the cart with the menu's answer for each of its lines:
+// prices are refreshed, lines that can't be ordered any more are flagged
+// and price changes since an item was added are reported.
+func checkCart(cart *repository.Cart, lines []*restaurantpb.LineValidation) *pb.Cart {
+	pbCart := &pb.Cart{
+		UserId:       cart.UserID,
+		RestaurantId: cart.RestaurantID,
+		Items:        make([]*pb.CartItem, len(cart.Items)),
+		Warnings:     []*pb.CartWarning{},
+		UpdatedAt:    cart.UpdatedAt,
+	}
+
+	if len(cart.Items) == 0 {
+		return pbCart
+	}
+
+	var subtotal float64
+	pbCart.Valid = true
+	for i, item := range cart.Items {
+		line := lines[i]
+		pbItem := toPbCartItem(item, line)
+		pbCart.Items[i] = pbItem
+
+		if !line.Valid {
+			pbCart.Valid = false
+			pbCart.Warnings = append(pbCart.Warnings, &pb.CartWarning{
+				CartItemId: item.ID,
+				Code:       WarningItemUnavailable,
+				Message:    fmt.Sprintf("%s can't be ordered: %s", pbItem.Name, strings.Join(line.Errors, "; ")),
+			})
+			continue
+		}
+
+		if toMinorUnits(line.UnitPrice) != toMinorUnits(item.UnitPrice) {
+			pbCart.Warnings = append(pbCart.Warnings, &pb.CartWarning{
+				CartItemId: item.ID,
+				Code:       WarningPriceChanged,
+				Message:    fmt.Sprintf("price of %s changed from %.2f to %.2f", pbItem.Name, item.UnitPrice, line.UnitPrice),
+			})
+		}
+
+		subtotal += line.LinePrice
+	}
+	pbCart.Subtotal = math.Round(subtotal*100) / 100
+
+	return pbCart
+}
+
+// toPbCartItem shows a cart line with its current menu price. Items that are
+// gone from the menu keep the name and price they were added with.
+func toPbCartItem(item *repository.CartItem, line *restaurantpb.LineValidation) *pb.CartItem {
+	pbItem := &pb.CartItem{
+		Id:             item.ID,
+		MenuItemId:     item.MenuItemID,
+		Name:           item.Name,
+		OptionIds:      item.OptionIDs,
+		Options:        make([]*pb.OrderItemOption, len(line.Options)),
+		Quantity:       item.Quantity,
+		UnitPrice:      item.UnitPrice,
+		AddedUnitPrice: item.UnitPrice,
+		LinePrice:      math.Round(item.UnitPrice*float64(item.Quantity)*100) / 100,
+		Valid:          line.Valid,
+		Errors:         line.Errors,
+	}
+
+	if line.Name != "" {
+		pbItem.Name = line.Name
+		pbItem.UnitPrice = line.UnitPrice
+		pbItem.LinePrice = line.LinePrice
+	}
+
+	for i, option := range line.Options {
+		pbItem.Options[i] = &pb.OrderItemOption{
+			GroupName:  option.GroupName,
+			Name:       option.Name,
+			PriceDelta: option.PriceDelta,
+		}
+	}
+
+	return pbItem
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/repository"
+	restaurantpb "github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
+)
+
+func TestCheckCart(t *testing.T) {
+	soup := &repository.CartItem{ID: "c1", MenuItemID: "m1", Name: "Soup", UnitPrice: 10.50, Quantity: 2}
+	bread := &repository.CartItem{ID: "c2", MenuItemID: "m2", Name: "Bread", UnitPrice: 3, Quantity: 1}
+
+	type item struct {
+		name                 string
+		unitPrice, linePrice float64
+		addedUnitPrice       float64
+		valid                bool
+	}
+
+	tests := []struct {
+		name         string
+		items        []*repository.CartItem
+		lines        []*restaurantpb.LineValidation
+		want         []item
+		wantWarnings []string
+		wantValid    bool
+		wantSubtotal float64
+	}{
+		{
+			name:  "nothing changed",
+			items: []*repository.CartItem{soup, bread},
+			lines: []*restaurantpb.LineValidation{
+				{Valid: true, Name: "Soup", UnitPrice: 10.50, LinePrice: 21},
+				{Valid: true, Name: "Bread", UnitPrice: 3, LinePrice: 3},
+			},
+			want: []item{
+				{name: "Soup", unitPrice: 10.50, linePrice: 21, addedUnitPrice: 10.50, valid: true},
+				{name: "Bread", unitPrice: 3, linePrice: 3, addedUnitPrice: 3, valid: true},
+			},
+			wantValid:    true,
+			wantSubtotal: 24,
+		},
+		{
+			name:  "price went up",
+			items: []*repository.CartItem{soup},
+			lines: []*restaurantpb.LineValidation{
+				{Valid: true, Name: "Soup", UnitPrice: 12, LinePrice: 24},
+			},
+			want:         []item{{name: "Soup", unitPrice: 12, linePrice: 24, addedUnitPrice: 10.50, valid: true}},
+			wantWarnings: []string{"c1:" + WarningPriceChanged},
+			wantValid:    true,
+			wantSubtotal: 24,
+		},
+		{
+			name:  "less than a cent is no change",
+			items: []*repository.CartItem{soup},
+			lines: []*restaurantpb.LineValidation{
+				{Valid: true, Name: "Soup", UnitPrice: 10.501, LinePrice: 21.002},
+			},
+			want:         []item{{name: "Soup", unitPrice: 10.501, linePrice: 21.002, addedUnitPrice: 10.50, valid: true}},
+			wantValid:    true,
+			wantSubtotal: 21,
+		},
+		{
+			name:  "renamed item shows its new name",
+			items: []*repository.CartItem{bread},
+			lines: []*restaurantpb.LineValidation{
+				{Valid: true, Name: "Sourdough", UnitPrice: 3, LinePrice: 3},
+			},
+			want:         []item{{name: "Sourdough", unitPrice: 3, linePrice: 3, addedUnitPrice: 3, valid: true}},
+			wantValid:    true,
+			wantSubtotal: 3,
+		},
+		{
+			name:  "sold out item stays but doesn't count",
+			items: []*repository.CartItem{soup, bread},
+			lines: []*restaurantpb.LineValidation{
+				{Valid: false, Name: "Soup", UnitPrice: 10.50, LinePrice: 21, Errors: []string{"item is not available"}},
+				{Valid: true, Name: "Bread", UnitPrice: 3, LinePrice: 3},
+			},
+			want: []item{
+				{name: "Soup", unitPrice: 10.50, linePrice: 21, addedUnitPrice: 10.50},
+				{name: "Bread", unitPrice: 3, linePrice: 3, addedUnitPrice: 3, valid: true},
+			},
+			wantWarnings: []string{"c1:" + WarningItemUnavailable},
+			wantSubtotal: 3,
+		},
+		{
+			name:  "item gone from the menu keeps what it was added with",
+			items: []*repository.CartItem{soup},
+			lines: []*restaurantpb.LineValidation{
+				{Valid: false, Errors: []string{"menu item not found"}},
+			},
+			want:         []item{{name: "Soup", unitPrice: 10.50, linePrice: 21, addedUnitPrice: 10.50}},
+			wantWarnings: []string{"c1:" + WarningItemUnavailable},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cart := checkCart(&repository.Cart{UserID: "u1", RestaurantID: "r1", Items: tt.items}, tt.lines)
+
+			var got []item
+			for _, i := range cart.Items {
+				got = append(got, item{
+					name:           i.Name,
+					unitPrice:      i.UnitPrice,
+					linePrice:      i.LinePrice,
+					addedUnitPrice: i.AddedUnitPrice,
+					valid:          i.Valid,
+				})
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("items = %+v, want %+v", got, tt.want)
+			}
+
+			if got := cartWarnings(cart.Warnings); !slices.Equal(got, tt.wantWarnings) {
+				t.Errorf("warnings = %v, want %v", got, tt.wantWarnings)
+			}
+			if cart.Valid != tt.wantValid {
+				t.Errorf("valid = %v, want %v", cart.Valid, tt.wantValid)
+			}
+			if cart.Subtotal != tt.wantSubtotal {
+				t.Errorf("subtotal = %v, want %v", cart.Subtotal, tt.wantSubtotal)
+			}
+		})
+	}
+}
+
+func TestCheckEmptyCart(t *testing.T) {
+	cart := checkCart(&repository.Cart{UserID: "u1", Items: []*repository.CartItem{}}, nil)
+
+	if cart.Valid || len(cart.Items) != 0 || cart.Warnings == nil {
+		t.Errorf("empty cart = %+v, want invalid with no items and an empty warning list", cart)
+	}
+}
+
+func TestToPbCartItemOptions(t *testing.T) {
+	item := &repository.CartItem{ID: "c1", MenuItemID: "m1", OptionIDs: []string{"o1", "o2"}, Name: "Pizza", UnitPrice: 14, Quantity: 1}
+	line := &restaurantpb.LineValidation{
+		Valid:     true,
+		Name:      "Pizza",
+		UnitPrice: 14,
+		LinePrice: 14,
+		Options: []*restaurantpb.SelectedOption{
+			{GroupName: "Size", Name: "Large", PriceDelta: 3},
+			{GroupName: "Sauce", Name: "Garlic", PriceDelta: 1},
+		},
+	}
+
+	got := toPbCartItem(item, line)
+
+	want := []*pb.OrderItemOption{
+		{GroupName: "Size", Name: "Large", PriceDelta: 3},
+		{GroupName: "Sauce", Name: "Garlic", PriceDelta: 1},
+	}
+	if len(got.Options) != len(want) {
+		t.Fatalf("got %d options, want %d", len(got.Options), len(want))
+	}
+	for i, option := range got.Options {
+		if option.GroupName != want[i].GroupName || option.Name != want[i].Name || option.PriceDelta != want[i].PriceDelta {
+			t.Errorf("option %d = %v, want %v", i, option, want[i])
+		}
+	}
+	if !slices.Equal(got.OptionIds, item.OptionIDs) {
+		t.Errorf("option ids = %v, want %v", got.OptionIds, item.OptionIDs)
+	}
+}
+
+func cartWarnings(warnings []*pb.CartWarning) []string {
+	var codes []string
+	for _, warning := range warnings {
+		codes = append(codes, warning.CartItemId+":"+warning.Code)
+	}
+	return codes
+}
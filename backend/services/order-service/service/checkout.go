@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/pkg"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/repository"
+	restaurantpb "github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
+	userpb "github.com/kimashii-dan/food-delivery-app/backend/services/user-service/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Pricing sets what is charged on top of the items. Amounts are minor units
+// of Currency, rates are basis points (1/100 of a percent).
+type Pricing struct {
+	Currency          string
+	DeliveryBaseFee   int64
+	DeliveryFeePerKm  int64 // for every started kilometer from the restaurant
+	SmallOrderMinimum int64 // subtotals below it pay SmallOrderFee
+	SmallOrderFee     int64
+	ServiceFeeRate    int64 // of the subtotal
+	TaxRate           int64 // of the subtotal and all fees, less discounts
+	QuoteTTL          time.Duration
+}
+
+var DefaultPricing = Pricing{
+	Currency:          "KZT",
+	DeliveryBaseFee:   30000,
+	DeliveryFeePerKm:  5000,
+	SmallOrderMinimum: 200000,
+	SmallOrderFee:     20000,
+	ServiceFeeRate:    500,
+	TaxRate:           1200,
+	QuoteTTL:          10 * time.Minute,
+}
+
+// price works out the fees, tax and total for a subtotal delivered over
+// distanceKm. Fees are based on the subtotal before the discount, which comes
+// off before tax.
+func (p Pricing) price(subtotal int64, distanceKm float64, discount int64) repository.PriceBreakdown {
+	price := repository.PriceBreakdown{
+		Currency:    p.Currency,
+		Subtotal:    subtotal,
+		DeliveryFee: p.deliveryFee(distanceKm),
+		ServiceFee:  applyRate(subtotal, p.ServiceFeeRate),
+		Discount:    discount,
+	}
+
+	if subtotal < p.SmallOrderMinimum {
+		price.SmallOrderFee = p.SmallOrderFee
+	}
+
+	taxable := price.Subtotal + price.DeliveryFee + price.SmallOrderFee + price.ServiceFee - price.Discount
+	price.Tax = applyRate(taxable, p.TaxRate)
+	price.Total = taxable + price.Tax
+
+	return price
+}
+
+func (p Pricing) deliveryFee(distanceKm float64) int64 {
+	return p.DeliveryBaseFee + p.DeliveryFeePerKm*int64(math.Ceil(distanceKm))
+}
+
+// applyRate takes rate basis points of amount, rounding half up
+func applyRate(amount, rate int64) int64 {
+	return (amount*rate + 5000) / 10000
+}
+
+// checkout is what restaurant-service and user-service said about an order
+// that can be placed
+type checkout struct {
+	lines      []*restaurantpb.LineValidation
+	distanceKm float64
+}
+
+// prepareCheckout asks the other services whether the order can be placed:
+// the restaurant must be open, the address inside its delivery zone and the
+// lines valid. When it can't, the reason is returned as a rejection.
+func (s *OrderService) prepareCheckout(ctx context.Context, userID, restaurantID, addressID string, lines []*restaurantpb.ItemSelection) (*checkout, *pb.OrderRejection, error) {
+	statusResp, err := s.restaurantClient.GetRestaurantStatus(ctx, &restaurantpb.GetRestaurantStatusRequest{
+		RestaurantId: restaurantID,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "failed to get restaurant status: %v", err)
+	}
+
+	if !statusResp.IsAcceptingOrders {
+		return nil, &pb.OrderRejection{
+			Reason:           RejectionRestaurantClosed,
+			UnavailableItems: []string{},
+		}, nil
+	}
+
+	addressResp, err := s.userClient.GetAddress(ctx, &userpb.GetAddressRequest{
+		AddressId: addressID,
+		UserId:    userID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil, status.Error(codes.InvalidArgument, "delivery address not found")
+		}
+		return nil, nil, fmt.Errorf("failed to get delivery address: %w", err)
+	}
+
+	zoneResp, err := s.restaurantClient.CheckDeliverable(ctx, &restaurantpb.CheckDeliverableRequest{
+		RestaurantId: restaurantID,
+		Latitude:     addressResp.Address.Latitude,
+		Longitude:    addressResp.Address.Longitude,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to check delivery zone: %w", err)
+	}
+
+	if !zoneResp.Deliverable {
+		return nil, &pb.OrderRejection{
+			Reason:           RejectionOutsideZone,
+			UnavailableItems: []string{},
+		}, nil
+	}
+
+	validation, err := s.restaurantClient.ValidateMenuItems(ctx, &restaurantpb.ValidateMenuItemsRequest{
+		RestaurantId: restaurantID,
+		Lines:        lines,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to validate menu items: %w", err)
+	}
+
+	if !validation.AllAvailable {
+		return nil, &pb.OrderRejection{
+			Reason:           RejectionItemsUnavailable,
+			UnavailableItems: validation.UnavailableItems,
+		}, nil
+	}
+
+	if !validation.AllValid {
+		var errs []string
+		for _, line := range validation.Lines {
+			for _, lineErr := range line.Errors {
+				errs = append(errs, line.Name+": "+lineErr)
+			}
+		}
+
+		return nil, &pb.OrderRejection{
+			Reason:           RejectionInvalidOptions,
+			UnavailableItems: []string{},
+			Errors:           errs,
+		}, nil
+	}
+
+	restaurantResp, err := s.restaurantClient.GetRestaurant(ctx, &restaurantpb.GetRestaurantRequest{
+		Id: restaurantID,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get restaurant: %w", err)
+	}
+
+	distanceKm := pkg.HaversineKm(
+		restaurantResp.Restaurant.Latitude, restaurantResp.Restaurant.Longitude,
+		addressResp.Address.Latitude, addressResp.Address.Longitude,
+	)
+
+	return &checkout{
+		lines:      validation.Lines,
+		distanceKm: math.Round(distanceKm*100) / 100,
+	}, nil, nil
+}
+
+// toQuoteLines snapshots the priced lines of a checkout in minor units.
+// selections are the lines that were validated, in the same order.
+func toQuoteLines(selections []*restaurantpb.ItemSelection, validations []*restaurantpb.LineValidation) []repository.QuoteLine {
+	lines := make([]repository.QuoteLine, len(validations))
+	for i, validation := range validations {
+		options := make([]repository.OrderItemOption, len(validation.Options))
+		for j, option := range validation.Options {
+			options[j] = repository.OrderItemOption{
+				GroupName:  option.GroupName,
+				Name:       option.Name,
+				PriceDelta: option.PriceDelta,
+			}
+		}
+
+		lines[i] = repository.QuoteLine{
+			MenuItemID: validation.MenuItemId,
+			OptionIDs:  selections[i].OptionIds,
+			Name:       validation.Name,
+			Quantity:   validation.Quantity,
+			UnitPrice:  toMinorUnits(validation.UnitPrice),
+			Options:    options,
+		}
+	}
+
+	return lines
+}
+
+func subtotalOf(lines []repository.QuoteLine) int64 {
+	var subtotal int64
+	for _, line := range lines {
+		subtotal += line.UnitPrice * int64(line.Quantity)
+	}
+	return subtotal
+}
+
+// toMinorUnits converts a price from restaurant-service, which uses major
+// units, so prices can be compared and added up exactly
+func toMinorUnits(price float64) int64 {
+	return int64(math.Round(price * 100))
+}
+
+func toPbPriceBreakdown(price repository.PriceBreakdown) *pb.PriceBreakdown {
+	return &pb.PriceBreakdown{
+		Currency:      price.Currency,
+		Subtotal:      price.Subtotal,
+		DeliveryFee:   price.DeliveryFee,
+		SmallOrderFee: price.SmallOrderFee,
+		ServiceFee:    price.ServiceFee,
+		Discount:      price.Discount,
+		Tax:           price.Tax,
+		Total:         price.Total,
+	}
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/repository"
+)
+
+func TestPricingPrice(t *testing.T) {
+	pricing := Pricing{
+		Currency:          "KZT",
+		DeliveryBaseFee:   30000,
+		DeliveryFeePerKm:  5000,
+		SmallOrderMinimum: 200000,
+		SmallOrderFee:     20000,
+		ServiceFeeRate:    500,
+		TaxRate:           1200,
+	}
+
+	tests := []struct {
+		name       string
+		subtotal   int64
+		distanceKm float64
+		discount   int64
+		want       repository.PriceBreakdown
+	}{
+		{
+			name:       "every started kilometer is charged",
+			subtotal:   300000,
+			distanceKm: 2.1,
+			want: repository.PriceBreakdown{
+				Subtotal: 300000, DeliveryFee: 45000, ServiceFee: 15000,
+				Tax: 43200, Total: 403200,
+			},
+		},
+		{
+			name:       "small order fee below the minimum",
+			subtotal:   199999,
+			distanceKm: 0,
+			want: repository.PriceBreakdown{
+				Subtotal: 199999, DeliveryFee: 30000, SmallOrderFee: 20000, ServiceFee: 10000,
+				Tax: 31200, Total: 291199,
+			},
+		},
+		{
+			name:       "no small order fee at the minimum",
+			subtotal:   200000,
+			distanceKm: 1,
+			want: repository.PriceBreakdown{
+				Subtotal: 200000, DeliveryFee: 35000, ServiceFee: 10000,
+				Tax: 29400, Total: 274400,
+			},
+		},
+		{
+			name:       "discount comes off before tax, fees stay",
+			subtotal:   300000,
+			distanceKm: 2,
+			discount:   60000,
+			want: repository.PriceBreakdown{
+				Subtotal: 300000, DeliveryFee: 40000, ServiceFee: 15000, Discount: 60000,
+				Tax: 35400, Total: 330400,
+			},
+		},
+		{
+			name:       "rates round half up",
+			subtotal:   210010,
+			distanceKm: 0,
+			want: repository.PriceBreakdown{
+				Subtotal: 210010, DeliveryFee: 30000, ServiceFee: 10501,
+				Tax: 30061, Total: 280572,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want.Currency = "KZT"
+			if got := pricing.price(tt.subtotal, tt.distanceKm, tt.discount); got != tt.want {
+				t.Errorf("price = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
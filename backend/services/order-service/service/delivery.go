@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	deliverypb "github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/repository"
+	restaurantpb "github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
+	userpb "github.com/kimashii-dan/food-delivery-app/backend/services/user-service/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// requestDelivery asks delivery-service for a courier, picking up at the
+// restaurant and dropping off at the order's delivery address.
+func (s *OrderService) requestDelivery(ctx context.Context, order *repository.Order) error {
+	restaurantResp, err := s.restaurantClient.GetRestaurant(ctx, &restaurantpb.GetRestaurantRequest{
+		Id: order.RestaurantID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get restaurant: %w", err)
+	}
+
+	addressResp, err := s.userClient.GetAddress(ctx, &userpb.GetAddressRequest{
+		AddressId: order.DeliveryAddressID,
+		UserId:    order.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get delivery address: %w", err)
+	}
+
+	_, err = s.deliveryClient.CreateDelivery(ctx, &deliverypb.CreateDeliveryRequest{
+		OrderId:          order.ID,
+		CustomerId:       order.UserID,
+		RestaurantId:     order.RestaurantID,
+		PickupLatitude:   restaurantResp.Restaurant.Latitude,
+		PickupLongitude:  restaurantResp.Restaurant.Longitude,
+		DropoffLatitude:  addressResp.Address.Latitude,
+		DropoffLongitude: addressResp.Address.Longitude,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create delivery: %w", err)
+	}
+
+	return nil
+}
+
+// checkAssignedCourier makes sure courierID is the courier delivering the order.
+func (s *OrderService) checkAssignedCourier(ctx context.Context, orderID, courierID string) error {
+	deliveryResp, err := s.deliveryClient.GetDelivery(ctx, &deliverypb.GetDeliveryRequest{
+		OrderId: orderID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return status.Error(codes.FailedPrecondition, "order has no delivery yet")
+		}
+		return fmt.Errorf("failed to get delivery: %w", err)
+	}
+
+	if deliveryResp.Delivery.CourierId != courierID {
+		return status.Error(codes.PermissionDenied, "order is delivered by another courier")
+	}
+
+	return nil
+}
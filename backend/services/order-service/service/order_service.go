@@ -1 +1,417 @@
 package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/kimashii-dan/food-delivery-app/backend/pkg"
+	deliverypb "github.com/kimashii-dan/food-delivery-app/backend/services/delivery-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/payments"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/repository"
+	restaurantpb "github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
+	userpb "github.com/kimashii-dan/food-delivery-app/backend/services/user-service/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Reasons reported in OrderRejection
+const (
+	RejectionRestaurantClosed = "restaurant_closed"
+	RejectionItemsUnavailable = "items_unavailable"
+	RejectionOutsideZone      = "outside_delivery_zone"
+	RejectionInvalidOptions   = "invalid_options"
+	RejectionPaymentDeclined  = "payment_declined"
+	RejectionInvalidPromoCode = "invalid_promo_code"
+)
+
+// defaultPageSize is used by list RPCs when the request leaves page_size out
+const defaultPageSize = 10
+
+type OrderService struct {
+	pb.UnimplementedOrderServiceServer
+	orderRepo        *repository.OrderRepository
+	cartRepo         *repository.CartRepository
+	quoteRepo        *repository.QuoteRepository
+	paymentRepo      *repository.PaymentRepository
+	promoRepo        *repository.PromoRepository
+	restaurantClient restaurantpb.RestaurantServiceClient
+	userClient       userpb.UserServiceClient
+	deliveryClient   deliverypb.DeliveryServiceClient
+	pricing          Pricing
+	payments         payments.Provider
+}
+
+func NewOrderService(
+	orderRepo *repository.OrderRepository,
+	cartRepo *repository.CartRepository,
+	quoteRepo *repository.QuoteRepository,
+	paymentRepo *repository.PaymentRepository,
+	promoRepo *repository.PromoRepository,
+	restaurantClient restaurantpb.RestaurantServiceClient,
+	userClient userpb.UserServiceClient,
+	deliveryClient deliverypb.DeliveryServiceClient,
+	pricing Pricing,
+	paymentProvider payments.Provider,
+) *OrderService {
+	return &OrderService{
+		orderRepo:        orderRepo,
+		cartRepo:         cartRepo,
+		quoteRepo:        quoteRepo,
+		paymentRepo:      paymentRepo,
+		promoRepo:        promoRepo,
+		restaurantClient: restaurantClient,
+		userClient:       userClient,
+		deliveryClient:   deliveryClient,
+		pricing:          pricing,
+		payments:         paymentProvider,
+	}
+}
+
+func (s *OrderService) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.CreateOrderResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	restaurantID, addressID := req.RestaurantId, req.DeliveryAddressId
+
+	var quote *repository.Quote
+	if req.QuoteId != "" {
+		var err error
+		quote, err = s.getUsableQuote(ctx, req.QuoteId, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+
+		if restaurantID == "" {
+			restaurantID = quote.RestaurantID
+		} else if !strings.EqualFold(restaurantID, quote.RestaurantID) {
+			return nil, status.Error(codes.InvalidArgument, "quote is for another restaurant")
+		}
+
+		if addressID == "" {
+			addressID = quote.DeliveryAddressID
+		} else if addressID != quote.DeliveryAddressID {
+			return nil, status.Error(codes.InvalidArgument, "quote is for another delivery address")
+		}
+	}
+
+	if restaurantID == "" {
+		return nil, status.Error(codes.InvalidArgument, "restaurant id is required")
+	}
+
+	if addressID == "" {
+		return nil, status.Error(codes.InvalidArgument, "delivery address id is required")
+	}
+
+	var lines []*restaurantpb.ItemSelection
+	if quote != nil && len(req.Items) == 0 {
+		lines = quoteSelections(quote)
+	} else {
+		var err error
+		lines, err = mergeLines(req.Items)
+		if err != nil {
+			return nil, err
+		}
+
+		if quote != nil && !sameSelections(lines, quoteSelections(quote)) {
+			return nil, status.Error(codes.InvalidArgument, "items differ from the quote")
+		}
+	}
+
+	// Ask restaurant-service and user-service before persisting anything
+	checkout, rejection, err := s.prepareCheckout(ctx, req.UserId, restaurantID, addressID, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	if rejection != nil {
+		return &pb.CreateOrderResponse{
+			Rejection: rejection,
+		}, nil
+	}
+
+	// A valid quote keeps its prices and promos even if the menu changed since
+	var orderLines []repository.QuoteLine
+	var price repository.PriceBreakdown
+	var promos []repository.AppliedPromo
+	if quote != nil {
+		orderLines = quote.Lines
+		price = quote.Price
+		promos = quote.Promos
+	} else {
+		orderLines = toQuoteLines(lines, checkout.lines)
+		subtotal := subtotalOf(orderLines)
+
+		var promoRejections []*pb.PromoRejection
+		promos, promoRejections, err = s.applyPromos(ctx, req.UserId, restaurantID, req.PromoCodes, subtotal, checkout.distanceKm)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(promoRejections) > 0 {
+			return &pb.CreateOrderResponse{
+				Rejection: &pb.OrderRejection{
+					Reason:           RejectionInvalidPromoCode,
+					UnavailableItems: []string{},
+					PromoRejections:  promoRejections,
+				},
+			}, nil
+		}
+
+		price = s.pricing.price(subtotal, checkout.distanceKm, discountOf(promos))
+	}
+
+	order := &repository.Order{
+		ID:                uuid.New().String(),
+		UserID:            req.UserId,
+		RestaurantID:      restaurantID,
+		DeliveryAddressID: addressID,
+		Status:            StatusPending,
+		TotalPrice:        float64(price.Total) / 100,
+		QuoteID:           req.QuoteId,
+		Price:             price,
+		Promos:            promos,
+	}
+
+	// Snapshot names and prices so later menu changes don't alter the order
+	for _, line := range orderLines {
+		order.Items = append(order.Items, &repository.OrderItem{
+			ID:         uuid.New().String(),
+			MenuItemID: line.MenuItemID,
+			Name:       line.Name,
+			Price:      float64(line.UnitPrice) / 100,
+			Quantity:   line.Quantity,
+			Options:    line.Options,
+		})
+	}
+
+	// Restaurants can only accept the order once the payment is authorized
+	payment, rejection, err := s.authorizePayment(ctx, order, req.PaymentMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	if rejection != nil {
+		return &pb.CreateOrderResponse{
+			Rejection: rejection,
+		}, nil
+	}
+	order.Payment = payment
+
+	initial := &repository.StatusChange{
+		ID:          uuid.New().String(),
+		OrderID:     order.ID,
+		ToStatus:    StatusPending,
+		ActorUserID: req.UserId,
+		ActorRole:   RoleCustomer,
+	}
+
+	if err := s.orderRepo.Create(ctx, order, initial); err != nil {
+		s.voidAuthorization(ctx, payment)
+		if errors.Is(err, repository.ErrQuoteUnavailable) {
+			return nil, status.Error(codes.FailedPrecondition, "quote has expired or was already used")
+		}
+		if errors.Is(err, repository.ErrPromoUnavailable) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, fmt.Errorf("failed to create order: %w", err)
+	}
+
+	order, err = s.orderRepo.GetByID(ctx, order.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get created order: %w", err)
+	}
+
+	return &pb.CreateOrderResponse{
+		Order: toPbOrder(order),
+	}, nil
+}
+
+func (s *OrderService) GetOrder(ctx context.Context, req *pb.GetOrderRequest) (*pb.GetOrderResponse, error) {
+	order, err := s.getUserOrder(ctx, req.OrderId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.orderRepo.GetStatusHistory(ctx, order.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order status history: %w", err)
+	}
+
+	return &pb.GetOrderResponse{
+		Order:   toPbOrder(order),
+		History: toPbStatusHistory(history),
+	}, nil
+}
+
+func (s *OrderService) ListOrdersForUser(ctx context.Context, req *pb.ListOrdersForUserRequest) (*pb.ListOrdersForUserResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	pageSize, err := pkg.PageSize(req.PageSize, defaultPageSize)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pageFilter := pkg.PageFilter(req.UserId)
+	after, err := pkg.DecodePageToken[repository.OrderCursor](req.PageToken, pageFilter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	orders, next, err := s.orderRepo.GetByUserID(ctx, req.UserId, after, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get orders: %w", err)
+	}
+
+	nextPageToken, err := pkg.EncodePageToken(pageFilter, next)
+	if err != nil {
+		return nil, err
+	}
+
+	pbOrders := make([]*pb.Order, len(orders))
+	for i, order := range orders {
+		pbOrders[i] = toPbOrder(order)
+	}
+
+	return &pb.ListOrdersForUserResponse{
+		Orders:        pbOrders,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *OrderService) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*pb.CancelOrderResponse, error) {
+	order, err := s.getUserOrder(ctx, req.OrderId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled, err := s.changeStatus(ctx, order, StatusCancelled, req.UserId, RoleCustomer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CancelOrderResponse{
+		Order: toPbOrder(cancelled),
+	}, nil
+}
+
+func (s *OrderService) getOrder(ctx context.Context, orderID string) (*repository.Order, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "order not found")
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	return order, nil
+}
+
+// getUserOrder loads an order and makes sure it belongs to userID.
+func (s *OrderService) getUserOrder(ctx context.Context, orderID, userID string) (*repository.Order, error) {
+	if orderID == "" {
+		return nil, status.Error(codes.InvalidArgument, "order id is required")
+	}
+
+	order, err := s.getOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.UserID != userID {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+
+	return order, nil
+}
+
+// mergeLines validates the requested items and merges lines that order the
+// same menu item with the same options, summing their quantities.
+func mergeLines(items []*pb.OrderItemInput) ([]*restaurantpb.ItemSelection, error) {
+	if len(items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one item is required")
+	}
+
+	var lines []*restaurantpb.ItemSelection
+	index := make(map[string]int, len(items))
+	for _, item := range items {
+		if item.MenuItemId == "" {
+			return nil, status.Error(codes.InvalidArgument, "menu item id is required")
+		}
+		if item.Quantity < 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "quantity for menu item %s must be positive", item.MenuItemId)
+		}
+
+		optionIDs := slices.Clone(item.OptionIds)
+		slices.Sort(optionIDs)
+
+		key := selectionKey(item.MenuItemId, optionIDs)
+		if i, ok := index[key]; ok {
+			lines[i].Quantity += item.Quantity
+			continue
+		}
+
+		index[key] = len(lines)
+		lines = append(lines, &restaurantpb.ItemSelection{
+			MenuItemId: item.MenuItemId,
+			OptionIds:  optionIDs,
+			Quantity:   item.Quantity,
+		})
+	}
+
+	return lines, nil
+}
+
+// selectionKey identifies a menu item with a sorted set of options
+func selectionKey(menuItemID string, optionIDs []string) string {
+	return menuItemID + "|" + strings.Join(optionIDs, ",")
+}
+
+func toPbOrder(order *repository.Order) *pb.Order {
+	items := make([]*pb.OrderItem, len(order.Items))
+	for i, item := range order.Items {
+		items[i] = &pb.OrderItem{
+			Id:         item.ID,
+			MenuItemId: item.MenuItemID,
+			Name:       item.Name,
+			Price:      item.Price,
+			Quantity:   item.Quantity,
+			Options:    toPbOrderItemOptions(item.Options),
+		}
+	}
+
+	return &pb.Order{
+		Id:                order.ID,
+		UserId:            order.UserID,
+		RestaurantId:      order.RestaurantID,
+		DeliveryAddressId: order.DeliveryAddressID,
+		Status:            order.Status,
+		TotalPrice:        order.TotalPrice,
+		QuoteId:           order.QuoteID,
+		Price:             toPbPriceBreakdown(order.Price),
+		PaymentStatus:     order.PaymentStatus,
+		Items:             items,
+		CreatedAt:         order.CreatedAt,
+		UpdatedAt:         order.UpdatedAt,
+	}
+}
+
+func toPbOrderItemOptions(options []repository.OrderItemOption) []*pb.OrderItemOption {
+	pbOptions := make([]*pb.OrderItemOption, len(options))
+	for i, option := range options {
+		pbOptions[i] = &pb.OrderItemOption{
+			GroupName:  option.GroupName,
+			Name:       option.Name,
+			PriceDelta: option.PriceDelta,
+		}
+	}
+	return pbOptions
+}
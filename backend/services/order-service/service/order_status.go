@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Order lifecycle statuses
+const (
+	StatusPending        = "pending"
+	StatusAccepted       = "accepted"
+	StatusPreparing      = "preparing"
+	StatusReadyForPickup = "ready_for_pickup"
+	StatusPickedUp       = "picked_up"
+	StatusDelivered      = "delivered"
+	StatusCancelled      = "cancelled"
+	StatusRejected       = "rejected"
+)
+
+// Roles that can drive order transitions, as stored on users in user-service
+const (
+	RoleCustomer   = "customer"
+	RoleRestaurant = "restaurant"
+	RoleCourier    = "courier"
+	// RoleSystem acts for order-service itself, e.g. when a payment fails
+	RoleSystem = "system"
+	// RoleAdmin manages promo codes but drives no transitions
+	RoleAdmin = "admin"
+)
+
+// transitions lists, for every status, the statuses it can move to and the
+// roles allowed to make that move. Statuses missing here are final.
+var transitions = map[string]map[string][]string{
+	StatusPending: {
+		StatusAccepted:  {RoleRestaurant},
+		StatusRejected:  {RoleRestaurant},
+		StatusCancelled: {RoleCustomer, RoleRestaurant, RoleSystem},
+	},
+	StatusAccepted: {
+		StatusPreparing: {RoleRestaurant},
+		StatusCancelled: {RoleRestaurant},
+	},
+	StatusPreparing: {
+		StatusReadyForPickup: {RoleRestaurant},
+	},
+	StatusReadyForPickup: {
+		StatusPickedUp: {RoleCourier},
+	},
+	StatusPickedUp: {
+		StatusDelivered: {RoleCourier},
+	},
+}
+
+// checkTransition reports whether role may move an order from one status to another.
+func checkTransition(from, to, role string) error {
+	next, ok := transitions[from]
+	if !ok {
+		return status.Errorf(codes.FailedPrecondition, "order in status %s can no longer change", from)
+	}
+
+	roles, ok := next[to]
+	if !ok {
+		return status.Errorf(codes.FailedPrecondition, "order cannot move from %s to %s", from, to)
+	}
+
+	if !slices.Contains(roles, role) {
+		return status.Errorf(codes.PermissionDenied, "role %s cannot move an order from %s to %s", role, from, to)
+	}
+
+	return nil
+}
+
+func (s *OrderService) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatusRequest) (*pb.UpdateOrderStatusResponse, error) {
+	if req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order id is required")
+	}
+
+	if req.Status == "" {
+		return nil, status.Error(codes.InvalidArgument, "status is required")
+	}
+
+	if req.ActorUserId == "" || req.ActorRole == "" {
+		return nil, status.Error(codes.InvalidArgument, "actor user id and role are required")
+	}
+
+	order, err := s.getOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ActorRole == RoleCustomer && order.UserID != req.ActorUserId {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+
+	// restaurants only move the orders placed with them
+	if req.ActorRole == RoleRestaurant {
+		if err := s.checkRestaurantOwner(ctx, order.RestaurantID, req.ActorUserId); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, status.Error(codes.NotFound, "order not found")
+			}
+			return nil, err
+		}
+	}
+
+	updated, err := s.changeStatus(ctx, order, req.Status, req.ActorUserId, req.ActorRole)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateOrderStatusResponse{
+		Order: toPbOrder(updated),
+	}, nil
+}
+
+// changeStatus applies a checked transition, records it in the order's
+// history and returns the updated order.
+func (s *OrderService) changeStatus(ctx context.Context, order *repository.Order, to, actorUserID, actorRole string) (*repository.Order, error) {
+	if err := checkTransition(order.Status, to, actorRole); err != nil {
+		return nil, err
+	}
+
+	if actorRole == RoleCourier {
+		if err := s.checkAssignedCourier(ctx, order.ID, actorUserID); err != nil {
+			return nil, err
+		}
+	}
+
+	// The effect runs only for the update that claims the transition and
+	// undoes it when it fails, so a lost race never captures or dispatches
+	var effect func() error
+	switch to {
+	case StatusAccepted:
+		// Only paid orders are accepted; the money is taken when they are
+		effect = func() error { return s.capturePayment(ctx, order.ID) }
+	case StatusReadyForPickup:
+		// Orders ready for pickup need a courier; delivery-service ignores repeats
+		effect = func() error { return s.requestDelivery(ctx, order) }
+	}
+
+	updated, err := s.orderRepo.UpdateStatus(ctx, &repository.StatusChange{
+		ID:          uuid.New().String(),
+		OrderID:     order.ID,
+		FromStatus:  order.Status,
+		ToStatus:    to,
+		ActorUserID: actorUserID,
+		ActorRole:   actorRole,
+	}, effect)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update order status: %w", err)
+	}
+	if !updated {
+		return nil, status.Error(codes.Aborted, "order status changed concurrently, retry")
+	}
+
+	// The order is already cancelled, a failed refund must not undo that
+	if to == StatusCancelled || to == StatusRejected {
+		if err := s.releasePayment(ctx, order.ID); err != nil {
+			log.Printf("Failed to release payment of order %s: %v", order.ID, err)
+		}
+	}
+
+	order, err = s.orderRepo.GetByID(ctx, order.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get updated order: %w", err)
+	}
+
+	return order, nil
+}
+
+func toPbStatusHistory(history []*repository.StatusChange) []*pb.OrderStatusChange {
+	pbHistory := make([]*pb.OrderStatusChange, len(history))
+	for i, change := range history {
+		pbHistory[i] = &pb.OrderStatusChange{
+			FromStatus:  change.FromStatus,
+			ToStatus:    change.ToStatus,
+			ActorUserId: change.ActorUserID,
+			ActorRole:   change.ActorRole,
+			CreatedAt:   change.CreatedAt,
+		}
+	}
+	return pbHistory
+}
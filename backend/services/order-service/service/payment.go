@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/payments"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// systemActorID is recorded in the history of status changes made on behalf
+// of the payment provider
+const systemActorID = "payments"
+
+// paymentEvents maps webhook event types to the payment statuses they move
+// from and the status they move to
+var paymentEvents = map[string]struct {
+	from []string
+	to   string
+}{
+	payments.EventAuthorized: {[]string{payments.StatusPending}, payments.StatusAuthorized},
+	payments.EventFailed:     {[]string{payments.StatusPending}, payments.StatusFailed},
+	payments.EventCaptured:   {[]string{payments.StatusAuthorized}, payments.StatusCaptured},
+	payments.EventVoided:     {[]string{payments.StatusPending, payments.StatusAuthorized}, payments.StatusVoided},
+	payments.EventRefunded:   {[]string{payments.StatusCaptured}, payments.StatusRefunded},
+}
+
+func (s *OrderService) HandlePaymentWebhook(ctx context.Context, req *pb.HandlePaymentWebhookRequest) (*pb.HandlePaymentWebhookResponse, error) {
+	if req.EventId == "" {
+		return nil, status.Error(codes.InvalidArgument, "event id is required")
+	}
+
+	if req.PaymentRef == "" {
+		return nil, status.Error(codes.InvalidArgument, "payment ref is required")
+	}
+
+	move, ok := paymentEvents[req.Type]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown payment event type %q", req.Type)
+	}
+
+	event := &repository.PaymentEvent{
+		ID:            req.EventId,
+		Provider:      s.payments.Name(),
+		Type:          req.Type,
+		ProviderRef:   req.PaymentRef,
+		FailureReason: req.FailureReason,
+	}
+
+	payment, err := s.paymentRepo.ApplyEvent(ctx, event, move.from, move.to)
+
+	// The event is recorded before the order follows it, so a redelivery may
+	// be the retry of an attempt that failed in between; the order is brought
+	// in line again, which does nothing when it already is
+	duplicate := errors.Is(err, repository.ErrDuplicatePaymentEvent)
+	if duplicate {
+		payment, err = s.paymentRepo.GetByProviderRef(ctx, event.Provider, event.ProviderRef)
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "payment not found")
+		}
+		return nil, err
+	}
+
+	// An order whose payment failed can't be accepted, so it is cancelled
+	if payment.Status == payments.StatusFailed {
+		order, err := s.getOrder(ctx, payment.OrderID)
+		if err != nil {
+			return nil, err
+		}
+
+		if order.Status == StatusPending {
+			if _, err := s.changeStatus(ctx, order, StatusCancelled, systemActorID, RoleSystem); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &pb.HandlePaymentWebhookResponse{
+		OrderId:       payment.OrderID,
+		PaymentStatus: payment.Status,
+		Duplicate:     duplicate,
+	}, nil
+}
+
+// authorizePayment holds the order's total with the provider. A declined
+// payment comes back as a rejection.
+func (s *OrderService) authorizePayment(ctx context.Context, order *repository.Order, paymentMethod string) (*repository.Payment, *pb.OrderRejection, error) {
+	result, err := s.payments.Authorize(ctx, payments.AuthorizeRequest{
+		OrderID:       order.ID,
+		Amount:        order.Price.Total,
+		Currency:      order.Price.Currency,
+		PaymentMethod: paymentMethod,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to authorize payment: %w", err)
+	}
+
+	if result.Status == payments.StatusFailed {
+		return nil, &pb.OrderRejection{
+			Reason:           RejectionPaymentDeclined,
+			UnavailableItems: []string{},
+			Errors:           []string{result.FailureReason},
+		}, nil
+	}
+
+	return &repository.Payment{
+		ID:          uuid.New().String(),
+		OrderID:     order.ID,
+		Provider:    s.payments.Name(),
+		ProviderRef: result.Ref,
+		Status:      result.Status,
+		Amount:      order.Price.Total,
+		Currency:    order.Price.Currency,
+	}, nil, nil
+}
+
+// capturePayment takes the authorized amount before an order is accepted.
+// Orders whose payment is not authorized can't be accepted.
+func (s *OrderService) capturePayment(ctx context.Context, orderID string) error {
+	payment, err := s.paymentRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.FailedPrecondition, "order has not been paid")
+		}
+		return err
+	}
+
+	switch payment.Status {
+	case payments.StatusCaptured:
+		return nil
+	case payments.StatusAuthorized:
+	default:
+		return status.Errorf(codes.FailedPrecondition, "payment is %s, the order can't be accepted", payment.Status)
+	}
+
+	if _, err := s.payments.Capture(ctx, payment.ProviderRef, payment.Amount); err != nil {
+		return fmt.Errorf("failed to capture payment: %w", err)
+	}
+
+	if _, err := s.paymentRepo.UpdateStatus(ctx, payment.ID, payments.StatusAuthorized, payments.StatusCaptured); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// releasePayment gives the money back for a cancelled or rejected order:
+// authorizations are voided, captured payments refunded.
+func (s *OrderService) releasePayment(ctx context.Context, orderID string) error {
+	payment, err := s.paymentRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	var to string
+	switch payment.Status {
+	case payments.StatusPending, payments.StatusAuthorized:
+		_, err = s.payments.Void(ctx, payment.ProviderRef)
+		to = payments.StatusVoided
+	case payments.StatusCaptured:
+		_, err = s.payments.Refund(ctx, payment.ProviderRef, payment.Amount)
+		to = payments.StatusRefunded
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to release payment: %w", err)
+	}
+
+	if _, err := s.paymentRepo.UpdateStatus(ctx, payment.ID, payment.Status, to); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// voidAuthorization releases a payment whose order could not be stored
+func (s *OrderService) voidAuthorization(ctx context.Context, payment *repository.Payment) {
+	if _, err := s.payments.Void(ctx, payment.ProviderRef); err != nil {
+		log.Printf("Failed to void payment %s of unsaved order %s: %v", payment.ProviderRef, payment.OrderID, err)
+	}
+}
@@ -0,0 +1,554 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/kimashii-dan/food-delivery-app/backend/pkg"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Kinds of promo codes
+const (
+	PromoPercentOff   = "percent_off"
+	PromoFixedAmount  = "fixed_amount"
+	PromoFreeDelivery = "free_delivery"
+)
+
+// Reasons reported in PromoRejection
+const (
+	PromoNotFound              = "not_found"
+	PromoInactive              = "inactive"
+	PromoNotStarted            = "not_started"
+	PromoExpired               = "expired"
+	PromoRestaurantNotEligible = "restaurant_not_eligible"
+	PromoMinSubtotalNotMet     = "min_subtotal_not_met"
+	PromoFirstOrderOnly        = "first_order_only"
+	PromoUsageLimitReached     = "usage_limit_reached"
+	PromoUserUsageLimitReached = "user_usage_limit_reached"
+	PromoNotStackable          = "not_stackable"
+	PromoDuplicateCode         = "duplicate_code"
+	PromoTooManyCodes          = "too_many_codes"
+)
+
+// maxPromoCodes is how many codes a quote or order tries
+const maxPromoCodes = 3
+
+var promoCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{3,40}$`)
+
+// normalizePromoCode makes codes case-insensitive for customers
+func normalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// applyPromos works out which of the requested codes apply to an order of
+// userID at restaurantID and what they take off, explaining why the others
+// don't. It loads what choosePromos needs to decide.
+func (s *OrderService) applyPromos(ctx context.Context, userID, restaurantID string, requested []string, subtotal int64, distanceKm float64) ([]repository.AppliedPromo, []*pb.PromoRejection, error) {
+	codes, rejections := splitPromoCodes(requested)
+	if len(codes) == 0 {
+		return []repository.AppliedPromo{}, rejections, nil
+	}
+
+	promos, err := s.promoRepo.GetByCodes(ctx, codes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	promoIDs := make([]string, 0, len(promos))
+	firstOrderOnly := false
+	for _, promo := range promos {
+		promoIDs = append(promoIDs, promo.ID)
+		firstOrderOnly = firstOrderOnly || promo.FirstOrderOnly
+	}
+
+	userUses, err := s.promoRepo.CountUserRedemptions(ctx, promoIDs, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Only looked up when a first-order code is used
+	hasOrders := false
+	if firstOrderOnly {
+		if hasOrders, err = s.orderRepo.HasOrders(ctx, userID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	applied, more := choosePromos(promoRequest{
+		codes:        codes,
+		promos:       promos,
+		userUses:     userUses,
+		hasOrders:    hasOrders,
+		restaurantID: restaurantID,
+		subtotal:     subtotal,
+		deliveryFee:  s.pricing.deliveryFee(distanceKm),
+		now:          time.Now(),
+	})
+
+	return applied, append(rejections, more...), nil
+}
+
+// splitPromoCodes normalizes the requested codes, dropping empty ones and
+// rejecting repeats and codes over maxPromoCodes.
+func splitPromoCodes(requested []string) ([]string, []*pb.PromoRejection) {
+	var codes []string
+	rejections := []*pb.PromoRejection{}
+
+	seen := make(map[string]bool, len(requested))
+	for _, code := range requested {
+		code = normalizePromoCode(code)
+		switch {
+		case code == "":
+			continue
+		case seen[code]:
+			rejections = append(rejections, promoRejection(code, PromoDuplicateCode, "code was given more than once"))
+		case len(codes) == maxPromoCodes:
+			rejections = append(rejections, promoRejection(code, PromoTooManyCodes, fmt.Sprintf("at most %d codes can be used on an order", maxPromoCodes)))
+		default:
+			codes = append(codes, code)
+		}
+		seen[code] = true
+	}
+
+	return codes, rejections
+}
+
+// promoRequest is everything choosePromos decides on
+type promoRequest struct {
+	codes        []string                         // normalized, in the order given
+	promos       map[string]*repository.PromoCode // by code; unknown codes are missing
+	userUses     map[string]int32                 // the user's redemptions by promo id
+	hasOrders    bool                             // whether the user ordered before
+	restaurantID string
+	subtotal     int64
+	deliveryFee  int64
+	now          time.Time
+}
+
+// choosePromos decides which codes apply and what they take off. Codes are
+// tried in the order given; a code that is not stackable only applies alone
+// and there is at most one free delivery.
+func choosePromos(req promoRequest) ([]repository.AppliedPromo, []*pb.PromoRejection) {
+	applied := []repository.AppliedPromo{}
+	rejections := []*pb.PromoRejection{}
+
+	reject := func(code, reason, message string) {
+		rejections = append(rejections, promoRejection(code, reason, message))
+	}
+
+	var eligible []*repository.PromoCode
+	for _, code := range req.codes {
+		promo, ok := req.promos[code]
+		if !ok {
+			reject(code, PromoNotFound, "code does not exist")
+			continue
+		}
+
+		switch {
+		case !promo.Active:
+			reject(code, PromoInactive, "code is no longer active")
+		case promo.StartsAt != nil && req.now.Before(*promo.StartsAt):
+			reject(code, PromoNotStarted, "code is valid from "+promo.StartsAt.UTC().Format(time.RFC3339))
+		case promo.EndsAt != nil && !req.now.Before(*promo.EndsAt):
+			reject(code, PromoExpired, "code has expired")
+		case len(promo.RestaurantIDs) > 0 && !containsFold(promo.RestaurantIDs, req.restaurantID):
+			reject(code, PromoRestaurantNotEligible, "code can't be used at this restaurant")
+		case req.subtotal < promo.MinSubtotal:
+			reject(code, PromoMinSubtotalNotMet, fmt.Sprintf("subtotal must be at least %d", promo.MinSubtotal))
+		case promo.MaxUses > 0 && promo.TimesUsed >= promo.MaxUses:
+			reject(code, PromoUsageLimitReached, "code has been used up")
+		case promo.MaxUsesPerUser > 0 && req.userUses[promo.ID] >= promo.MaxUsesPerUser:
+			reject(code, PromoUserUsageLimitReached, "you have already used this code")
+		case promo.FirstOrderOnly && req.hasOrders:
+			reject(code, PromoFirstOrderOnly, "code is only valid on your first order")
+		default:
+			eligible = append(eligible, promo)
+		}
+	}
+
+	// Stacking is decided over the eligible codes, in the order given
+	var stacked []*repository.PromoCode
+	freeDelivery := false
+	for _, promo := range eligible {
+		switch {
+		case len(stacked) > 0 && (!promo.Stackable || !stacked[0].Stackable):
+			reject(promo.Code, PromoNotStackable, "code can't be combined with "+stacked[0].Code)
+			continue
+		case promo.Kind == PromoFreeDelivery && freeDelivery:
+			reject(promo.Code, PromoNotStackable, "only one free delivery code can be used")
+			continue
+		}
+
+		stacked = append(stacked, promo)
+		freeDelivery = freeDelivery || promo.Kind == PromoFreeDelivery
+	}
+
+	// Item discounts never take more than the subtotal
+	remaining := req.subtotal
+	for _, promo := range stacked {
+		var discount int64
+		switch promo.Kind {
+		case PromoPercentOff:
+			discount = applyRate(req.subtotal, int64(promo.PercentOff)*100)
+			if promo.MaxDiscount > 0 {
+				discount = min(discount, promo.MaxDiscount)
+			}
+			discount = min(discount, remaining)
+			remaining -= discount
+		case PromoFixedAmount:
+			discount = min(promo.AmountOff, remaining)
+			remaining -= discount
+		case PromoFreeDelivery:
+			discount = req.deliveryFee
+		}
+
+		applied = append(applied, repository.AppliedPromo{
+			PromoID:  promo.ID,
+			Code:     promo.Code,
+			Kind:     promo.Kind,
+			Discount: discount,
+		})
+	}
+
+	return applied, rejections
+}
+
+func promoRejection(code, reason, message string) *pb.PromoRejection {
+	return &pb.PromoRejection{
+		Code:    code,
+		Reason:  reason,
+		Message: message,
+	}
+}
+
+func discountOf(promos []repository.AppliedPromo) int64 {
+	var discount int64
+	for _, promo := range promos {
+		discount += promo.Discount
+	}
+	return discount
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *OrderService) CreatePromoCode(ctx context.Context, req *pb.CreatePromoCodeRequest) (*pb.CreatePromoCodeResponse, error) {
+	if err := requireAdmin(req.ActorRole); err != nil {
+		return nil, err
+	}
+
+	promo, err := toPromoCode(req.PromoCode)
+	if err != nil {
+		return nil, err
+	}
+	promo.ID = uuid.New().String()
+
+	if err := s.promoRepo.Create(ctx, promo); err != nil {
+		if isUniqueViolation(err) {
+			return nil, status.Error(codes.AlreadyExists, "another promo code already uses this code")
+		}
+		return nil, fmt.Errorf("failed to create promo code: %w", err)
+	}
+
+	created, err := s.getPromo(ctx, promo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreatePromoCodeResponse{
+		PromoCode: toPbPromoCode(created),
+	}, nil
+}
+
+func (s *OrderService) GetPromoCode(ctx context.Context, req *pb.GetPromoCodeRequest) (*pb.GetPromoCodeResponse, error) {
+	if err := requireAdmin(req.ActorRole); err != nil {
+		return nil, err
+	}
+
+	promo, err := s.getPromo(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetPromoCodeResponse{
+		PromoCode: toPbPromoCode(promo),
+	}, nil
+}
+
+func (s *OrderService) ListPromoCodes(ctx context.Context, req *pb.ListPromoCodesRequest) (*pb.ListPromoCodesResponse, error) {
+	if err := requireAdmin(req.ActorRole); err != nil {
+		return nil, err
+	}
+
+	pageSize, err := pkg.PageSize(req.PageSize, defaultPageSize)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pageFilter := pkg.PageFilter("promo_codes")
+	after, err := pkg.DecodePageToken[repository.PromoCursor](req.PageToken, pageFilter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	promos, next, err := s.promoRepo.List(ctx, after, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list promo codes: %w", err)
+	}
+
+	nextPageToken, err := pkg.EncodePageToken(pageFilter, next)
+	if err != nil {
+		return nil, err
+	}
+
+	pbPromos := make([]*pb.PromoCode, len(promos))
+	for i, promo := range promos {
+		pbPromos[i] = toPbPromoCode(promo)
+	}
+
+	return &pb.ListPromoCodesResponse{
+		PromoCodes:    pbPromos,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *OrderService) UpdatePromoCode(ctx context.Context, req *pb.UpdatePromoCodeRequest) (*pb.UpdatePromoCodeResponse, error) {
+	if err := requireAdmin(req.ActorRole); err != nil {
+		return nil, err
+	}
+
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.NotFound, "promo code not found")
+	}
+
+	promo, err := toPromoCode(req.PromoCode)
+	if err != nil {
+		return nil, err
+	}
+	promo.ID = req.Id
+
+	updated, err := s.promoRepo.Update(ctx, promo)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, status.Error(codes.AlreadyExists, "another promo code already uses this code")
+		}
+		return nil, fmt.Errorf("failed to update promo code: %w", err)
+	}
+
+	if !updated {
+		return nil, status.Error(codes.NotFound, "promo code not found")
+	}
+
+	result, err := s.getPromo(ctx, promo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdatePromoCodeResponse{
+		PromoCode: toPbPromoCode(result),
+	}, nil
+}
+
+func (s *OrderService) DeletePromoCode(ctx context.Context, req *pb.DeletePromoCodeRequest) (*pb.DeletePromoCodeResponse, error) {
+	if err := requireAdmin(req.ActorRole); err != nil {
+		return nil, err
+	}
+
+	promo, err := s.getPromo(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.promoRepo.Delete(ctx, promo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !deleted {
+		return nil, status.Error(codes.FailedPrecondition, "promo code was redeemed, deactivate it instead")
+	}
+
+	return &pb.DeletePromoCodeResponse{}, nil
+}
+
+func (s *OrderService) getPromo(ctx context.Context, id string) (*repository.PromoCode, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, status.Error(codes.NotFound, "promo code not found")
+	}
+
+	promo, err := s.promoRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "promo code not found")
+		}
+		return nil, fmt.Errorf("failed to get promo code: %w", err)
+	}
+
+	return promo, nil
+}
+
+func requireAdmin(role string) error {
+	if role != RoleAdmin {
+		return status.Error(codes.PermissionDenied, "only admins can manage promo codes")
+	}
+	return nil
+}
+
+// toPromoCode validates a promo code sent by an admin
+func toPromoCode(in *pb.PromoCode) (*repository.PromoCode, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "promo code is required")
+	}
+
+	promo := &repository.PromoCode{
+		Code:           normalizePromoCode(in.Code),
+		Description:    strings.TrimSpace(in.Description),
+		Kind:           in.Kind,
+		PercentOff:     in.PercentOff,
+		AmountOff:      in.AmountOff,
+		MaxDiscount:    in.MaxDiscount,
+		MinSubtotal:    in.MinSubtotal,
+		FirstOrderOnly: in.FirstOrderOnly,
+		RestaurantIDs:  []string{},
+		MaxUses:        in.MaxUses,
+		MaxUsesPerUser: in.MaxUsesPerUser,
+		Stackable:      in.Stackable,
+		Active:         in.Active,
+	}
+
+	if !promoCodePattern.MatchString(promo.Code) {
+		return nil, status.Error(codes.InvalidArgument, "code must be 3 to 40 letters, digits, '-' or '_'")
+	}
+
+	switch promo.Kind {
+	case PromoPercentOff:
+		if promo.PercentOff < 1 || promo.PercentOff > 100 {
+			return nil, status.Error(codes.InvalidArgument, "percent_off must be between 1 and 100")
+		}
+		if promo.AmountOff != 0 {
+			return nil, status.Error(codes.InvalidArgument, "amount_off is only used by fixed_amount codes")
+		}
+	case PromoFixedAmount:
+		if promo.AmountOff <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "amount_off must be positive")
+		}
+		if promo.PercentOff != 0 || promo.MaxDiscount != 0 {
+			return nil, status.Error(codes.InvalidArgument, "percent_off and max_discount are only used by percent_off codes")
+		}
+	case PromoFreeDelivery:
+		if promo.PercentOff != 0 || promo.AmountOff != 0 || promo.MaxDiscount != 0 {
+			return nil, status.Error(codes.InvalidArgument, "free_delivery codes take no amounts")
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown promo kind %q", promo.Kind)
+	}
+
+	if promo.MaxDiscount < 0 || promo.MinSubtotal < 0 {
+		return nil, status.Error(codes.InvalidArgument, "amounts must not be negative")
+	}
+
+	if promo.MaxUses < 0 || promo.MaxUsesPerUser < 0 {
+		return nil, status.Error(codes.InvalidArgument, "usage limits must not be negative")
+	}
+
+	for _, id := range in.RestaurantIds {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid restaurant id %q", id)
+		}
+		promo.RestaurantIDs = append(promo.RestaurantIDs, parsed.String())
+	}
+
+	var err error
+	if promo.StartsAt, err = parseOptionalTime(in.StartsAt, "starts_at"); err != nil {
+		return nil, err
+	}
+	if promo.EndsAt, err = parseOptionalTime(in.EndsAt, "ends_at"); err != nil {
+		return nil, err
+	}
+
+	if promo.StartsAt != nil && promo.EndsAt != nil && !promo.EndsAt.After(*promo.StartsAt) {
+		return nil, status.Error(codes.InvalidArgument, "ends_at must be after starts_at")
+	}
+
+	return promo, nil
+}
+
+func parseOptionalTime(value, field string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s must be an RFC3339 time", field)
+	}
+
+	return &t, nil
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+func toPbPromoCode(promo *repository.PromoCode) *pb.PromoCode {
+	return &pb.PromoCode{
+		Id:             promo.ID,
+		Code:           promo.Code,
+		Description:    promo.Description,
+		Kind:           promo.Kind,
+		PercentOff:     promo.PercentOff,
+		AmountOff:      promo.AmountOff,
+		MaxDiscount:    promo.MaxDiscount,
+		MinSubtotal:    promo.MinSubtotal,
+		FirstOrderOnly: promo.FirstOrderOnly,
+		RestaurantIds:  promo.RestaurantIDs,
+		StartsAt:       formatOptionalTime(promo.StartsAt),
+		EndsAt:         formatOptionalTime(promo.EndsAt),
+		MaxUses:        promo.MaxUses,
+		MaxUsesPerUser: promo.MaxUsesPerUser,
+		Stackable:      promo.Stackable,
+		Active:         promo.Active,
+		TimesUsed:      promo.TimesUsed,
+		CreatedAt:      promo.CreatedAt,
+		UpdatedAt:      promo.UpdatedAt,
+	}
+}
+
+func toPbAppliedPromos(promos []repository.AppliedPromo) []*pb.AppliedPromo {
+	pbPromos := make([]*pb.AppliedPromo, len(promos))
+	for i, promo := range promos {
+		pbPromos[i] = &pb.AppliedPromo{
+			PromoId:  promo.PromoID,
+			Code:     promo.Code,
+			Kind:     promo.Kind,
+			Discount: promo.Discount,
+		}
+	}
+	return pbPromos
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/repository"
+)
+
+func TestSplitPromoCodes(t *testing.T) {
+	codes, rejections := splitPromoCodes([]string{" save10 ", "", "SAVE10", "a1b", "c2d", "e3f"})
+
+	if want := []string{"SAVE10", "A1B", "C2D"}; !slices.Equal(codes, want) {
+		t.Errorf("codes = %v, want %v", codes, want)
+	}
+
+	want := []string{"SAVE10:" + PromoDuplicateCode, "E3F:" + PromoTooManyCodes}
+	if got := rejectionReasons(rejections); !slices.Equal(got, want) {
+		t.Errorf("rejections = %v, want %v", got, want)
+	}
+}
+
+func TestChoosePromos(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	yesterday := now.Add(-24 * time.Hour)
+	tomorrow := now.Add(24 * time.Hour)
+
+	promos := map[string]*repository.PromoCode{
+		"PCT20":   {ID: "p1", Code: "PCT20", Kind: PromoPercentOff, PercentOff: 20, Stackable: true, Active: true},
+		"PCT50":   {ID: "p2", Code: "PCT50", Kind: PromoPercentOff, PercentOff: 50, MaxDiscount: 3000, Stackable: true, Active: true},
+		"MINUS":   {ID: "p3", Code: "MINUS", Kind: PromoFixedAmount, AmountOff: 8000, Stackable: true, Active: true},
+		"SHIP":    {ID: "p4", Code: "SHIP", Kind: PromoFreeDelivery, Stackable: true, Active: true},
+		"SHIP2":   {ID: "p5", Code: "SHIP2", Kind: PromoFreeDelivery, Stackable: true, Active: true},
+		"ALONE":   {ID: "p6", Code: "ALONE", Kind: PromoFixedAmount, AmountOff: 1000, Active: true},
+		"OFF":     {ID: "p7", Code: "OFF", Kind: PromoFixedAmount, AmountOff: 1000},
+		"LATER":   {ID: "p8", Code: "LATER", Kind: PromoFixedAmount, AmountOff: 1000, StartsAt: &tomorrow, Active: true},
+		"OVER":    {ID: "p9", Code: "OVER", Kind: PromoFixedAmount, AmountOff: 1000, EndsAt: &yesterday, Active: true},
+		"ENDSNOW": {ID: "p10", Code: "ENDSNOW", Kind: PromoFixedAmount, AmountOff: 1000, EndsAt: &now, Active: true},
+		"ELSE":    {ID: "p11", Code: "ELSE", Kind: PromoFixedAmount, AmountOff: 1000, RestaurantIDs: []string{"other"}, Active: true},
+		"HERE":    {ID: "p12", Code: "HERE", Kind: PromoFixedAmount, AmountOff: 1000, RestaurantIDs: []string{"REST-1"}, Active: true},
+		"BIG":     {ID: "p13", Code: "BIG", Kind: PromoFixedAmount, AmountOff: 1000, MinSubtotal: 20000, Active: true},
+		"GONE":    {ID: "p14", Code: "GONE", Kind: PromoFixedAmount, AmountOff: 1000, MaxUses: 5, TimesUsed: 5, Active: true},
+		"ONCE":    {ID: "p15", Code: "ONCE", Kind: PromoFixedAmount, AmountOff: 1000, MaxUsesPerUser: 1, Active: true},
+		"FIRST":   {ID: "p16", Code: "FIRST", Kind: PromoFixedAmount, AmountOff: 1000, FirstOrderOnly: true, Active: true},
+	}
+
+	type discount struct {
+		code   string
+		amount int64
+	}
+
+	tests := []struct {
+		name           string
+		codes          []string
+		hasOrders      bool
+		wantApplied    []discount
+		wantRejections []string
+	}{
+		{
+			name:        "percent off the subtotal",
+			codes:       []string{"PCT20"},
+			wantApplied: []discount{{"PCT20", 2000}},
+		},
+		{
+			name:        "percent off is capped",
+			codes:       []string{"PCT50"},
+			wantApplied: []discount{{"PCT50", 3000}},
+		},
+		{
+			name:        "free delivery takes the delivery fee",
+			codes:       []string{"SHIP"},
+			wantApplied: []discount{{"SHIP", 450}},
+		},
+		{
+			name:        "item discounts can take the whole subtotal",
+			codes:       []string{"MINUS", "PCT20"},
+			wantApplied: []discount{{"MINUS", 8000}, {"PCT20", 2000}},
+		},
+		{
+			name:        "later discounts get what is left",
+			codes:       []string{"MINUS", "PCT50"},
+			wantApplied: []discount{{"MINUS", 8000}, {"PCT50", 2000}},
+		},
+		{
+			name:           "one free delivery only",
+			codes:          []string{"SHIP", "SHIP2"},
+			wantApplied:    []discount{{"SHIP", 450}},
+			wantRejections: []string{"SHIP2:" + PromoNotStackable},
+		},
+		{
+			name:           "a code that doesn't stack after another",
+			codes:          []string{"PCT20", "ALONE"},
+			wantApplied:    []discount{{"PCT20", 2000}},
+			wantRejections: []string{"ALONE:" + PromoNotStackable},
+		},
+		{
+			name:           "nothing stacks on a code that doesn't stack",
+			codes:          []string{"ALONE", "PCT20"},
+			wantApplied:    []discount{{"ALONE", 1000}},
+			wantRejections: []string{"PCT20:" + PromoNotStackable},
+		},
+		{
+			name:           "rejected codes don't block stacking",
+			codes:          []string{"OFF", "ALONE"},
+			wantApplied:    []discount{{"ALONE", 1000}},
+			wantRejections: []string{"OFF:" + PromoInactive},
+		},
+		{
+			name:  "eligibility",
+			codes: []string{"NOPE", "OFF", "LATER", "OVER", "ENDSNOW", "ELSE", "BIG", "GONE", "ONCE"},
+			wantRejections: []string{
+				"NOPE:" + PromoNotFound,
+				"OFF:" + PromoInactive,
+				"LATER:" + PromoNotStarted,
+				"OVER:" + PromoExpired,
+				"ENDSNOW:" + PromoExpired,
+				"ELSE:" + PromoRestaurantNotEligible,
+				"BIG:" + PromoMinSubtotalNotMet,
+				"GONE:" + PromoUsageLimitReached,
+				"ONCE:" + PromoUserUsageLimitReached,
+			},
+		},
+		{
+			name:        "restaurant ids match regardless of case",
+			codes:       []string{"HERE"},
+			wantApplied: []discount{{"HERE", 1000}},
+		},
+		{
+			name:        "first order",
+			codes:       []string{"FIRST"},
+			wantApplied: []discount{{"FIRST", 1000}},
+		},
+		{
+			name:           "not the first order",
+			codes:          []string{"FIRST"},
+			hasOrders:      true,
+			wantRejections: []string{"FIRST:" + PromoFirstOrderOnly},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applied, rejections := choosePromos(promoRequest{
+				codes:        tt.codes,
+				promos:       promos,
+				userUses:     map[string]int32{"p15": 1},
+				hasOrders:    tt.hasOrders,
+				restaurantID: "rest-1",
+				subtotal:     10000,
+				deliveryFee:  450,
+				now:          now,
+			})
+
+			var got []discount
+			for _, promo := range applied {
+				got = append(got, discount{promo.Code, promo.Discount})
+			}
+			if !slices.Equal(got, tt.wantApplied) {
+				t.Errorf("applied = %v, want %v", got, tt.wantApplied)
+			}
+
+			if got := rejectionReasons(rejections); !slices.Equal(got, tt.wantRejections) {
+				t.Errorf("rejections = %v, want %v", got, tt.wantRejections)
+			}
+		})
+	}
+}
+
+func rejectionReasons(rejections []*pb.PromoRejection) []string {
+	var reasons []string
+	for _, rejection := range rejections {
+		reasons = append(reasons, rejection.Code+":"+rejection.Reason)
+	}
+	return reasons
+}
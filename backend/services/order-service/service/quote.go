@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/repository"
+	restaurantpb "github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *OrderService) Quote(ctx context.Context, req *pb.QuoteRequest) (*pb.QuoteResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	if req.DeliveryAddressId == "" {
+		return nil, status.Error(codes.InvalidArgument, "delivery address id is required")
+	}
+
+	restaurantID := req.RestaurantId
+	var lines []*restaurantpb.ItemSelection
+
+	if len(req.Items) == 0 {
+		// Price the user's cart
+		cart, err := s.cartRepo.Get(ctx, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(cart.Items) == 0 {
+			return nil, status.Error(codes.FailedPrecondition, "cart is empty")
+		}
+
+		if restaurantID == "" {
+			restaurantID = cart.RestaurantID
+		} else if !strings.EqualFold(restaurantID, cart.RestaurantID) {
+			return nil, status.Error(codes.InvalidArgument, "cart holds items from another restaurant")
+		}
+
+		for _, item := range cart.Items {
+			lines = append(lines, &restaurantpb.ItemSelection{
+				MenuItemId: item.MenuItemID,
+				OptionIds:  item.OptionIDs,
+				Quantity:   item.Quantity,
+			})
+		}
+	} else {
+		if restaurantID == "" {
+			return nil, status.Error(codes.InvalidArgument, "restaurant id is required")
+		}
+
+		var err error
+		lines, err = mergeLines(req.Items)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	checkout, rejection, err := s.prepareCheckout(ctx, req.UserId, restaurantID, req.DeliveryAddressId, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	if rejection != nil {
+		return &pb.QuoteResponse{
+			Rejection: rejection,
+		}, nil
+	}
+
+	quoteLines := toQuoteLines(lines, checkout.lines)
+	subtotal := subtotalOf(quoteLines)
+
+	promos, promoRejections, err := s.applyPromos(ctx, req.UserId, restaurantID, req.PromoCodes, subtotal, checkout.distanceKm)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &repository.Quote{
+		ID:                uuid.New().String(),
+		UserID:            req.UserId,
+		RestaurantID:      restaurantID,
+		DeliveryAddressID: req.DeliveryAddressId,
+		Lines:             quoteLines,
+		Price:             s.pricing.price(subtotal, checkout.distanceKm, discountOf(promos)),
+		Promos:            promos,
+		DistanceKm:        checkout.distanceKm,
+		ExpiresAt:         time.Now().Add(s.pricing.QuoteTTL),
+	}
+
+	if err := s.quoteRepo.Create(ctx, quote); err != nil {
+		return nil, err
+	}
+
+	pbQuote := toPbQuote(quote)
+	pbQuote.PromoRejections = promoRejections
+
+	return &pb.QuoteResponse{
+		Quote: pbQuote,
+	}, nil
+}
+
+// getUsableQuote loads a quote of userID that an order can still be placed with
+func (s *OrderService) getUsableQuote(ctx context.Context, quoteID, userID string) (*repository.Quote, error) {
+	if _, err := uuid.Parse(quoteID); err != nil {
+		return nil, status.Error(codes.NotFound, "quote not found")
+	}
+
+	quote, err := s.quoteRepo.GetByID(ctx, quoteID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "quote not found")
+		}
+		return nil, fmt.Errorf("failed to get quote: %w", err)
+	}
+
+	if quote.UserID != userID {
+		return nil, status.Error(codes.NotFound, "quote not found")
+	}
+
+	if quote.OrderID != "" {
+		return nil, status.Error(codes.FailedPrecondition, "quote was already used")
+	}
+
+	if time.Now().After(quote.ExpiresAt) {
+		return nil, status.Error(codes.FailedPrecondition, "quote has expired")
+	}
+
+	return quote, nil
+}
+
+// quoteSelections returns the lines of a quote as they were validated
+func quoteSelections(quote *repository.Quote) []*restaurantpb.ItemSelection {
+	lines := make([]*restaurantpb.ItemSelection, len(quote.Lines))
+	for i, line := range quote.Lines {
+		lines[i] = &restaurantpb.ItemSelection{
+			MenuItemId: line.MenuItemID,
+			OptionIds:  line.OptionIDs,
+			Quantity:   line.Quantity,
+		}
+	}
+	return lines
+}
+
+// sameSelections reports whether two lists of merged lines order the same
+// items with the same options and quantities
+func sameSelections(a, b []*restaurantpb.ItemSelection) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	quantities := make(map[string]int32, len(a))
+	for _, line := range a {
+		quantities[selectionKey(line.MenuItemId, line.OptionIds)] = line.Quantity
+	}
+
+	for _, line := range b {
+		if quantities[selectionKey(line.MenuItemId, line.OptionIds)] != line.Quantity {
+			return false
+		}
+	}
+
+	return true
+}
+
+func toPbQuote(quote *repository.Quote) *pb.Quote {
+	lines := make([]*pb.QuoteLine, len(quote.Lines))
+	for i, line := range quote.Lines {
+		lines[i] = &pb.QuoteLine{
+			MenuItemId: line.MenuItemID,
+			Name:       line.Name,
+			OptionIds:  line.OptionIDs,
+			Options:    toPbOrderItemOptions(line.Options),
+			Quantity:   line.Quantity,
+			UnitPrice:  line.UnitPrice,
+			LinePrice:  line.UnitPrice * int64(line.Quantity),
+		}
+	}
+
+	return &pb.Quote{
+		Id:                quote.ID,
+		UserId:            quote.UserID,
+		RestaurantId:      quote.RestaurantID,
+		DeliveryAddressId: quote.DeliveryAddressID,
+		Lines:             lines,
+		Price:             toPbPriceBreakdown(quote.Price),
+		Promos:            toPbAppliedPromos(quote.Promos),
+		DistanceKm:        quote.DistanceKm,
+		ExpiresAt:         quote.ExpiresAt.UTC().Format(time.RFC3339),
+	}
+}
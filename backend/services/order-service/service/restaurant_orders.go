@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/pkg"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/order-service/repository"
+	restaurantpb "github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *OrderService) ListRestaurantOrders(ctx context.Context, req *pb.ListRestaurantOrdersRequest) (*pb.ListRestaurantOrdersResponse, error) {
+	if req.RestaurantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "restaurant id is required")
+	}
+
+	if err := s.checkRestaurantAccess(ctx, req.RestaurantId, req.ActorUserId, req.ActorRole); err != nil {
+		return nil, err
+	}
+
+	pageSize, err := pkg.PageSize(req.PageSize, defaultPageSize)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pageFilter := pkg.PageFilter(req.RestaurantId)
+	after, err := pkg.DecodePageToken[repository.OrderCursor](req.PageToken, pageFilter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	orders, next, err := s.orderRepo.GetByRestaurantID(ctx, req.RestaurantId, after, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get orders: %w", err)
+	}
+
+	nextPageToken, err := pkg.EncodePageToken(pageFilter, next)
+	if err != nil {
+		return nil, err
+	}
+
+	pbOrders := make([]*pb.Order, len(orders))
+	for i, order := range orders {
+		pbOrders[i] = toPbOrder(order)
+	}
+
+	return &pb.ListRestaurantOrdersResponse{
+		Orders:        pbOrders,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *OrderService) GetRestaurantOrder(ctx context.Context, req *pb.GetRestaurantOrderRequest) (*pb.GetRestaurantOrderResponse, error) {
+	if req.RestaurantId == "" || req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "restaurant id and order id are required")
+	}
+
+	if err := s.checkRestaurantAccess(ctx, req.RestaurantId, req.ActorUserId, req.ActorRole); err != nil {
+		return nil, err
+	}
+
+	order, err := s.getOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.RestaurantID != req.RestaurantId {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+
+	history, err := s.orderRepo.GetStatusHistory(ctx, order.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order status history: %w", err)
+	}
+
+	return &pb.GetRestaurantOrderResponse{
+		Order:   toPbOrder(order),
+		History: toPbStatusHistory(history),
+	}, nil
+}
+
+// checkRestaurantAccess lets admins and the restaurant's owner see its
+// orders
+func (s *OrderService) checkRestaurantAccess(ctx context.Context, restaurantID, actorUserID, actorRole string) error {
+	switch actorRole {
+	case RoleAdmin:
+		return nil
+	case RoleRestaurant:
+		return s.checkRestaurantOwner(ctx, restaurantID, actorUserID)
+	default:
+		return status.Error(codes.PermissionDenied, "only restaurants and admins can see restaurant orders")
+	}
+}
+
+// checkRestaurantOwner makes sure actorUserID owns the restaurant. Other
+// restaurants and their orders look like they don't exist.
+func (s *OrderService) checkRestaurantOwner(ctx context.Context, restaurantID, actorUserID string) error {
+	resp, err := s.restaurantClient.GetRestaurant(ctx, &restaurantpb.GetRestaurantRequest{
+		Id: restaurantID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return status.Error(codes.NotFound, "restaurant not found")
+		}
+		return fmt.Errorf("failed to get restaurant: %w", err)
+	}
+
+	if actorUserID == "" || resp.Restaurant.OwnerUserId != actorUserID {
+		return status.Error(codes.NotFound, "restaurant not found")
+	}
+
+	return nil
+}
@@ -4,8 +4,10 @@ import (
 	"log"
 	"net"
 	"os"
+	_ "time/tzdata" // opening hours need IANA zones even on images without them
 
 	"github.com/joho/godotenv"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/clients"
 	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
 	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/repository"
 	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/service"
@@ -25,10 +27,18 @@ func main() {
 	}
 	defer db.Close()
 
+	// init grpc connection with user service
+	userServicePort := os.Getenv("USER_SERVICE_PORT")
+	userClient, userConn := clients.NewUserServiceClient(userServicePort)
+	defer userConn.Close()
+
 	restaurantRepo := repository.NewRestaurantRepository(db)
 	menuItemRepo := repository.NewMenuItemRepository(db)
+	optionRepo := repository.NewOptionRepository(db)
+	hoursRepo := repository.NewHoursRepository(db)
+	categoryRepo := repository.NewCategoryRepository(db)
 
-	restaurantService := service.NewRestaurantService(restaurantRepo, menuItemRepo)
+	restaurantService := service.NewRestaurantService(restaurantRepo, menuItemRepo, optionRepo, hoursRepo, categoryRepo, userClient)
 
 	port := os.Getenv("PORT")
 	lis, err := net.Listen("tcp", port)
@@ -0,0 +1,3 @@
+ALTER TABLE restaurants
+DROP COLUMN delivery_polygon,
+DROP COLUMN delivery_radius_km;
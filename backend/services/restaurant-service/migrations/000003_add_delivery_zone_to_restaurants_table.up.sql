@@ -0,0 +1,5 @@
+-- A restaurant delivers within delivery_radius_km of itself, or inside
+-- delivery_polygon ([{"lat": .., "lng": ..}, ...]) when one is set
+ALTER TABLE restaurants
+ADD COLUMN delivery_radius_km DECIMAL(6,2) NOT NULL DEFAULT 5,
+ADD COLUMN delivery_polygon JSONB;
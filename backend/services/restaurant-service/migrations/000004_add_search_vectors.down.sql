@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_menu_items_search_vector;
+DROP INDEX IF EXISTS idx_restaurants_search_vector;
+
+ALTER TABLE menu_items
+DROP COLUMN search_vector;
+
+ALTER TABLE restaurants
+DROP COLUMN search_vector;
@@ -0,0 +1,21 @@
+-- Both configurations are indexed so Russian and English queries stem correctly
+ALTER TABLE restaurants
+ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', COALESCE(name, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(name, '')), 'A') ||
+        setweight(to_tsvector('russian', COALESCE(description, '')), 'B') ||
+        setweight(to_tsvector('english', COALESCE(description, '')), 'B')
+    ) STORED;
+
+ALTER TABLE menu_items
+ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', COALESCE(name, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(name, '')), 'A') ||
+        setweight(to_tsvector('russian', COALESCE(category, '')), 'B') ||
+        setweight(to_tsvector('english', COALESCE(category, '')), 'B') ||
+        setweight(to_tsvector('russian', COALESCE(description, '')), 'C') ||
+        setweight(to_tsvector('english', COALESCE(description, '')), 'C')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_restaurants_search_vector ON restaurants USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_menu_items_search_vector ON menu_items USING GIN (search_vector);
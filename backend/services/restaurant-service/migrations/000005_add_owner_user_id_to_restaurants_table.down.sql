@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_restaurants_owner_user_id;
+
+ALTER TABLE restaurants
+DROP COLUMN owner_user_id;
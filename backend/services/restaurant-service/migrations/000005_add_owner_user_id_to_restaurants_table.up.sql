@@ -0,0 +1,5 @@
+-- References users in user-service; restaurants without an owner are managed by admins
+ALTER TABLE restaurants
+ADD COLUMN owner_user_id VARCHAR(36);
+
+CREATE INDEX IF NOT EXISTS idx_restaurants_owner_user_id ON restaurants(owner_user_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_menu_items_restaurant_external_sku;
+
+ALTER TABLE menu_items
+DROP COLUMN external_sku;
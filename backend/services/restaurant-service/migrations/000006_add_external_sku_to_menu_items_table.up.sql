@@ -0,0 +1,7 @@
+-- Partners' own item codes, used to match rows when a menu is imported again
+ALTER TABLE menu_items
+ADD COLUMN external_sku VARCHAR(100);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_menu_items_restaurant_external_sku
+    ON menu_items(restaurant_id, external_sku)
+    WHERE external_sku IS NOT NULL;
@@ -0,0 +1,25 @@
+-- Choices offered with a menu item, e.g. "Size" or "Sauce (choose 1)"
+CREATE TABLE IF NOT EXISTS menu_option_groups (
+        id              UUID PRIMARY KEY,
+        menu_item_id    UUID NOT NULL REFERENCES menu_items(id) ON DELETE CASCADE,
+        name            VARCHAR(100) NOT NULL,
+        min_select      INT NOT NULL DEFAULT 0 CHECK (min_select >= 0),
+        max_select      INT NOT NULL DEFAULT 1 CHECK (max_select >= 1 AND max_select >= min_select),
+        position        INT NOT NULL DEFAULT 0,
+        created_at      TIMESTAMP DEFAULT NOW(),
+        updated_at      TIMESTAMP DEFAULT NOW()
+    );
+
+CREATE TABLE IF NOT EXISTS menu_options (
+        id              UUID PRIMARY KEY,
+        group_id        UUID NOT NULL REFERENCES menu_option_groups(id) ON DELETE CASCADE,
+        name            VARCHAR(100) NOT NULL,
+        price_delta     DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (price_delta >= 0),
+        is_available    BOOLEAN NOT NULL DEFAULT TRUE,
+        position        INT NOT NULL DEFAULT 0,
+        created_at      TIMESTAMP DEFAULT NOW(),
+        updated_at      TIMESTAMP DEFAULT NOW()
+    );
+
+CREATE INDEX IF NOT EXISTS idx_menu_option_groups_menu_item_id ON menu_option_groups(menu_item_id);
+CREATE INDEX IF NOT EXISTS idx_menu_options_group_id ON menu_options(group_id);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS restaurant_hour_exceptions;
+DROP TABLE IF EXISTS restaurant_hours;
+
+ALTER TABLE restaurants
+DROP COLUMN is_paused,
+DROP COLUMN timezone;
@@ -0,0 +1,28 @@
+-- Opening hours are read in the restaurant's own timezone
+ALTER TABLE restaurants
+ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Almaty',
+ADD COLUMN is_paused BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Weekly schedule, weekday 0 is Sunday. closes_at <= opens_at runs past midnight.
+-- Restaurants without rows here fall back to opening_time/closing_time every day.
+CREATE TABLE IF NOT EXISTS restaurant_hours (
+        id              UUID PRIMARY KEY,
+        restaurant_id   UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+        weekday         SMALLINT NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+        opens_at        TIME NOT NULL,
+        closes_at       TIME NOT NULL
+    );
+
+-- Dated overrides of the weekly schedule. A row without times closes the whole day.
+CREATE TABLE IF NOT EXISTS restaurant_hour_exceptions (
+        id              UUID PRIMARY KEY,
+        restaurant_id   UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+        date            DATE NOT NULL,
+        opens_at        TIME,
+        closes_at       TIME,
+        note            VARCHAR(255),
+        CHECK ((opens_at IS NULL) = (closes_at IS NULL))
+    );
+
+CREATE INDEX IF NOT EXISTS idx_restaurant_hours_restaurant_id ON restaurant_hours(restaurant_id);
+CREATE INDEX IF NOT EXISTS idx_restaurant_hour_exceptions_restaurant_id_date ON restaurant_hour_exceptions(restaurant_id, date);
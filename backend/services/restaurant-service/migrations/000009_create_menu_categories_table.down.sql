@@ -0,0 +1,5 @@
+ALTER TABLE menu_items
+DROP COLUMN position,
+DROP COLUMN category_id;
+
+DROP TABLE IF EXISTS menu_categories;
@@ -0,0 +1,36 @@
+-- Categories in display order. menu_items.category keeps the name for search
+-- and the API, category_id points at the row that orders it.
+CREATE TABLE IF NOT EXISTS menu_categories (
+        id              UUID PRIMARY KEY,
+        restaurant_id   UUID NOT NULL REFERENCES restaurants(id) ON DELETE CASCADE,
+        name            VARCHAR(50) NOT NULL,
+        position        INT NOT NULL DEFAULT 0,
+        created_at      TIMESTAMP DEFAULT NOW(),
+        updated_at      TIMESTAMP DEFAULT NOW(),
+        UNIQUE (restaurant_id, name)
+    );
+
+ALTER TABLE menu_items
+ADD COLUMN category_id UUID REFERENCES menu_categories(id) ON DELETE SET NULL,
+ADD COLUMN position INT NOT NULL DEFAULT 0;
+
+-- Existing categories and items start out in the old alphabetical order
+INSERT INTO menu_categories (id, restaurant_id, name, position)
+SELECT gen_random_uuid(), restaurant_id, category,
+       ROW_NUMBER() OVER (PARTITION BY restaurant_id ORDER BY category) - 1
+FROM (SELECT DISTINCT restaurant_id, category FROM menu_items WHERE category <> '') c;
+
+UPDATE menu_items m
+SET category_id = c.id
+FROM menu_categories c
+WHERE c.restaurant_id = m.restaurant_id AND c.name = m.category;
+
+UPDATE menu_items m
+SET position = o.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY restaurant_id, category_id ORDER BY name) - 1 AS position
+    FROM menu_items
+) o
+WHERE o.id = m.id;
+
+CREATE INDEX IF NOT EXISTS idx_menu_items_category_id ON menu_items(category_id);
@@ -23,21 +23,27 @@ const (
 
 // Messages
 type Restaurant struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
-	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
-	Latitude      float64                `protobuf:"fixed64,6,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude     float64                `protobuf:"fixed64,7,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	LogoUrl       string                 `protobuf:"bytes,8,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
-	OpeningTime   string                 `protobuf:"bytes,9,opt,name=opening_time,json=openingTime,proto3" json:"opening_time,omitempty"`
-	ClosingTime   string                 `protobuf:"bytes,10,opt,name=closing_time,json=closingTime,proto3" json:"closing_time,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Address          string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Phone            string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Latitude         float64                `protobuf:"fixed64,6,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude        float64                `protobuf:"fixed64,7,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	LogoUrl          string                 `protobuf:"bytes,8,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	OpeningTime      string                 `protobuf:"bytes,9,opt,name=opening_time,json=openingTime,proto3" json:"opening_time,omitempty"`
+	ClosingTime      string                 `protobuf:"bytes,10,opt,name=closing_time,json=closingTime,proto3" json:"closing_time,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeliveryRadiusKm float64                `protobuf:"fixed64,13,opt,name=delivery_radius_km,json=deliveryRadiusKm,proto3" json:"delivery_radius_km,omitempty"`
+	DeliveryPolygon  []*GeoPoint            `protobuf:"bytes,14,rep,name=delivery_polygon,json=deliveryPolygon,proto3" json:"delivery_polygon,omitempty"`
+	DistanceKm       float64                `protobuf:"fixed64,15,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	OwnerUserId      string                 `protobuf:"bytes,16,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"`
+	Timezone         string                 `protobuf:"bytes,17,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	IsPaused         bool                   `protobuf:"varint,18,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Restaurant) Reset() {
@@ -154,6 +160,101 @@ func (x *Restaurant) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Restaurant) GetDeliveryRadiusKm() float64 {
+	if x != nil {
+		return x.DeliveryRadiusKm
+	}
+	return 0
+}
+
+func (x *Restaurant) GetDeliveryPolygon() []*GeoPoint {
+	if x != nil {
+		return x.DeliveryPolygon
+	}
+	return nil
+}
+
+func (x *Restaurant) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *Restaurant) GetOwnerUserId() string {
+	if x != nil {
+		return x.OwnerUserId
+	}
+	return ""
+}
+
+func (x *Restaurant) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Restaurant) GetIsPaused() bool {
+	if x != nil {
+		return x.IsPaused
+	}
+	return false
+}
+
+// GeoPoint - A polygon vertex; when a restaurant has a polygon it replaces the radius
+type GeoPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	mi := &file_restaurant_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GeoPoint) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
 type MenuItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -166,13 +267,17 @@ type MenuItem struct {
 	Category      string                 `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ExternalSku   string                 `protobuf:"bytes,11,opt,name=external_sku,json=externalSku,proto3" json:"external_sku,omitempty"`
+	OptionGroups  []*OptionGroup         `protobuf:"bytes,12,rep,name=option_groups,json=optionGroups,proto3" json:"option_groups,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,13,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Position      int32                  `protobuf:"varint,14,opt,name=position,proto3" json:"position,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MenuItem) Reset() {
 	*x = MenuItem{}
-	mi := &file_restaurant_proto_msgTypes[1]
+	mi := &file_restaurant_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -184,7 +289,7 @@ func (x *MenuItem) String() string {
 func (*MenuItem) ProtoMessage() {}
 
 func (x *MenuItem) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[1]
+	mi := &file_restaurant_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -197,7 +302,7 @@ func (x *MenuItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MenuItem.ProtoReflect.Descriptor instead.
 func (*MenuItem) Descriptor() ([]byte, []int) {
-	return file_restaurant_proto_rawDescGZIP(), []int{1}
+	return file_restaurant_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *MenuItem) GetId() string {
@@ -270,72 +375,61 @@ func (x *MenuItem) GetUpdatedAt() string {
 	return ""
 }
 
-type GetRestaurantsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetRestaurantsRequest) Reset() {
-	*x = GetRestaurantsRequest{}
-	mi := &file_restaurant_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetRestaurantsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MenuItem) GetExternalSku() string {
+	if x != nil {
+		return x.ExternalSku
+	}
+	return ""
 }
 
-func (*GetRestaurantsRequest) ProtoMessage() {}
-
-func (x *GetRestaurantsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[2]
+func (x *MenuItem) GetOptionGroups() []*OptionGroup {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.OptionGroups
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetRestaurantsRequest.ProtoReflect.Descriptor instead.
-func (*GetRestaurantsRequest) Descriptor() ([]byte, []int) {
-	return file_restaurant_proto_rawDescGZIP(), []int{2}
+func (x *MenuItem) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
 }
 
-func (x *GetRestaurantsRequest) GetPage() int32 {
+func (x *MenuItem) GetPosition() int32 {
 	if x != nil {
-		return x.Page
+		return x.Position
 	}
 	return 0
 }
 
-type GetRestaurantsResponse struct {
+// OptionGroup - A choice offered with a menu item, e.g. "Size" or "Sauce".
+// Customers pick between min_select and max_select of its options.
+type OptionGroup struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Restaurants   []*Restaurant          `protobuf:"bytes,1,rep,name=restaurants,proto3" json:"restaurants,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MinSelect     int32                  `protobuf:"varint,3,opt,name=min_select,json=minSelect,proto3" json:"min_select,omitempty"`
+	MaxSelect     int32                  `protobuf:"varint,4,opt,name=max_select,json=maxSelect,proto3" json:"max_select,omitempty"`
+	Options       []*MenuOption          `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRestaurantsResponse) Reset() {
-	*x = GetRestaurantsResponse{}
+func (x *OptionGroup) Reset() {
+	*x = OptionGroup{}
 	mi := &file_restaurant_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRestaurantsResponse) String() string {
+func (x *OptionGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRestaurantsResponse) ProtoMessage() {}
+func (*OptionGroup) ProtoMessage() {}
 
-func (x *GetRestaurantsResponse) ProtoReflect() protoreflect.Message {
+func (x *OptionGroup) ProtoReflect() protoreflect.Message {
 	mi := &file_restaurant_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -347,46 +441,71 @@ func (x *GetRestaurantsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRestaurantsResponse.ProtoReflect.Descriptor instead.
-func (*GetRestaurantsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OptionGroup.ProtoReflect.Descriptor instead.
+func (*OptionGroup) Descriptor() ([]byte, []int) {
 	return file_restaurant_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetRestaurantsResponse) GetRestaurants() []*Restaurant {
+func (x *OptionGroup) GetId() string {
 	if x != nil {
-		return x.Restaurants
+		return x.Id
 	}
-	return nil
+	return ""
+}
+
+func (x *OptionGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *GetRestaurantsResponse) GetTotal() int32 {
+func (x *OptionGroup) GetMinSelect() int32 {
 	if x != nil {
-		return x.Total
+		return x.MinSelect
 	}
 	return 0
 }
 
-type GetRestaurantRequest struct {
+func (x *OptionGroup) GetMaxSelect() int32 {
+	if x != nil {
+		return x.MaxSelect
+	}
+	return 0
+}
+
+func (x *OptionGroup) GetOptions() []*MenuOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// MenuOption - price_delta is added to the item price when the option is chosen
+type MenuOption struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PriceDelta    float64                `protobuf:"fixed64,3,opt,name=price_delta,json=priceDelta,proto3" json:"price_delta,omitempty"`
+	IsAvailable   bool                   `protobuf:"varint,4,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRestaurantRequest) Reset() {
-	*x = GetRestaurantRequest{}
+func (x *MenuOption) Reset() {
+	*x = MenuOption{}
 	mi := &file_restaurant_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRestaurantRequest) String() string {
+func (x *MenuOption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRestaurantRequest) ProtoMessage() {}
+func (*MenuOption) ProtoMessage() {}
 
-func (x *GetRestaurantRequest) ProtoReflect() protoreflect.Message {
+func (x *MenuOption) ProtoReflect() protoreflect.Message {
 	mi := &file_restaurant_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -398,39 +517,69 @@ func (x *GetRestaurantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRestaurantRequest.ProtoReflect.Descriptor instead.
-func (*GetRestaurantRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MenuOption.ProtoReflect.Descriptor instead.
+func (*MenuOption) Descriptor() ([]byte, []int) {
 	return file_restaurant_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetRestaurantRequest) GetId() string {
+func (x *MenuOption) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetRestaurantResponse struct {
+func (x *MenuOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MenuOption) GetPriceDelta() float64 {
+	if x != nil {
+		return x.PriceDelta
+	}
+	return 0
+}
+
+func (x *MenuOption) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+// address_id (owned by user_id) limits the list to restaurants delivering to that address.
+// With an origin, results are sorted nearest first with distance_km set; radius_km = 0 means no limit.
+// page_size defaults to 10 and is capped at 100; page_token is a previous next_page_token
+// and only works with the same filters.
+type GetRestaurantsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Restaurant    *Restaurant            `protobuf:"bytes,1,opt,name=restaurant,proto3" json:"restaurant,omitempty"`
+	AddressId     string                 `protobuf:"bytes,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Origin        *GeoPoint              `protobuf:"bytes,4,opt,name=origin,proto3" json:"origin,omitempty"`
+	RadiusKm      float64                `protobuf:"fixed64,5,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRestaurantResponse) Reset() {
-	*x = GetRestaurantResponse{}
+func (x *GetRestaurantsRequest) Reset() {
+	*x = GetRestaurantsRequest{}
 	mi := &file_restaurant_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRestaurantResponse) String() string {
+func (x *GetRestaurantsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRestaurantResponse) ProtoMessage() {}
+func (*GetRestaurantsRequest) ProtoMessage() {}
 
-func (x *GetRestaurantResponse) ProtoReflect() protoreflect.Message {
+func (x *GetRestaurantsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_restaurant_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -442,40 +591,76 @@ func (x *GetRestaurantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRestaurantResponse.ProtoReflect.Descriptor instead.
-func (*GetRestaurantResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRestaurantsRequest.ProtoReflect.Descriptor instead.
+func (*GetRestaurantsRequest) Descriptor() ([]byte, []int) {
 	return file_restaurant_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetRestaurantResponse) GetRestaurant() *Restaurant {
+func (x *GetRestaurantsRequest) GetAddressId() string {
 	if x != nil {
-		return x.Restaurant
+		return x.AddressId
+	}
+	return ""
+}
+
+func (x *GetRestaurantsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetRestaurantsRequest) GetOrigin() *GeoPoint {
+	if x != nil {
+		return x.Origin
 	}
 	return nil
 }
 
-type GetMenuRequest struct {
+func (x *GetRestaurantsRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *GetRestaurantsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetRestaurantsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// next_page_token is empty on the last page
+type GetRestaurantsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RestaurantId  string                 `protobuf:"bytes,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Restaurants   []*Restaurant          `protobuf:"bytes,1,rep,name=restaurants,proto3" json:"restaurants,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMenuRequest) Reset() {
-	*x = GetMenuRequest{}
+func (x *GetRestaurantsResponse) Reset() {
+	*x = GetRestaurantsResponse{}
 	mi := &file_restaurant_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMenuRequest) String() string {
+func (x *GetRestaurantsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMenuRequest) ProtoMessage() {}
+func (*GetRestaurantsResponse) ProtoMessage() {}
 
-func (x *GetMenuRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRestaurantsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_restaurant_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -487,48 +672,3044 @@ func (x *GetMenuRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMenuRequest.ProtoReflect.Descriptor instead.
-func (*GetMenuRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRestaurantsResponse.ProtoReflect.Descriptor instead.
+func (*GetRestaurantsResponse) Descriptor() ([]byte, []int) {
 	return file_restaurant_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetMenuRequest) GetRestaurantId() string {
+func (x *GetRestaurantsResponse) GetRestaurants() []*Restaurant {
 	if x != nil {
-		return x.RestaurantId
+		return x.Restaurants
 	}
-	return ""
+	return nil
 }
 
-func (x *GetMenuRequest) GetPage() int32 {
+func (x *GetRestaurantsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Page
+		return x.NextPageToken
 	}
-	return 0
+	return ""
 }
 
-type GetMenuResponse struct {
+type GetRestaurantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*MenuItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMenuResponse) Reset() {
-	*x = GetMenuResponse{}
+func (x *GetRestaurantRequest) Reset() {
+	*x = GetRestaurantRequest{}
 	mi := &file_restaurant_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
+func (x *GetRestaurantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRestaurantRequest) ProtoMessage() {}
+
+func (x *GetRestaurantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRestaurantRequest.ProtoReflect.Descriptor instead.
+func (*GetRestaurantRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetRestaurantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetRestaurantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Restaurant    *Restaurant            `protobuf:"bytes,1,opt,name=restaurant,proto3" json:"restaurant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRestaurantResponse) Reset() {
+	*x = GetRestaurantResponse{}
+	mi := &file_restaurant_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRestaurantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRestaurantResponse) ProtoMessage() {}
+
+func (x *GetRestaurantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRestaurantResponse.ProtoReflect.Descriptor instead.
+func (*GetRestaurantResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetRestaurantResponse) GetRestaurant() *Restaurant {
+	if x != nil {
+		return x.Restaurant
+	}
+	return nil
+}
+
+// GetMenu - Pages of items in display order (page_size defaults to 10, capped at 100),
+// or with grouped set the whole menu in categories; items without a category come last
+// in a category with no id
+type GetMenuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RestaurantId  string                 `protobuf:"bytes,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	Grouped       bool                   `protobuf:"varint,3,opt,name=grouped,proto3" json:"grouped,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMenuRequest) Reset() {
+	*x = GetMenuRequest{}
+	mi := &file_restaurant_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMenuRequest) ProtoMessage() {}
+
+func (x *GetMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMenuRequest.ProtoReflect.Descriptor instead.
+func (*GetMenuRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetMenuRequest) GetRestaurantId() string {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return ""
+}
+
+func (x *GetMenuRequest) GetGrouped() bool {
+	if x != nil {
+		return x.Grouped
+	}
+	return false
+}
+
+func (x *GetMenuRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetMenuRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetMenuResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MenuItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Categories    []*MenuCategory        `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMenuResponse) Reset() {
+	*x = GetMenuResponse{}
+	mi := &file_restaurant_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
 func (x *GetMenuResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMenuResponse) ProtoMessage() {}
+func (*GetMenuResponse) ProtoMessage() {}
+
+func (x *GetMenuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMenuResponse.ProtoReflect.Descriptor instead.
+func (*GetMenuResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetMenuResponse) GetItems() []*MenuItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetMenuResponse) GetCategories() []*MenuCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetMenuResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// MenuCategory - Categories are created from the category name of menu items
+type MenuCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Items         []*MenuItem            `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuCategory) Reset() {
+	*x = MenuCategory{}
+	mi := &file_restaurant_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuCategory) ProtoMessage() {}
+
+func (x *MenuCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuCategory.ProtoReflect.Descriptor instead.
+func (*MenuCategory) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MenuCategory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MenuCategory) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *MenuCategory) GetItems() []*MenuItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetMenuItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMenuItemRequest) Reset() {
+	*x = GetMenuItemRequest{}
+	mi := &file_restaurant_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMenuItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMenuItemRequest) ProtoMessage() {}
+
+func (x *GetMenuItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMenuItemRequest.ProtoReflect.Descriptor instead.
+func (*GetMenuItemRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetMenuItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetMenuItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *MenuItem              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMenuItemResponse) Reset() {
+	*x = GetMenuItemResponse{}
+	mi := &file_restaurant_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMenuItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMenuItemResponse) ProtoMessage() {}
+
+func (x *GetMenuItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMenuItemResponse.ProtoReflect.Descriptor instead.
+func (*GetMenuItemResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetMenuItemResponse) GetItem() *MenuItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// GetRestaurantStatus - Check if restaurant is open and accepting orders
+type GetRestaurantStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RestaurantId  string                 `protobuf:"bytes,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRestaurantStatusRequest) Reset() {
+	*x = GetRestaurantStatusRequest{}
+	mi := &file_restaurant_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRestaurantStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRestaurantStatusRequest) ProtoMessage() {}
+
+func (x *GetRestaurantStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRestaurantStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetRestaurantStatusRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetRestaurantStatusRequest) GetRestaurantId() string {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return ""
+}
+
+// is_open follows the schedule alone; a paused restaurant is open but not accepting orders.
+// next_open_at and next_close_at are RFC 3339 in the restaurant's timezone, empty when
+// unknown (paused, or no opening within the next weeks).
+type GetRestaurantStatusResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	IsAcceptingOrders bool                   `protobuf:"varint,2,opt,name=is_accepting_orders,json=isAcceptingOrders,proto3" json:"is_accepting_orders,omitempty"`
+	OpeningTime       string                 `protobuf:"bytes,3,opt,name=opening_time,json=openingTime,proto3" json:"opening_time,omitempty"`
+	ClosingTime       string                 `protobuf:"bytes,4,opt,name=closing_time,json=closingTime,proto3" json:"closing_time,omitempty"`
+	Timezone          string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	IsPaused          bool                   `protobuf:"varint,6,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
+	IsOpen            bool                   `protobuf:"varint,7,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	NextOpenAt        string                 `protobuf:"bytes,8,opt,name=next_open_at,json=nextOpenAt,proto3" json:"next_open_at,omitempty"`
+	NextCloseAt       string                 `protobuf:"bytes,9,opt,name=next_close_at,json=nextCloseAt,proto3" json:"next_close_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetRestaurantStatusResponse) Reset() {
+	*x = GetRestaurantStatusResponse{}
+	mi := &file_restaurant_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRestaurantStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRestaurantStatusResponse) ProtoMessage() {}
+
+func (x *GetRestaurantStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRestaurantStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetRestaurantStatusResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetRestaurantStatusResponse) GetIsAcceptingOrders() bool {
+	if x != nil {
+		return x.IsAcceptingOrders
+	}
+	return false
+}
+
+func (x *GetRestaurantStatusResponse) GetOpeningTime() string {
+	if x != nil {
+		return x.OpeningTime
+	}
+	return ""
+}
+
+func (x *GetRestaurantStatusResponse) GetClosingTime() string {
+	if x != nil {
+		return x.ClosingTime
+	}
+	return ""
+}
+
+func (x *GetRestaurantStatusResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetRestaurantStatusResponse) GetIsPaused() bool {
+	if x != nil {
+		return x.IsPaused
+	}
+	return false
+}
+
+func (x *GetRestaurantStatusResponse) GetIsOpen() bool {
+	if x != nil {
+		return x.IsOpen
+	}
+	return false
+}
+
+func (x *GetRestaurantStatusResponse) GetNextOpenAt() string {
+	if x != nil {
+		return x.NextOpenAt
+	}
+	return ""
+}
+
+func (x *GetRestaurantStatusResponse) GetNextCloseAt() string {
+	if x != nil {
+		return x.NextCloseAt
+	}
+	return ""
+}
+
+// ValidateMenuItems - Validate multiple items are available (for order validation).
+// With lines, the chosen options are validated too and each line is priced.
+type ValidateMenuItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RestaurantId  string                 `protobuf:"bytes,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	ItemIds       []string               `protobuf:"bytes,2,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	Lines         []*ItemSelection       `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateMenuItemsRequest) Reset() {
+	*x = ValidateMenuItemsRequest{}
+	mi := &file_restaurant_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateMenuItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateMenuItemsRequest) ProtoMessage() {}
+
+func (x *ValidateMenuItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateMenuItemsRequest.ProtoReflect.Descriptor instead.
+func (*ValidateMenuItemsRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ValidateMenuItemsRequest) GetRestaurantId() string {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return ""
+}
+
+func (x *ValidateMenuItemsRequest) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+func (x *ValidateMenuItemsRequest) GetLines() []*ItemSelection {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// ItemSelection - A menu item with the options chosen for it; quantity defaults to 1
+type ItemSelection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MenuItemId    string                 `protobuf:"bytes,1,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"`
+	OptionIds     []string               `protobuf:"bytes,2,rep,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemSelection) Reset() {
+	*x = ItemSelection{}
+	mi := &file_restaurant_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemSelection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemSelection) ProtoMessage() {}
+
+func (x *ItemSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemSelection.ProtoReflect.Descriptor instead.
+func (*ItemSelection) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ItemSelection) GetMenuItemId() string {
+	if x != nil {
+		return x.MenuItemId
+	}
+	return ""
+}
+
+func (x *ItemSelection) GetOptionIds() []string {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
+func (x *ItemSelection) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type SelectedOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupName     string                 `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	PriceDelta    float64                `protobuf:"fixed64,4,opt,name=price_delta,json=priceDelta,proto3" json:"price_delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectedOption) Reset() {
+	*x = SelectedOption{}
+	mi := &file_restaurant_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectedOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectedOption) ProtoMessage() {}
+
+func (x *SelectedOption) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectedOption.ProtoReflect.Descriptor instead.
+func (*SelectedOption) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SelectedOption) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SelectedOption) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *SelectedOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SelectedOption) GetPriceDelta() float64 {
+	if x != nil {
+		return x.PriceDelta
+	}
+	return 0
+}
+
+// LineValidation - unit_price is the item price plus its options, line_price
+// is unit_price times quantity. errors explain why a line is not valid.
+type LineValidation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MenuItemId    string                 `protobuf:"bytes,1,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"`
+	Valid         bool                   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UnitPrice     float64                `protobuf:"fixed64,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	LinePrice     float64                `protobuf:"fixed64,6,opt,name=line_price,json=linePrice,proto3" json:"line_price,omitempty"`
+	Options       []*SelectedOption      `protobuf:"bytes,7,rep,name=options,proto3" json:"options,omitempty"`
+	Errors        []string               `protobuf:"bytes,8,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineValidation) Reset() {
+	*x = LineValidation{}
+	mi := &file_restaurant_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineValidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineValidation) ProtoMessage() {}
+
+func (x *LineValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineValidation.ProtoReflect.Descriptor instead.
+func (*LineValidation) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LineValidation) GetMenuItemId() string {
+	if x != nil {
+		return x.MenuItemId
+	}
+	return ""
+}
+
+func (x *LineValidation) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *LineValidation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LineValidation) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *LineValidation) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *LineValidation) GetLinePrice() float64 {
+	if x != nil {
+		return x.LinePrice
+	}
+	return 0
+}
+
+func (x *LineValidation) GetOptions() []*SelectedOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *LineValidation) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type MenuItemValidation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	IsAvailable   bool                   `protobuf:"varint,2,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuItemValidation) Reset() {
+	*x = MenuItemValidation{}
+	mi := &file_restaurant_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuItemValidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuItemValidation) ProtoMessage() {}
+
+func (x *MenuItemValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuItemValidation.ProtoReflect.Descriptor instead.
+func (*MenuItemValidation) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MenuItemValidation) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *MenuItemValidation) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *MenuItemValidation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MenuItemValidation) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type ValidateMenuItemsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AllAvailable     bool                   `protobuf:"varint,1,opt,name=all_available,json=allAvailable,proto3" json:"all_available,omitempty"`
+	Items            []*MenuItemValidation  `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	UnavailableItems []string               `protobuf:"bytes,3,rep,name=unavailable_items,json=unavailableItems,proto3" json:"unavailable_items,omitempty"`
+	Lines            []*LineValidation      `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	AllValid         bool                   `protobuf:"varint,5,opt,name=all_valid,json=allValid,proto3" json:"all_valid,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ValidateMenuItemsResponse) Reset() {
+	*x = ValidateMenuItemsResponse{}
+	mi := &file_restaurant_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateMenuItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateMenuItemsResponse) ProtoMessage() {}
+
+func (x *ValidateMenuItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateMenuItemsResponse.ProtoReflect.Descriptor instead.
+func (*ValidateMenuItemsResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ValidateMenuItemsResponse) GetAllAvailable() bool {
+	if x != nil {
+		return x.AllAvailable
+	}
+	return false
+}
+
+func (x *ValidateMenuItemsResponse) GetItems() []*MenuItemValidation {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ValidateMenuItemsResponse) GetUnavailableItems() []string {
+	if x != nil {
+		return x.UnavailableItems
+	}
+	return nil
+}
+
+func (x *ValidateMenuItemsResponse) GetLines() []*LineValidation {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *ValidateMenuItemsResponse) GetAllValid() bool {
+	if x != nil {
+		return x.AllValid
+	}
+	return false
+}
+
+// CheckDeliverable - Whether a point lies inside the restaurant's delivery zone
+type CheckDeliverableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RestaurantId  string                 `protobuf:"bytes,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckDeliverableRequest) Reset() {
+	*x = CheckDeliverableRequest{}
+	mi := &file_restaurant_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckDeliverableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDeliverableRequest) ProtoMessage() {}
+
+func (x *CheckDeliverableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDeliverableRequest.ProtoReflect.Descriptor instead.
+func (*CheckDeliverableRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CheckDeliverableRequest) GetRestaurantId() string {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return ""
+}
+
+func (x *CheckDeliverableRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *CheckDeliverableRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type CheckDeliverableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliverable   bool                   `protobuf:"varint,1,opt,name=deliverable,proto3" json:"deliverable,omitempty"`
+	DistanceKm    float64                `protobuf:"fixed64,2,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckDeliverableResponse) Reset() {
+	*x = CheckDeliverableResponse{}
+	mi := &file_restaurant_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckDeliverableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDeliverableResponse) ProtoMessage() {}
+
+func (x *CheckDeliverableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDeliverableResponse.ProtoReflect.Descriptor instead.
+func (*CheckDeliverableResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CheckDeliverableResponse) GetDeliverable() bool {
+	if x != nil {
+		return x.Deliverable
+	}
+	return false
+}
+
+func (x *CheckDeliverableResponse) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+// Search - Full-text search (Russian and English) over restaurants and their menus, best match first.
+// page_size counts restaurants, defaults to 10 and is capped at 100; page_token is a previous
+// next_page_token and only works with the same query.
+type SearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_restaurant_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// SearchResult - A matching restaurant with the menu items that matched, best first
+type SearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Restaurant    *Restaurant            `protobuf:"bytes,1,opt,name=restaurant,proto3" json:"restaurant,omitempty"`
+	Items         []*MenuItem            `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Rank          float64                `protobuf:"fixed64,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_restaurant_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SearchResult) GetRestaurant() *Restaurant {
+	if x != nil {
+		return x.Restaurant
+	}
+	return nil
+}
+
+func (x *SearchResult) GetItems() []*MenuItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SearchResult) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+// next_page_token is empty on the last page
+type SearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_restaurant_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SearchResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Actor - The user making a change, as authenticated by the gateway
+type Actor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Actor) Reset() {
+	*x = Actor{}
+	mi := &file_restaurant_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Actor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Actor) ProtoMessage() {}
+
+func (x *Actor) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Actor.ProtoReflect.Descriptor instead.
+func (*Actor) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Actor) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Actor) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// CreateRestaurant - Restaurant users own what they create; admins must name the owner
+type CreateRestaurantRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Actor            *Actor                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	OwnerUserId      string                 `protobuf:"bytes,2,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Address          string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Phone            string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
+	Latitude         float64                `protobuf:"fixed64,7,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude        float64                `protobuf:"fixed64,8,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	LogoUrl          string                 `protobuf:"bytes,9,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	OpeningTime      string                 `protobuf:"bytes,10,opt,name=opening_time,json=openingTime,proto3" json:"opening_time,omitempty"`
+	ClosingTime      string                 `protobuf:"bytes,11,opt,name=closing_time,json=closingTime,proto3" json:"closing_time,omitempty"`
+	DeliveryRadiusKm float64                `protobuf:"fixed64,12,opt,name=delivery_radius_km,json=deliveryRadiusKm,proto3" json:"delivery_radius_km,omitempty"`
+	DeliveryPolygon  []*GeoPoint            `protobuf:"bytes,13,rep,name=delivery_polygon,json=deliveryPolygon,proto3" json:"delivery_polygon,omitempty"`
+	Timezone         string                 `protobuf:"bytes,14,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateRestaurantRequest) Reset() {
+	*x = CreateRestaurantRequest{}
+	mi := &file_restaurant_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRestaurantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRestaurantRequest) ProtoMessage() {}
+
+func (x *CreateRestaurantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRestaurantRequest.ProtoReflect.Descriptor instead.
+func (*CreateRestaurantRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateRestaurantRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *CreateRestaurantRequest) GetOwnerUserId() string {
+	if x != nil {
+		return x.OwnerUserId
+	}
+	return ""
+}
+
+func (x *CreateRestaurantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRestaurantRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRestaurantRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateRestaurantRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateRestaurantRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *CreateRestaurantRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *CreateRestaurantRequest) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *CreateRestaurantRequest) GetOpeningTime() string {
+	if x != nil {
+		return x.OpeningTime
+	}
+	return ""
+}
+
+func (x *CreateRestaurantRequest) GetClosingTime() string {
+	if x != nil {
+		return x.ClosingTime
+	}
+	return ""
+}
+
+func (x *CreateRestaurantRequest) GetDeliveryRadiusKm() float64 {
+	if x != nil {
+		return x.DeliveryRadiusKm
+	}
+	return 0
+}
+
+func (x *CreateRestaurantRequest) GetDeliveryPolygon() []*GeoPoint {
+	if x != nil {
+		return x.DeliveryPolygon
+	}
+	return nil
+}
+
+func (x *CreateRestaurantRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type CreateRestaurantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Restaurant    *Restaurant            `protobuf:"bytes,1,opt,name=restaurant,proto3" json:"restaurant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRestaurantResponse) Reset() {
+	*x = CreateRestaurantResponse{}
+	mi := &file_restaurant_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRestaurantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRestaurantResponse) ProtoMessage() {}
+
+func (x *CreateRestaurantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRestaurantResponse.ProtoReflect.Descriptor instead.
+func (*CreateRestaurantResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateRestaurantResponse) GetRestaurant() *Restaurant {
+	if x != nil {
+		return x.Restaurant
+	}
+	return nil
+}
+
+// DeliveryPolygonUpdate - Replaces the delivery polygon; no points removes it
+type DeliveryPolygonUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*GeoPoint            `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliveryPolygonUpdate) Reset() {
+	*x = DeliveryPolygonUpdate{}
+	mi := &file_restaurant_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryPolygonUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryPolygonUpdate) ProtoMessage() {}
+
+func (x *DeliveryPolygonUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryPolygonUpdate.ProtoReflect.Descriptor instead.
+func (*DeliveryPolygonUpdate) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeliveryPolygonUpdate) GetPoints() []*GeoPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// UpdateRestaurant - Only fields that are set are changed
+type UpdateRestaurantRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Actor            *Actor                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Id               string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name             *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description      *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Address          *string                `protobuf:"bytes,5,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	Phone            *string                `protobuf:"bytes,6,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Latitude         *float64               `protobuf:"fixed64,7,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude        *float64               `protobuf:"fixed64,8,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	LogoUrl          *string                `protobuf:"bytes,9,opt,name=logo_url,json=logoUrl,proto3,oneof" json:"logo_url,omitempty"`
+	OpeningTime      *string                `protobuf:"bytes,10,opt,name=opening_time,json=openingTime,proto3,oneof" json:"opening_time,omitempty"`
+	ClosingTime      *string                `protobuf:"bytes,11,opt,name=closing_time,json=closingTime,proto3,oneof" json:"closing_time,omitempty"`
+	DeliveryRadiusKm *float64               `protobuf:"fixed64,12,opt,name=delivery_radius_km,json=deliveryRadiusKm,proto3,oneof" json:"delivery_radius_km,omitempty"`
+	DeliveryPolygon  *DeliveryPolygonUpdate `protobuf:"bytes,13,opt,name=delivery_polygon,json=deliveryPolygon,proto3" json:"delivery_polygon,omitempty"`
+	Timezone         *string                `protobuf:"bytes,14,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateRestaurantRequest) Reset() {
+	*x = UpdateRestaurantRequest{}
+	mi := &file_restaurant_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRestaurantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRestaurantRequest) ProtoMessage() {}
+
+func (x *UpdateRestaurantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRestaurantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRestaurantRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateRestaurantRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *UpdateRestaurantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRestaurantRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRestaurantRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateRestaurantRequest) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *UpdateRestaurantRequest) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateRestaurantRequest) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *UpdateRestaurantRequest) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *UpdateRestaurantRequest) GetLogoUrl() string {
+	if x != nil && x.LogoUrl != nil {
+		return *x.LogoUrl
+	}
+	return ""
+}
+
+func (x *UpdateRestaurantRequest) GetOpeningTime() string {
+	if x != nil && x.OpeningTime != nil {
+		return *x.OpeningTime
+	}
+	return ""
+}
+
+func (x *UpdateRestaurantRequest) GetClosingTime() string {
+	if x != nil && x.ClosingTime != nil {
+		return *x.ClosingTime
+	}
+	return ""
+}
+
+func (x *UpdateRestaurantRequest) GetDeliveryRadiusKm() float64 {
+	if x != nil && x.DeliveryRadiusKm != nil {
+		return *x.DeliveryRadiusKm
+	}
+	return 0
+}
+
+func (x *UpdateRestaurantRequest) GetDeliveryPolygon() *DeliveryPolygonUpdate {
+	if x != nil {
+		return x.DeliveryPolygon
+	}
+	return nil
+}
+
+func (x *UpdateRestaurantRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+type UpdateRestaurantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Restaurant    *Restaurant            `protobuf:"bytes,1,opt,name=restaurant,proto3" json:"restaurant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRestaurantResponse) Reset() {
+	*x = UpdateRestaurantResponse{}
+	mi := &file_restaurant_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRestaurantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRestaurantResponse) ProtoMessage() {}
+
+func (x *UpdateRestaurantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRestaurantResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRestaurantResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateRestaurantResponse) GetRestaurant() *Restaurant {
+	if x != nil {
+		return x.Restaurant
+	}
+	return nil
+}
+
+// CreateMenuItem - is_available defaults to true
+type CreateMenuItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         *Actor                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	RestaurantId  string                 `protobuf:"bytes,2,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Category      string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	IsAvailable   *bool                  `protobuf:"varint,8,opt,name=is_available,json=isAvailable,proto3,oneof" json:"is_available,omitempty"`
+	ExternalSku   string                 `protobuf:"bytes,9,opt,name=external_sku,json=externalSku,proto3" json:"external_sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMenuItemRequest) Reset() {
+	*x = CreateMenuItemRequest{}
+	mi := &file_restaurant_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMenuItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMenuItemRequest) ProtoMessage() {}
+
+func (x *CreateMenuItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMenuItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateMenuItemRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateMenuItemRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *CreateMenuItemRequest) GetRestaurantId() string {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return ""
+}
+
+func (x *CreateMenuItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMenuItemRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateMenuItemRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateMenuItemRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CreateMenuItemRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateMenuItemRequest) GetIsAvailable() bool {
+	if x != nil && x.IsAvailable != nil {
+		return *x.IsAvailable
+	}
+	return false
+}
+
+func (x *CreateMenuItemRequest) GetExternalSku() string {
+	if x != nil {
+		return x.ExternalSku
+	}
+	return ""
+}
+
+type CreateMenuItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *MenuItem              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMenuItemResponse) Reset() {
+	*x = CreateMenuItemResponse{}
+	mi := &file_restaurant_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMenuItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMenuItemResponse) ProtoMessage() {}
+
+func (x *CreateMenuItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMenuItemResponse.ProtoReflect.Descriptor instead.
+func (*CreateMenuItemResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateMenuItemResponse) GetItem() *MenuItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// UpdateMenuItem - Only fields that are set are changed
+type UpdateMenuItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         *Actor                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Price         *float64               `protobuf:"fixed64,5,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	ImageUrl      *string                `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	Category      *string                `protobuf:"bytes,7,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	IsAvailable   *bool                  `protobuf:"varint,8,opt,name=is_available,json=isAvailable,proto3,oneof" json:"is_available,omitempty"`
+	ExternalSku   *string                `protobuf:"bytes,9,opt,name=external_sku,json=externalSku,proto3,oneof" json:"external_sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMenuItemRequest) Reset() {
+	*x = UpdateMenuItemRequest{}
+	mi := &file_restaurant_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMenuItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMenuItemRequest) ProtoMessage() {}
+
+func (x *UpdateMenuItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMenuItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMenuItemRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateMenuItemRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *UpdateMenuItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateMenuItemRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateMenuItemRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateMenuItemRequest) GetPrice() float64 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *UpdateMenuItemRequest) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *UpdateMenuItemRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *UpdateMenuItemRequest) GetIsAvailable() bool {
+	if x != nil && x.IsAvailable != nil {
+		return *x.IsAvailable
+	}
+	return false
+}
+
+func (x *UpdateMenuItemRequest) GetExternalSku() string {
+	if x != nil && x.ExternalSku != nil {
+		return *x.ExternalSku
+	}
+	return ""
+}
+
+type UpdateMenuItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *MenuItem              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMenuItemResponse) Reset() {
+	*x = UpdateMenuItemResponse{}
+	mi := &file_restaurant_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMenuItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMenuItemResponse) ProtoMessage() {}
+
+func (x *UpdateMenuItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMenuItemResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMenuItemResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateMenuItemResponse) GetItem() *MenuItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type DeleteMenuItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         *Actor                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMenuItemRequest) Reset() {
+	*x = DeleteMenuItemRequest{}
+	mi := &file_restaurant_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMenuItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMenuItemRequest) ProtoMessage() {}
+
+func (x *DeleteMenuItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMenuItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMenuItemRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteMenuItemRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *DeleteMenuItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteMenuItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMenuItemResponse) Reset() {
+	*x = DeleteMenuItemResponse{}
+	mi := &file_restaurant_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMenuItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMenuItemResponse) ProtoMessage() {}
+
+func (x *DeleteMenuItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMenuItemResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMenuItemResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{38}
+}
+
+// SetItemAvailability - Quick toggle for items that run out during the day
+type SetItemAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         *Actor                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	IsAvailable   bool                   `protobuf:"varint,3,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetItemAvailabilityRequest) Reset() {
+	*x = SetItemAvailabilityRequest{}
+	mi := &file_restaurant_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetItemAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetItemAvailabilityRequest) ProtoMessage() {}
+
+func (x *SetItemAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetItemAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*SetItemAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetItemAvailabilityRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *SetItemAvailabilityRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetItemAvailabilityRequest) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+type SetItemAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *MenuItem              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetItemAvailabilityResponse) Reset() {
+	*x = SetItemAvailabilityResponse{}
+	mi := &file_restaurant_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetItemAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetItemAvailabilityResponse) ProtoMessage() {}
+
+func (x *SetItemAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetItemAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*SetItemAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetItemAvailabilityResponse) GetItem() *MenuItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// ImportMenu - Upserts menu items matched on external_sku from a "csv" or "json" file.
+// Nothing is written when any row fails or when dry_run is set.
+type ImportMenuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         *Actor                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	RestaurantId  string                 `protobuf:"bytes,2,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportMenuRequest) Reset() {
+	*x = ImportMenuRequest{}
+	mi := &file_restaurant_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMenuRequest) ProtoMessage() {}
+
+func (x *ImportMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMenuRequest.ProtoReflect.Descriptor instead.
+func (*ImportMenuRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ImportMenuRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *ImportMenuRequest) GetRestaurantId() string {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return ""
+}
+
+func (x *ImportMenuRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportMenuRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportMenuRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ImportRowError - row is the file line for CSV (the header is line 1) and the 1-based position for JSON
+type ImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_restaurant_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ImportRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportMenuResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Errors        []*ImportRowError      `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	Applied       bool                   `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportMenuResponse) Reset() {
+	*x = ImportMenuResponse{}
+	mi := &file_restaurant_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMenuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMenuResponse) ProtoMessage() {}
+
+func (x *ImportMenuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMenuResponse.ProtoReflect.Descriptor instead.
+func (*ImportMenuResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ImportMenuResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportMenuResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportMenuResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportMenuResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+type ExportMenuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         *Actor                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	RestaurantId  string                 `protobuf:"bytes,2,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMenuRequest) Reset() {
+	*x = ExportMenuRequest{}
+	mi := &file_restaurant_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMenuRequest) ProtoMessage() {}
+
+func (x *ExportMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMenuRequest.ProtoReflect.Descriptor instead.
+func (*ExportMenuRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExportMenuRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *ExportMenuRequest) GetRestaurantId() string {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return ""
+}
+
+func (x *ExportMenuRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportMenuResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMenuResponse) Reset() {
+	*x = ExportMenuResponse{}
+	mi := &file_restaurant_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMenuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMenuResponse) ProtoMessage() {}
+
+func (x *ExportMenuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMenuResponse.ProtoReflect.Descriptor instead.
+func (*ExportMenuResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ExportMenuResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportMenuResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportMenuResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// SetMenuItemOptions - Replaces the item's option groups. Groups and options
+// sent with an id are updated, ones without are created, the rest deleted.
+type SetMenuItemOptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         *Actor                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	MenuItemId    string                 `protobuf:"bytes,2,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"`
+	OptionGroups  []*OptionGroup         `protobuf:"bytes,3,rep,name=option_groups,json=optionGroups,proto3" json:"option_groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMenuItemOptionsRequest) Reset() {
+	*x = SetMenuItemOptionsRequest{}
+	mi := &file_restaurant_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMenuItemOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMenuItemOptionsRequest) ProtoMessage() {}
+
+func (x *SetMenuItemOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMenuItemOptionsRequest.ProtoReflect.Descriptor instead.
+func (*SetMenuItemOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetMenuItemOptionsRequest) GetActor() *Actor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *SetMenuItemOptionsRequest) GetMenuItemId() string {
+	if x != nil {
+		return x.MenuItemId
+	}
+	return ""
+}
+
+func (x *SetMenuItemOptionsRequest) GetOptionGroups() []*OptionGroup {
+	if x != nil {
+		return x.OptionGroups
+	}
+	return nil
+}
+
+type SetMenuItemOptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *MenuItem              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMenuItemOptionsResponse) Reset() {
+	*x = SetMenuItemOptionsResponse{}
+	mi := &file_restaurant_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMenuItemOptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMenuItemOptionsResponse) ProtoMessage() {}
+
+func (x *SetMenuItemOptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMenuItemOptionsResponse.ProtoReflect.Descriptor instead.
+func (*SetMenuItemOptionsResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetMenuItemOptionsResponse) GetItem() *MenuItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// TimeInterval - HH:MM in the restaurant's timezone; closes_at at or before opens_at runs past midnight
+type TimeInterval struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OpensAt       string                 `protobuf:"bytes,1,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`
+	ClosesAt      string                 `protobuf:"bytes,2,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeInterval) Reset() {
+	*x = TimeInterval{}
+	mi := &file_restaurant_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeInterval) ProtoMessage() {}
+
+func (x *TimeInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeInterval.ProtoReflect.Descriptor instead.
+func (*TimeInterval) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TimeInterval) GetOpensAt() string {
+	if x != nil {
+		return x.OpensAt
+	}
+	return ""
+}
+
+func (x *TimeInterval) GetClosesAt() string {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return ""
+}
+
+// WeeklyHours - weekday 0 is Sunday; a weekday without intervals is closed
+type WeeklyHours struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weekday       int32                  `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	Intervals     []*TimeInterval        `protobuf:"bytes,2,rep,name=intervals,proto3" json:"intervals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeeklyHours) Reset() {
+	*x = WeeklyHours{}
+	mi := &file_restaurant_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeeklyHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeeklyHours) ProtoMessage() {}
+
+func (x *WeeklyHours) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeeklyHours.ProtoReflect.Descriptor instead.
+func (*WeeklyHours) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WeeklyHours) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *WeeklyHours) GetIntervals() []*TimeInterval {
+	if x != nil {
+		return x.Intervals
+	}
+	return nil
+}
+
+// HoursException - Replaces the weekly hours on date (YYYY-MM-DD); no intervals closes the day
+type HoursException struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Intervals     []*TimeInterval        `protobuf:"bytes,2,rep,name=intervals,proto3" json:"intervals,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoursException) Reset() {
+	*x = HoursException{}
+	mi := &file_restaurant_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoursException) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoursException) ProtoMessage() {}
+
+func (x *HoursException) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoursException.ProtoReflect.Descriptor instead.
+func (*HoursException) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *HoursException) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *HoursException) GetIntervals() []*TimeInterval {
+	if x != nil {
+		return x.Intervals
+	}
+	return nil
+}
+
+func (x *HoursException) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// OpeningHours - Without weekly hours the restaurant opens at opening_time and closes at
+// closing_time every day
+type OpeningHours struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timezone      string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Weekly        []*WeeklyHours         `protobuf:"bytes,2,rep,name=weekly,proto3" json:"weekly,omitempty"`
+	Exceptions    []*HoursException      `protobuf:"bytes,3,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	IsPaused      bool                   `protobuf:"varint,4,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpeningHours) Reset() {
+	*x = OpeningHours{}
+	mi := &file_restaurant_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpeningHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpeningHours) ProtoMessage() {}
+
+func (x *OpeningHours) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpeningHours.ProtoReflect.Descriptor instead.
+func (*OpeningHours) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *OpeningHours) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *OpeningHours) GetWeekly() []*WeeklyHours {
+	if x != nil {
+		return x.Weekly
+	}
+	return nil
+}
+
+func (x *OpeningHours) GetExceptions() []*HoursException {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+func (x *OpeningHours) GetIsPaused() bool {
+	if x != nil {
+		return x.IsPaused
+	}
+	return false
+}
+
+// GetOpeningHours - Past exceptions are left out
+type GetOpeningHoursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RestaurantId  string                 `protobuf:"bytes,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpeningHoursRequest) Reset() {
+	*x = GetOpeningHoursRequest{}
+	mi := &file_restaurant_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpeningHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpeningHoursRequest) ProtoMessage() {}
+
+func (x *GetOpeningHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpeningHoursRequest.ProtoReflect.Descriptor instead.
+func (*GetOpeningHoursRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetOpeningHoursRequest) GetRestaurantId() string {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return ""
+}
+
+type GetOpeningHoursResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hours         *OpeningHours          `protobuf:"bytes,1,opt,name=hours,proto3" json:"hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpeningHoursResponse) Reset() {
+	*x = GetOpeningHoursResponse{}
+	mi := &file_restaurant_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpeningHoursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpeningHoursResponse) ProtoMessage() {}
+
+func (x *GetOpeningHoursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpeningHoursResponse.ProtoReflect.Descriptor instead.
+func (*GetOpeningHoursResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetOpeningHoursResponse) GetHours() *OpeningHours {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+// SetOpeningHours - Replaces the weekly hours and all exceptions
+type SetOpeningHoursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         *Actor                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	RestaurantId  string                 `protobuf:"bytes,2,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	Weekly        []*WeeklyHours         `protobuf:"bytes,3,rep,name=weekly,proto3" json:"weekly,omitempty"`
+	Exceptions    []*HoursException      `protobuf:"bytes,4,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOpeningHoursRequest) Reset() {
+	*x = SetOpeningHoursRequest{}
+	mi := &file_restaurant_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOpeningHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOpeningHoursRequest) ProtoMessage() {}
 
-func (x *GetMenuResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[7]
+func (x *SetOpeningHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,47 +3720,61 @@ func (x *GetMenuResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMenuResponse.ProtoReflect.Descriptor instead.
-func (*GetMenuResponse) Descriptor() ([]byte, []int) {
-	return file_restaurant_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SetOpeningHoursRequest.ProtoReflect.Descriptor instead.
+func (*SetOpeningHoursRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetMenuResponse) GetItems() []*MenuItem {
+func (x *SetOpeningHoursRequest) GetActor() *Actor {
 	if x != nil {
-		return x.Items
+		return x.Actor
 	}
 	return nil
 }
 
-func (x *GetMenuResponse) GetTotal() int32 {
+func (x *SetOpeningHoursRequest) GetRestaurantId() string {
 	if x != nil {
-		return x.Total
+		return x.RestaurantId
 	}
-	return 0
+	return ""
 }
 
-type GetMenuItemRequest struct {
+func (x *SetOpeningHoursRequest) GetWeekly() []*WeeklyHours {
+	if x != nil {
+		return x.Weekly
+	}
+	return nil
+}
+
+func (x *SetOpeningHoursRequest) GetExceptions() []*HoursException {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+type SetOpeningHoursResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hours         *OpeningHours          `protobuf:"bytes,1,opt,name=hours,proto3" json:"hours,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMenuItemRequest) Reset() {
-	*x = GetMenuItemRequest{}
-	mi := &file_restaurant_proto_msgTypes[8]
+func (x *SetOpeningHoursResponse) Reset() {
+	*x = SetOpeningHoursResponse{}
+	mi := &file_restaurant_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMenuItemRequest) String() string {
+func (x *SetOpeningHoursResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMenuItemRequest) ProtoMessage() {}
+func (*SetOpeningHoursResponse) ProtoMessage() {}
 
-func (x *GetMenuItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[8]
+func (x *SetOpeningHoursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -590,40 +3785,43 @@ func (x *GetMenuItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMenuItemRequest.ProtoReflect.Descriptor instead.
-func (*GetMenuItemRequest) Descriptor() ([]byte, []int) {
-	return file_restaurant_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SetOpeningHoursResponse.ProtoReflect.Descriptor instead.
+func (*SetOpeningHoursResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetMenuItemRequest) GetId() string {
+func (x *SetOpeningHoursResponse) GetHours() *OpeningHours {
 	if x != nil {
-		return x.Id
+		return x.Hours
 	}
-	return ""
+	return nil
 }
 
-type GetMenuItemResponse struct {
+// SetRestaurantPaused - Stops taking orders until unpaused, whatever the hours say
+type SetRestaurantPausedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *MenuItem              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Actor         *Actor                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	RestaurantId  string                 `protobuf:"bytes,2,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	Paused        bool                   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMenuItemResponse) Reset() {
-	*x = GetMenuItemResponse{}
-	mi := &file_restaurant_proto_msgTypes[9]
+func (x *SetRestaurantPausedRequest) Reset() {
+	*x = SetRestaurantPausedRequest{}
+	mi := &file_restaurant_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMenuItemResponse) String() string {
+func (x *SetRestaurantPausedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMenuItemResponse) ProtoMessage() {}
+func (*SetRestaurantPausedRequest) ProtoMessage() {}
 
-func (x *GetMenuItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[9]
+func (x *SetRestaurantPausedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -634,41 +3832,54 @@ func (x *GetMenuItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMenuItemResponse.ProtoReflect.Descriptor instead.
-func (*GetMenuItemResponse) Descriptor() ([]byte, []int) {
-	return file_restaurant_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SetRestaurantPausedRequest.ProtoReflect.Descriptor instead.
+func (*SetRestaurantPausedRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetMenuItemResponse) GetItem() *MenuItem {
+func (x *SetRestaurantPausedRequest) GetActor() *Actor {
 	if x != nil {
-		return x.Item
+		return x.Actor
 	}
 	return nil
 }
 
-// GetRestaurantStatus - Check if restaurant is open and accepting orders
-type GetRestaurantStatusRequest struct {
+func (x *SetRestaurantPausedRequest) GetRestaurantId() string {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return ""
+}
+
+func (x *SetRestaurantPausedRequest) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type SetRestaurantPausedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RestaurantId  string                 `protobuf:"bytes,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	Restaurant    *Restaurant            `protobuf:"bytes,1,opt,name=restaurant,proto3" json:"restaurant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRestaurantStatusRequest) Reset() {
-	*x = GetRestaurantStatusRequest{}
-	mi := &file_restaurant_proto_msgTypes[10]
+func (x *SetRestaurantPausedResponse) Reset() {
+	*x = SetRestaurantPausedResponse{}
+	mi := &file_restaurant_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRestaurantStatusRequest) String() string {
+func (x *SetRestaurantPausedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRestaurantStatusRequest) ProtoMessage() {}
+func (*SetRestaurantPausedResponse) ProtoMessage() {}
 
-func (x *GetRestaurantStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[10]
+func (x *SetRestaurantPausedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,42 +3890,44 @@ func (x *GetRestaurantStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRestaurantStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetRestaurantStatusRequest) Descriptor() ([]byte, []int) {
-	return file_restaurant_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use SetRestaurantPausedResponse.ProtoReflect.Descriptor instead.
+func (*SetRestaurantPausedResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetRestaurantStatusRequest) GetRestaurantId() string {
+func (x *SetRestaurantPausedResponse) GetRestaurant() *Restaurant {
 	if x != nil {
-		return x.RestaurantId
+		return x.Restaurant
 	}
-	return ""
+	return nil
 }
 
-type GetRestaurantStatusResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	IsAcceptingOrders bool                   `protobuf:"varint,2,opt,name=is_accepting_orders,json=isAcceptingOrders,proto3" json:"is_accepting_orders,omitempty"`
-	OpeningTime       string                 `protobuf:"bytes,3,opt,name=opening_time,json=openingTime,proto3" json:"opening_time,omitempty"`
-	ClosingTime       string                 `protobuf:"bytes,4,opt,name=closing_time,json=closingTime,proto3" json:"closing_time,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// ReorderMenuCategories - Listed categories come first in the given order, the rest keep
+// their order after them
+type ReorderMenuCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         *Actor                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	RestaurantId  string                 `protobuf:"bytes,2,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	CategoryIds   []string               `protobuf:"bytes,3,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRestaurantStatusResponse) Reset() {
-	*x = GetRestaurantStatusResponse{}
-	mi := &file_restaurant_proto_msgTypes[11]
+func (x *ReorderMenuCategoriesRequest) Reset() {
+	*x = ReorderMenuCategoriesRequest{}
+	mi := &file_restaurant_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRestaurantStatusResponse) String() string {
+func (x *ReorderMenuCategoriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRestaurantStatusResponse) ProtoMessage() {}
+func (*ReorderMenuCategoriesRequest) ProtoMessage() {}
 
-func (x *GetRestaurantStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[11]
+func (x *ReorderMenuCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,56 +3938,54 @@ func (x *GetRestaurantStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRestaurantStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetRestaurantStatusResponse) Descriptor() ([]byte, []int) {
-	return file_restaurant_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ReorderMenuCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderMenuCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetRestaurantStatusResponse) GetIsAcceptingOrders() bool {
+func (x *ReorderMenuCategoriesRequest) GetActor() *Actor {
 	if x != nil {
-		return x.IsAcceptingOrders
+		return x.Actor
 	}
-	return false
+	return nil
 }
 
-func (x *GetRestaurantStatusResponse) GetOpeningTime() string {
+func (x *ReorderMenuCategoriesRequest) GetRestaurantId() string {
 	if x != nil {
-		return x.OpeningTime
+		return x.RestaurantId
 	}
 	return ""
 }
 
-func (x *GetRestaurantStatusResponse) GetClosingTime() string {
+func (x *ReorderMenuCategoriesRequest) GetCategoryIds() []string {
 	if x != nil {
-		return x.ClosingTime
+		return x.CategoryIds
 	}
-	return ""
+	return nil
 }
 
-// ValidateMenuItems - Validate multiple items are available (for order validation)
-type ValidateMenuItemsRequest struct {
+type ReorderMenuCategoriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RestaurantId  string                 `protobuf:"bytes,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
-	ItemIds       []string               `protobuf:"bytes,2,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	Categories    []*MenuCategory        `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateMenuItemsRequest) Reset() {
-	*x = ValidateMenuItemsRequest{}
-	mi := &file_restaurant_proto_msgTypes[12]
+func (x *ReorderMenuCategoriesResponse) Reset() {
+	*x = ReorderMenuCategoriesResponse{}
+	mi := &file_restaurant_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateMenuItemsRequest) String() string {
+func (x *ReorderMenuCategoriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateMenuItemsRequest) ProtoMessage() {}
+func (*ReorderMenuCategoriesResponse) ProtoMessage() {}
 
-func (x *ValidateMenuItemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[12]
+func (x *ReorderMenuCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -785,49 +3996,45 @@ func (x *ValidateMenuItemsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateMenuItemsRequest.ProtoReflect.Descriptor instead.
-func (*ValidateMenuItemsRequest) Descriptor() ([]byte, []int) {
-	return file_restaurant_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *ValidateMenuItemsRequest) GetRestaurantId() string {
-	if x != nil {
-		return x.RestaurantId
-	}
-	return ""
+// Deprecated: Use ReorderMenuCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderMenuCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ValidateMenuItemsRequest) GetItemIds() []string {
+func (x *ReorderMenuCategoriesResponse) GetCategories() []*MenuCategory {
 	if x != nil {
-		return x.ItemIds
+		return x.Categories
 	}
 	return nil
 }
 
-type MenuItemValidation struct {
+// ReorderMenuItems - Orders the items of one category like ReorderMenuCategories; an
+// empty category_id orders the items without a category
+type ReorderMenuItemsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	IsAvailable   bool                   `protobuf:"varint,2,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Actor         *Actor                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	RestaurantId  string                 `protobuf:"bytes,2,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	ItemIds       []string               `protobuf:"bytes,4,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MenuItemValidation) Reset() {
-	*x = MenuItemValidation{}
-	mi := &file_restaurant_proto_msgTypes[13]
+func (x *ReorderMenuItemsRequest) Reset() {
+	*x = ReorderMenuItemsRequest{}
+	mi := &file_restaurant_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MenuItemValidation) String() string {
+func (x *ReorderMenuItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MenuItemValidation) ProtoMessage() {}
+func (*ReorderMenuItemsRequest) ProtoMessage() {}
 
-func (x *MenuItemValidation) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[13]
+func (x *ReorderMenuItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -838,56 +4045,61 @@ func (x *MenuItemValidation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MenuItemValidation.ProtoReflect.Descriptor instead.
-func (*MenuItemValidation) Descriptor() ([]byte, []int) {
-	return file_restaurant_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ReorderMenuItemsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderMenuItemsRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *MenuItemValidation) GetItemId() string {
+func (x *ReorderMenuItemsRequest) GetActor() *Actor {
 	if x != nil {
-		return x.ItemId
+		return x.Actor
 	}
-	return ""
+	return nil
 }
 
-func (x *MenuItemValidation) GetIsAvailable() bool {
+func (x *ReorderMenuItemsRequest) GetRestaurantId() string {
 	if x != nil {
-		return x.IsAvailable
+		return x.RestaurantId
 	}
-	return false
+	return ""
 }
 
-func (x *MenuItemValidation) GetName() string {
+func (x *ReorderMenuItemsRequest) GetCategoryId() string {
 	if x != nil {
-		return x.Name
+		return x.CategoryId
 	}
 	return ""
 }
 
-type ValidateMenuItemsResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	AllAvailable     bool                   `protobuf:"varint,1,opt,name=all_available,json=allAvailable,proto3" json:"all_available,omitempty"`
-	Items            []*MenuItemValidation  `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	UnavailableItems []string               `protobuf:"bytes,3,rep,name=unavailable_items,json=unavailableItems,proto3" json:"unavailable_items,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *ReorderMenuItemsRequest) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
 }
 
-func (x *ValidateMenuItemsResponse) Reset() {
-	*x = ValidateMenuItemsResponse{}
-	mi := &file_restaurant_proto_msgTypes[14]
+type ReorderMenuItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MenuItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderMenuItemsResponse) Reset() {
+	*x = ReorderMenuItemsResponse{}
+	mi := &file_restaurant_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateMenuItemsResponse) String() string {
+func (x *ReorderMenuItemsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateMenuItemsResponse) ProtoMessage() {}
+func (*ReorderMenuItemsResponse) ProtoMessage() {}
 
-func (x *ValidateMenuItemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_restaurant_proto_msgTypes[14]
+func (x *ReorderMenuItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -898,38 +4110,24 @@ func (x *ValidateMenuItemsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateMenuItemsResponse.ProtoReflect.Descriptor instead.
-func (*ValidateMenuItemsResponse) Descriptor() ([]byte, []int) {
-	return file_restaurant_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *ValidateMenuItemsResponse) GetAllAvailable() bool {
-	if x != nil {
-		return x.AllAvailable
-	}
-	return false
+// Deprecated: Use ReorderMenuItemsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderMenuItemsResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ValidateMenuItemsResponse) GetItems() []*MenuItemValidation {
+func (x *ReorderMenuItemsResponse) GetItems() []*MenuItem {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-func (x *ValidateMenuItemsResponse) GetUnavailableItems() []string {
-	if x != nil {
-		return x.UnavailableItems
-	}
-	return nil
-}
-
 var File_restaurant_proto protoreflect.FileDescriptor
 
 const file_restaurant_proto_rawDesc = "" +
 	"\n" +
 	"\x10restaurant.proto\x12\n" +
-	"restaurant\"\xdb\x02\n" +
+	"restaurant\"\xc8\x04\n" +
 	"\n" +
 	"Restaurant\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -946,7 +4144,17 @@ const file_restaurant_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\tR\tupdatedAt\"\xa5\x02\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\x12,\n" +
+	"\x12delivery_radius_km\x18\r \x01(\x01R\x10deliveryRadiusKm\x12?\n" +
+	"\x10delivery_polygon\x18\x0e \x03(\v2\x14.restaurant.GeoPointR\x0fdeliveryPolygon\x12\x1f\n" +
+	"\vdistance_km\x18\x0f \x01(\x01R\n" +
+	"distanceKm\x12\"\n" +
+	"\rowner_user_id\x18\x10 \x01(\tR\vownerUserId\x12\x1a\n" +
+	"\btimezone\x18\x11 \x01(\tR\btimezone\x12\x1b\n" +
+	"\tis_paused\x18\x12 \x01(\bR\bisPaused\"D\n" +
+	"\bGeoPoint\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"\xc3\x03\n" +
 	"\bMenuItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
 	"\rrestaurant_id\x18\x02 \x01(\tR\frestaurantId\x12\x12\n" +
@@ -960,52 +4168,351 @@ const file_restaurant_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\tR\tupdatedAt\"+\n" +
-	"\x15GetRestaurantsRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\"h\n" +
+	" \x01(\tR\tupdatedAt\x12!\n" +
+	"\fexternal_sku\x18\v \x01(\tR\vexternalSku\x12<\n" +
+	"\roption_groups\x18\f \x03(\v2\x17.restaurant.OptionGroupR\foptionGroups\x12\x1f\n" +
+	"\vcategory_id\x18\r \x01(\tR\n" +
+	"categoryId\x12\x1a\n" +
+	"\bposition\x18\x0e \x01(\x05R\bposition\"\xa1\x01\n" +
+	"\vOptionGroup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"min_select\x18\x03 \x01(\x05R\tminSelect\x12\x1d\n" +
+	"\n" +
+	"max_select\x18\x04 \x01(\x05R\tmaxSelect\x120\n" +
+	"\aoptions\x18\x05 \x03(\v2\x16.restaurant.MenuOptionR\aoptions\"t\n" +
+	"\n" +
+	"MenuOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vprice_delta\x18\x03 \x01(\x01R\n" +
+	"priceDelta\x12!\n" +
+	"\fis_available\x18\x04 \x01(\bR\visAvailable\"\xe2\x01\n" +
+	"\x15GetRestaurantsRequest\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\tR\taddressId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12,\n" +
+	"\x06origin\x18\x04 \x01(\v2\x14.restaurant.GeoPointR\x06origin\x12\x1b\n" +
+	"\tradius_km\x18\x05 \x01(\x01R\bradiusKm\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageTokenJ\x04\b\x01\x10\x02R\x04page\"\x87\x01\n" +
 	"\x16GetRestaurantsResponse\x128\n" +
-	"\vrestaurants\x18\x01 \x03(\v2\x16.restaurant.RestaurantR\vrestaurants\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"&\n" +
+	"\vrestaurants\x18\x01 \x03(\v2\x16.restaurant.RestaurantR\vrestaurants\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenJ\x04\b\x02\x10\x03R\x05total\"&\n" +
 	"\x14GetRestaurantRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"O\n" +
 	"\x15GetRestaurantResponse\x126\n" +
 	"\n" +
 	"restaurant\x18\x01 \x01(\v2\x16.restaurant.RestaurantR\n" +
-	"restaurant\"I\n" +
+	"restaurant\"\x97\x01\n" +
 	"\x0eGetMenuRequest\x12#\n" +
-	"\rrestaurant_id\x18\x01 \x01(\tR\frestaurantId\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\"S\n" +
+	"\rrestaurant_id\x18\x01 \x01(\tR\frestaurantId\x12\x18\n" +
+	"\agrouped\x18\x03 \x01(\bR\agrouped\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageTokenJ\x04\b\x02\x10\x03R\x04page\"\xac\x01\n" +
 	"\x0fGetMenuResponse\x12*\n" +
-	"\x05items\x18\x01 \x03(\v2\x14.restaurant.MenuItemR\x05items\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"$\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.restaurant.MenuItemR\x05items\x128\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\v2\x18.restaurant.MenuCategoryR\n" +
+	"categories\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageTokenJ\x04\b\x02\x10\x03R\x05total\"z\n" +
+	"\fMenuCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12*\n" +
+	"\x05items\x18\x04 \x03(\v2\x14.restaurant.MenuItemR\x05items\"$\n" +
 	"\x12GetMenuItemRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
 	"\x13GetMenuItemResponse\x12(\n" +
 	"\x04item\x18\x01 \x01(\v2\x14.restaurant.MenuItemR\x04item\"A\n" +
 	"\x1aGetRestaurantStatusRequest\x12#\n" +
-	"\rrestaurant_id\x18\x01 \x01(\tR\frestaurantId\"\x93\x01\n" +
+	"\rrestaurant_id\x18\x01 \x01(\tR\frestaurantId\"\xab\x02\n" +
 	"\x1bGetRestaurantStatusResponse\x12.\n" +
 	"\x13is_accepting_orders\x18\x02 \x01(\bR\x11isAcceptingOrders\x12!\n" +
 	"\fopening_time\x18\x03 \x01(\tR\vopeningTime\x12!\n" +
-	"\fclosing_time\x18\x04 \x01(\tR\vclosingTime\"Z\n" +
+	"\fclosing_time\x18\x04 \x01(\tR\vclosingTime\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x12\x1b\n" +
+	"\tis_paused\x18\x06 \x01(\bR\bisPaused\x12\x17\n" +
+	"\ais_open\x18\a \x01(\bR\x06isOpen\x12 \n" +
+	"\fnext_open_at\x18\b \x01(\tR\n" +
+	"nextOpenAt\x12\"\n" +
+	"\rnext_close_at\x18\t \x01(\tR\vnextCloseAt\"\x8b\x01\n" +
 	"\x18ValidateMenuItemsRequest\x12#\n" +
 	"\rrestaurant_id\x18\x01 \x01(\tR\frestaurantId\x12\x19\n" +
-	"\bitem_ids\x18\x02 \x03(\tR\aitemIds\"d\n" +
+	"\bitem_ids\x18\x02 \x03(\tR\aitemIds\x12/\n" +
+	"\x05lines\x18\x03 \x03(\v2\x19.restaurant.ItemSelectionR\x05lines\"l\n" +
+	"\rItemSelection\x12 \n" +
+	"\fmenu_item_id\x18\x01 \x01(\tR\n" +
+	"menuItemId\x12\x1d\n" +
+	"\n" +
+	"option_ids\x18\x02 \x03(\tR\toptionIds\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"t\n" +
+	"\x0eSelectedOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x02 \x01(\tR\tgroupName\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
+	"\vprice_delta\x18\x04 \x01(\x01R\n" +
+	"priceDelta\"\x84\x02\n" +
+	"\x0eLineValidation\x12 \n" +
+	"\fmenu_item_id\x18\x01 \x01(\tR\n" +
+	"menuItemId\x12\x14\n" +
+	"\x05valid\x18\x02 \x01(\bR\x05valid\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\x01R\tunitPrice\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"line_price\x18\x06 \x01(\x01R\tlinePrice\x124\n" +
+	"\aoptions\x18\a \x03(\v2\x1a.restaurant.SelectedOptionR\aoptions\x12\x16\n" +
+	"\x06errors\x18\b \x03(\tR\x06errors\"z\n" +
 	"\x12MenuItemValidation\x12\x17\n" +
 	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12!\n" +
 	"\fis_available\x18\x02 \x01(\bR\visAvailable\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\"\xa3\x01\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\"\xf2\x01\n" +
 	"\x19ValidateMenuItemsResponse\x12#\n" +
 	"\rall_available\x18\x01 \x01(\bR\fallAvailable\x124\n" +
 	"\x05items\x18\x02 \x03(\v2\x1e.restaurant.MenuItemValidationR\x05items\x12+\n" +
-	"\x11unavailable_items\x18\x03 \x03(\tR\x10unavailableItems2\xa0\x04\n" +
+	"\x11unavailable_items\x18\x03 \x03(\tR\x10unavailableItems\x120\n" +
+	"\x05lines\x18\x04 \x03(\v2\x1a.restaurant.LineValidationR\x05lines\x12\x1b\n" +
+	"\tall_valid\x18\x05 \x01(\bR\ballValid\"x\n" +
+	"\x17CheckDeliverableRequest\x12#\n" +
+	"\rrestaurant_id\x18\x01 \x01(\tR\frestaurantId\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\"]\n" +
+	"\x18CheckDeliverableResponse\x12 \n" +
+	"\vdeliverable\x18\x01 \x01(\bR\vdeliverable\x12\x1f\n" +
+	"\vdistance_km\x18\x02 \x01(\x01R\n" +
+	"distanceKm\"m\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageTokenJ\x04\b\x02\x10\x03R\x04page\"\x86\x01\n" +
+	"\fSearchResult\x126\n" +
+	"\n" +
+	"restaurant\x18\x01 \x01(\v2\x16.restaurant.RestaurantR\n" +
+	"restaurant\x12*\n" +
+	"\x05items\x18\x02 \x03(\v2\x14.restaurant.MenuItemR\x05items\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x01R\x04rank\"y\n" +
+	"\x0eSearchResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.restaurant.SearchResultR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenJ\x04\b\x02\x10\x03R\x05total\"4\n" +
+	"\x05Actor\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"\xf2\x03\n" +
+	"\x17CreateRestaurantRequest\x12'\n" +
+	"\x05actor\x18\x01 \x01(\v2\x11.restaurant.ActorR\x05actor\x12\"\n" +
+	"\rowner_user_id\x18\x02 \x01(\tR\vownerUserId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05phone\x18\x06 \x01(\tR\x05phone\x12\x1a\n" +
+	"\blatitude\x18\a \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\b \x01(\x01R\tlongitude\x12\x19\n" +
+	"\blogo_url\x18\t \x01(\tR\alogoUrl\x12!\n" +
+	"\fopening_time\x18\n" +
+	" \x01(\tR\vopeningTime\x12!\n" +
+	"\fclosing_time\x18\v \x01(\tR\vclosingTime\x12,\n" +
+	"\x12delivery_radius_km\x18\f \x01(\x01R\x10deliveryRadiusKm\x12?\n" +
+	"\x10delivery_polygon\x18\r \x03(\v2\x14.restaurant.GeoPointR\x0fdeliveryPolygon\x12\x1a\n" +
+	"\btimezone\x18\x0e \x01(\tR\btimezone\"R\n" +
+	"\x18CreateRestaurantResponse\x126\n" +
+	"\n" +
+	"restaurant\x18\x01 \x01(\v2\x16.restaurant.RestaurantR\n" +
+	"restaurant\"E\n" +
+	"\x15DeliveryPolygonUpdate\x12,\n" +
+	"\x06points\x18\x01 \x03(\v2\x14.restaurant.GeoPointR\x06points\"\xbf\x05\n" +
+	"\x17UpdateRestaurantRequest\x12'\n" +
+	"\x05actor\x18\x01 \x01(\v2\x11.restaurant.ActorR\x05actor\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1d\n" +
+	"\aaddress\x18\x05 \x01(\tH\x02R\aaddress\x88\x01\x01\x12\x19\n" +
+	"\x05phone\x18\x06 \x01(\tH\x03R\x05phone\x88\x01\x01\x12\x1f\n" +
+	"\blatitude\x18\a \x01(\x01H\x04R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\b \x01(\x01H\x05R\tlongitude\x88\x01\x01\x12\x1e\n" +
+	"\blogo_url\x18\t \x01(\tH\x06R\alogoUrl\x88\x01\x01\x12&\n" +
+	"\fopening_time\x18\n" +
+	" \x01(\tH\aR\vopeningTime\x88\x01\x01\x12&\n" +
+	"\fclosing_time\x18\v \x01(\tH\bR\vclosingTime\x88\x01\x01\x121\n" +
+	"\x12delivery_radius_km\x18\f \x01(\x01H\tR\x10deliveryRadiusKm\x88\x01\x01\x12L\n" +
+	"\x10delivery_polygon\x18\r \x01(\v2!.restaurant.DeliveryPolygonUpdateR\x0fdeliveryPolygon\x12\x1f\n" +
+	"\btimezone\x18\x0e \x01(\tH\n" +
+	"R\btimezone\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\n" +
+	"\n" +
+	"\b_addressB\b\n" +
+	"\x06_phoneB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitudeB\v\n" +
+	"\t_logo_urlB\x0f\n" +
+	"\r_opening_timeB\x0f\n" +
+	"\r_closing_timeB\x15\n" +
+	"\x13_delivery_radius_kmB\v\n" +
+	"\t_timezone\"R\n" +
+	"\x18UpdateRestaurantResponse\x126\n" +
+	"\n" +
+	"restaurant\x18\x01 \x01(\v2\x16.restaurant.RestaurantR\n" +
+	"restaurant\"\xc6\x02\n" +
+	"\x15CreateMenuItemRequest\x12'\n" +
+	"\x05actor\x18\x01 \x01(\v2\x11.restaurant.ActorR\x05actor\x12#\n" +
+	"\rrestaurant_id\x18\x02 \x01(\tR\frestaurantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1b\n" +
+	"\timage_url\x18\x06 \x01(\tR\bimageUrl\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\x12&\n" +
+	"\fis_available\x18\b \x01(\bH\x00R\visAvailable\x88\x01\x01\x12!\n" +
+	"\fexternal_sku\x18\t \x01(\tR\vexternalSkuB\x0f\n" +
+	"\r_is_available\"B\n" +
+	"\x16CreateMenuItemResponse\x12(\n" +
+	"\x04item\x18\x01 \x01(\v2\x14.restaurant.MenuItemR\x04item\"\x9e\x03\n" +
+	"\x15UpdateMenuItemRequest\x12'\n" +
+	"\x05actor\x18\x01 \x01(\v2\x11.restaurant.ActorR\x05actor\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x19\n" +
+	"\x05price\x18\x05 \x01(\x01H\x02R\x05price\x88\x01\x01\x12 \n" +
+	"\timage_url\x18\x06 \x01(\tH\x03R\bimageUrl\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\a \x01(\tH\x04R\bcategory\x88\x01\x01\x12&\n" +
+	"\fis_available\x18\b \x01(\bH\x05R\visAvailable\x88\x01\x01\x12&\n" +
+	"\fexternal_sku\x18\t \x01(\tH\x06R\vexternalSku\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\b\n" +
+	"\x06_priceB\f\n" +
+	"\n" +
+	"_image_urlB\v\n" +
+	"\t_categoryB\x0f\n" +
+	"\r_is_availableB\x0f\n" +
+	"\r_external_sku\"B\n" +
+	"\x16UpdateMenuItemResponse\x12(\n" +
+	"\x04item\x18\x01 \x01(\v2\x14.restaurant.MenuItemR\x04item\"P\n" +
+	"\x15DeleteMenuItemRequest\x12'\n" +
+	"\x05actor\x18\x01 \x01(\v2\x11.restaurant.ActorR\x05actor\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x18\n" +
+	"\x16DeleteMenuItemResponse\"x\n" +
+	"\x1aSetItemAvailabilityRequest\x12'\n" +
+	"\x05actor\x18\x01 \x01(\v2\x11.restaurant.ActorR\x05actor\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12!\n" +
+	"\fis_available\x18\x03 \x01(\bR\visAvailable\"G\n" +
+	"\x1bSetItemAvailabilityResponse\x12(\n" +
+	"\x04item\x18\x01 \x01(\v2\x14.restaurant.MenuItemR\x04item\"\xa6\x01\n" +
+	"\x11ImportMenuRequest\x12'\n" +
+	"\x05actor\x18\x01 \x01(\v2\x11.restaurant.ActorR\x05actor\x12#\n" +
+	"\rrestaurant_id\x18\x02 \x01(\tR\frestaurantId\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"R\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x96\x01\n" +
+	"\x12ImportMenuResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x05R\aupdated\x122\n" +
+	"\x06errors\x18\x03 \x03(\v2\x1a.restaurant.ImportRowErrorR\x06errors\x12\x18\n" +
+	"\aapplied\x18\x04 \x01(\bR\aapplied\"y\n" +
+	"\x11ExportMenuRequest\x12'\n" +
+	"\x05actor\x18\x01 \x01(\v2\x11.restaurant.ActorR\x05actor\x12#\n" +
+	"\rrestaurant_id\x18\x02 \x01(\tR\frestaurantId\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\"g\n" +
+	"\x12ExportMenuResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"\xa4\x01\n" +
+	"\x19SetMenuItemOptionsRequest\x12'\n" +
+	"\x05actor\x18\x01 \x01(\v2\x11.restaurant.ActorR\x05actor\x12 \n" +
+	"\fmenu_item_id\x18\x02 \x01(\tR\n" +
+	"menuItemId\x12<\n" +
+	"\roption_groups\x18\x03 \x03(\v2\x17.restaurant.OptionGroupR\foptionGroups\"F\n" +
+	"\x1aSetMenuItemOptionsResponse\x12(\n" +
+	"\x04item\x18\x01 \x01(\v2\x14.restaurant.MenuItemR\x04item\"F\n" +
+	"\fTimeInterval\x12\x19\n" +
+	"\bopens_at\x18\x01 \x01(\tR\aopensAt\x12\x1b\n" +
+	"\tcloses_at\x18\x02 \x01(\tR\bclosesAt\"_\n" +
+	"\vWeeklyHours\x12\x18\n" +
+	"\aweekday\x18\x01 \x01(\x05R\aweekday\x126\n" +
+	"\tintervals\x18\x02 \x03(\v2\x18.restaurant.TimeIntervalR\tintervals\"p\n" +
+	"\x0eHoursException\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x126\n" +
+	"\tintervals\x18\x02 \x03(\v2\x18.restaurant.TimeIntervalR\tintervals\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\xb4\x01\n" +
+	"\fOpeningHours\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x12/\n" +
+	"\x06weekly\x18\x02 \x03(\v2\x17.restaurant.WeeklyHoursR\x06weekly\x12:\n" +
+	"\n" +
+	"exceptions\x18\x03 \x03(\v2\x1a.restaurant.HoursExceptionR\n" +
+	"exceptions\x12\x1b\n" +
+	"\tis_paused\x18\x04 \x01(\bR\bisPaused\"=\n" +
+	"\x16GetOpeningHoursRequest\x12#\n" +
+	"\rrestaurant_id\x18\x01 \x01(\tR\frestaurantId\"I\n" +
+	"\x17GetOpeningHoursResponse\x12.\n" +
+	"\x05hours\x18\x01 \x01(\v2\x18.restaurant.OpeningHoursR\x05hours\"\xd3\x01\n" +
+	"\x16SetOpeningHoursRequest\x12'\n" +
+	"\x05actor\x18\x01 \x01(\v2\x11.restaurant.ActorR\x05actor\x12#\n" +
+	"\rrestaurant_id\x18\x02 \x01(\tR\frestaurantId\x12/\n" +
+	"\x06weekly\x18\x03 \x03(\v2\x17.restaurant.WeeklyHoursR\x06weekly\x12:\n" +
+	"\n" +
+	"exceptions\x18\x04 \x03(\v2\x1a.restaurant.HoursExceptionR\n" +
+	"exceptions\"I\n" +
+	"\x17SetOpeningHoursResponse\x12.\n" +
+	"\x05hours\x18\x01 \x01(\v2\x18.restaurant.OpeningHoursR\x05hours\"\x82\x01\n" +
+	"\x1aSetRestaurantPausedRequest\x12'\n" +
+	"\x05actor\x18\x01 \x01(\v2\x11.restaurant.ActorR\x05actor\x12#\n" +
+	"\rrestaurant_id\x18\x02 \x01(\tR\frestaurantId\x12\x16\n" +
+	"\x06paused\x18\x03 \x01(\bR\x06paused\"U\n" +
+	"\x1bSetRestaurantPausedResponse\x126\n" +
+	"\n" +
+	"restaurant\x18\x01 \x01(\v2\x16.restaurant.RestaurantR\n" +
+	"restaurant\"\x8f\x01\n" +
+	"\x1cReorderMenuCategoriesRequest\x12'\n" +
+	"\x05actor\x18\x01 \x01(\v2\x11.restaurant.ActorR\x05actor\x12#\n" +
+	"\rrestaurant_id\x18\x02 \x01(\tR\frestaurantId\x12!\n" +
+	"\fcategory_ids\x18\x03 \x03(\tR\vcategoryIds\"Y\n" +
+	"\x1dReorderMenuCategoriesResponse\x128\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x18.restaurant.MenuCategoryR\n" +
+	"categories\"\xa3\x01\n" +
+	"\x17ReorderMenuItemsRequest\x12'\n" +
+	"\x05actor\x18\x01 \x01(\v2\x11.restaurant.ActorR\x05actor\x12#\n" +
+	"\rrestaurant_id\x18\x02 \x01(\tR\frestaurantId\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\tR\n" +
+	"categoryId\x12\x19\n" +
+	"\bitem_ids\x18\x04 \x03(\tR\aitemIds\"F\n" +
+	"\x18ReorderMenuItemsResponse\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.restaurant.MenuItemR\x05items2\xdd\x0f\n" +
 	"\x11RestaurantService\x12W\n" +
 	"\x0eGetRestaurants\x12!.restaurant.GetRestaurantsRequest\x1a\".restaurant.GetRestaurantsResponse\x12T\n" +
 	"\rGetRestaurant\x12 .restaurant.GetRestaurantRequest\x1a!.restaurant.GetRestaurantResponse\x12B\n" +
 	"\aGetMenu\x12\x1a.restaurant.GetMenuRequest\x1a\x1b.restaurant.GetMenuResponse\x12N\n" +
 	"\vGetMenuItem\x12\x1e.restaurant.GetMenuItemRequest\x1a\x1f.restaurant.GetMenuItemResponse\x12f\n" +
 	"\x13GetRestaurantStatus\x12&.restaurant.GetRestaurantStatusRequest\x1a'.restaurant.GetRestaurantStatusResponse\x12`\n" +
-	"\x11ValidateMenuItems\x12$.restaurant.ValidateMenuItemsRequest\x1a%.restaurant.ValidateMenuItemsResponseB\x06Z\x04./pbb\x06proto3"
+	"\x11ValidateMenuItems\x12$.restaurant.ValidateMenuItemsRequest\x1a%.restaurant.ValidateMenuItemsResponse\x12]\n" +
+	"\x10CheckDeliverable\x12#.restaurant.CheckDeliverableRequest\x1a$.restaurant.CheckDeliverableResponse\x12?\n" +
+	"\x06Search\x12\x19.restaurant.SearchRequest\x1a\x1a.restaurant.SearchResponse\x12]\n" +
+	"\x10CreateRestaurant\x12#.restaurant.CreateRestaurantRequest\x1a$.restaurant.CreateRestaurantResponse\x12]\n" +
+	"\x10UpdateRestaurant\x12#.restaurant.UpdateRestaurantRequest\x1a$.restaurant.UpdateRestaurantResponse\x12W\n" +
+	"\x0eCreateMenuItem\x12!.restaurant.CreateMenuItemRequest\x1a\".restaurant.CreateMenuItemResponse\x12W\n" +
+	"\x0eUpdateMenuItem\x12!.restaurant.UpdateMenuItemRequest\x1a\".restaurant.UpdateMenuItemResponse\x12W\n" +
+	"\x0eDeleteMenuItem\x12!.restaurant.DeleteMenuItemRequest\x1a\".restaurant.DeleteMenuItemResponse\x12f\n" +
+	"\x13SetItemAvailability\x12&.restaurant.SetItemAvailabilityRequest\x1a'.restaurant.SetItemAvailabilityResponse\x12K\n" +
+	"\n" +
+	"ImportMenu\x12\x1d.restaurant.ImportMenuRequest\x1a\x1e.restaurant.ImportMenuResponse\x12K\n" +
+	"\n" +
+	"ExportMenu\x12\x1d.restaurant.ExportMenuRequest\x1a\x1e.restaurant.ExportMenuResponse\x12c\n" +
+	"\x12SetMenuItemOptions\x12%.restaurant.SetMenuItemOptionsRequest\x1a&.restaurant.SetMenuItemOptionsResponse\x12Z\n" +
+	"\x0fGetOpeningHours\x12\".restaurant.GetOpeningHoursRequest\x1a#.restaurant.GetOpeningHoursResponse\x12Z\n" +
+	"\x0fSetOpeningHours\x12\".restaurant.SetOpeningHoursRequest\x1a#.restaurant.SetOpeningHoursResponse\x12f\n" +
+	"\x13SetRestaurantPaused\x12&.restaurant.SetRestaurantPausedRequest\x1a'.restaurant.SetRestaurantPausedResponse\x12l\n" +
+	"\x15ReorderMenuCategories\x12(.restaurant.ReorderMenuCategoriesRequest\x1a).restaurant.ReorderMenuCategoriesResponse\x12]\n" +
+	"\x10ReorderMenuItems\x12#.restaurant.ReorderMenuItemsRequest\x1a$.restaurant.ReorderMenuItemsResponseB\x06Z\x04./pbb\x06proto3"
 
 var (
 	file_restaurant_proto_rawDescOnce sync.Once
@@ -1019,47 +4526,173 @@ func file_restaurant_proto_rawDescGZIP() []byte {
 	return file_restaurant_proto_rawDescData
 }
 
-var file_restaurant_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_restaurant_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
 var file_restaurant_proto_goTypes = []any{
-	(*Restaurant)(nil),                  // 0: restaurant.Restaurant
-	(*MenuItem)(nil),                    // 1: restaurant.MenuItem
-	(*GetRestaurantsRequest)(nil),       // 2: restaurant.GetRestaurantsRequest
-	(*GetRestaurantsResponse)(nil),      // 3: restaurant.GetRestaurantsResponse
-	(*GetRestaurantRequest)(nil),        // 4: restaurant.GetRestaurantRequest
-	(*GetRestaurantResponse)(nil),       // 5: restaurant.GetRestaurantResponse
-	(*GetMenuRequest)(nil),              // 6: restaurant.GetMenuRequest
-	(*GetMenuResponse)(nil),             // 7: restaurant.GetMenuResponse
-	(*GetMenuItemRequest)(nil),          // 8: restaurant.GetMenuItemRequest
-	(*GetMenuItemResponse)(nil),         // 9: restaurant.GetMenuItemResponse
-	(*GetRestaurantStatusRequest)(nil),  // 10: restaurant.GetRestaurantStatusRequest
-	(*GetRestaurantStatusResponse)(nil), // 11: restaurant.GetRestaurantStatusResponse
-	(*ValidateMenuItemsRequest)(nil),    // 12: restaurant.ValidateMenuItemsRequest
-	(*MenuItemValidation)(nil),          // 13: restaurant.MenuItemValidation
-	(*ValidateMenuItemsResponse)(nil),   // 14: restaurant.ValidateMenuItemsResponse
+	(*Restaurant)(nil),                    // 0: restaurant.Restaurant
+	(*GeoPoint)(nil),                      // 1: restaurant.GeoPoint
+	(*MenuItem)(nil),                      // 2: restaurant.MenuItem
+	(*OptionGroup)(nil),                   // 3: restaurant.OptionGroup
+	(*MenuOption)(nil),                    // 4: restaurant.MenuOption
+	(*GetRestaurantsRequest)(nil),         // 5: restaurant.GetRestaurantsRequest
+	(*GetRestaurantsResponse)(nil),        // 6: restaurant.GetRestaurantsResponse
+	(*GetRestaurantRequest)(nil),          // 7: restaurant.GetRestaurantRequest
+	(*GetRestaurantResponse)(nil),         // 8: restaurant.GetRestaurantResponse
+	(*GetMenuRequest)(nil),                // 9: restaurant.GetMenuRequest
+	(*GetMenuResponse)(nil),               // 10: restaurant.GetMenuResponse
+	(*MenuCategory)(nil),                  // 11: restaurant.MenuCategory
+	(*GetMenuItemRequest)(nil),            // 12: restaurant.GetMenuItemRequest
+	(*GetMenuItemResponse)(nil),           // 13: restaurant.GetMenuItemResponse
+	(*GetRestaurantStatusRequest)(nil),    // 14: restaurant.GetRestaurantStatusRequest
+	(*GetRestaurantStatusResponse)(nil),   // 15: restaurant.GetRestaurantStatusResponse
+	(*ValidateMenuItemsRequest)(nil),      // 16: restaurant.ValidateMenuItemsRequest
+	(*ItemSelection)(nil),                 // 17: restaurant.ItemSelection
+	(*SelectedOption)(nil),                // 18: restaurant.SelectedOption
+	(*LineValidation)(nil),                // 19: restaurant.LineValidation
+	(*MenuItemValidation)(nil),            // 20: restaurant.MenuItemValidation
+	(*ValidateMenuItemsResponse)(nil),     // 21: restaurant.ValidateMenuItemsResponse
+	(*CheckDeliverableRequest)(nil),       // 22: restaurant.CheckDeliverableRequest
+	(*CheckDeliverableResponse)(nil),      // 23: restaurant.CheckDeliverableResponse
+	(*SearchRequest)(nil),                 // 24: restaurant.SearchRequest
+	(*SearchResult)(nil),                  // 25: restaurant.SearchResult
+	(*SearchResponse)(nil),                // 26: restaurant.SearchResponse
+	(*Actor)(nil),                         // 27: restaurant.Actor
+	(*CreateRestaurantRequest)(nil),       // 28: restaurant.CreateRestaurantRequest
+	(*CreateRestaurantResponse)(nil),      // 29: restaurant.CreateRestaurantResponse
+	(*DeliveryPolygonUpdate)(nil),         // 30: restaurant.DeliveryPolygonUpdate
+	(*UpdateRestaurantRequest)(nil),       // 31: restaurant.UpdateRestaurantRequest
+	(*UpdateRestaurantResponse)(nil),      // 32: restaurant.UpdateRestaurantResponse
+	(*CreateMenuItemRequest)(nil),         // 33: restaurant.CreateMenuItemRequest
+	(*CreateMenuItemResponse)(nil),        // 34: restaurant.CreateMenuItemResponse
+	(*UpdateMenuItemRequest)(nil),         // 35: restaurant.UpdateMenuItemRequest
+	(*UpdateMenuItemResponse)(nil),        // 36: restaurant.UpdateMenuItemResponse
+	(*DeleteMenuItemRequest)(nil),         // 37: restaurant.DeleteMenuItemRequest
+	(*DeleteMenuItemResponse)(nil),        // 38: restaurant.DeleteMenuItemResponse
+	(*SetItemAvailabilityRequest)(nil),    // 39: restaurant.SetItemAvailabilityRequest
+	(*SetItemAvailabilityResponse)(nil),   // 40: restaurant.SetItemAvailabilityResponse
+	(*ImportMenuRequest)(nil),             // 41: restaurant.ImportMenuRequest
+	(*ImportRowError)(nil),                // 42: restaurant.ImportRowError
+	(*ImportMenuResponse)(nil),            // 43: restaurant.ImportMenuResponse
+	(*ExportMenuRequest)(nil),             // 44: restaurant.ExportMenuRequest
+	(*ExportMenuResponse)(nil),            // 45: restaurant.ExportMenuResponse
+	(*SetMenuItemOptionsRequest)(nil),     // 46: restaurant.SetMenuItemOptionsRequest
+	(*SetMenuItemOptionsResponse)(nil),    // 47: restaurant.SetMenuItemOptionsResponse
+	(*TimeInterval)(nil),                  // 48: restaurant.TimeInterval
+	(*WeeklyHours)(nil),                   // 49: restaurant.WeeklyHours
+	(*HoursException)(nil),                // 50: restaurant.HoursException
+	(*OpeningHours)(nil),                  // 51: restaurant.OpeningHours
+	(*GetOpeningHoursRequest)(nil),        // 52: restaurant.GetOpeningHoursRequest
+	(*GetOpeningHoursResponse)(nil),       // 53: restaurant.GetOpeningHoursResponse
+	(*SetOpeningHoursRequest)(nil),        // 54: restaurant.SetOpeningHoursRequest
+	(*SetOpeningHoursResponse)(nil),       // 55: restaurant.SetOpeningHoursResponse
+	(*SetRestaurantPausedRequest)(nil),    // 56: restaurant.SetRestaurantPausedRequest
+	(*SetRestaurantPausedResponse)(nil),   // 57: restaurant.SetRestaurantPausedResponse
+	(*ReorderMenuCategoriesRequest)(nil),  // 58: restaurant.ReorderMenuCategoriesRequest
+	(*ReorderMenuCategoriesResponse)(nil), // 59: restaurant.ReorderMenuCategoriesResponse
+	(*ReorderMenuItemsRequest)(nil),       // 60: restaurant.ReorderMenuItemsRequest
+	(*ReorderMenuItemsResponse)(nil),      // 61: restaurant.ReorderMenuItemsResponse
 }
 var file_restaurant_proto_depIdxs = []int32{
-	0,  // 0: restaurant.GetRestaurantsResponse.restaurants:type_name -> restaurant.Restaurant
-	0,  // 1: restaurant.GetRestaurantResponse.restaurant:type_name -> restaurant.Restaurant
-	1,  // 2: restaurant.GetMenuResponse.items:type_name -> restaurant.MenuItem
-	1,  // 3: restaurant.GetMenuItemResponse.item:type_name -> restaurant.MenuItem
-	13, // 4: restaurant.ValidateMenuItemsResponse.items:type_name -> restaurant.MenuItemValidation
-	2,  // 5: restaurant.RestaurantService.GetRestaurants:input_type -> restaurant.GetRestaurantsRequest
-	4,  // 6: restaurant.RestaurantService.GetRestaurant:input_type -> restaurant.GetRestaurantRequest
-	6,  // 7: restaurant.RestaurantService.GetMenu:input_type -> restaurant.GetMenuRequest
-	8,  // 8: restaurant.RestaurantService.GetMenuItem:input_type -> restaurant.GetMenuItemRequest
-	10, // 9: restaurant.RestaurantService.GetRestaurantStatus:input_type -> restaurant.GetRestaurantStatusRequest
-	12, // 10: restaurant.RestaurantService.ValidateMenuItems:input_type -> restaurant.ValidateMenuItemsRequest
-	3,  // 11: restaurant.RestaurantService.GetRestaurants:output_type -> restaurant.GetRestaurantsResponse
-	5,  // 12: restaurant.RestaurantService.GetRestaurant:output_type -> restaurant.GetRestaurantResponse
-	7,  // 13: restaurant.RestaurantService.GetMenu:output_type -> restaurant.GetMenuResponse
-	9,  // 14: restaurant.RestaurantService.GetMenuItem:output_type -> restaurant.GetMenuItemResponse
-	11, // 15: restaurant.RestaurantService.GetRestaurantStatus:output_type -> restaurant.GetRestaurantStatusResponse
-	14, // 16: restaurant.RestaurantService.ValidateMenuItems:output_type -> restaurant.ValidateMenuItemsResponse
-	11, // [11:17] is the sub-list for method output_type
-	5,  // [5:11] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	1,  // 0: restaurant.Restaurant.delivery_polygon:type_name -> restaurant.GeoPoint
+	3,  // 1: restaurant.MenuItem.option_groups:type_name -> restaurant.OptionGroup
+	4,  // 2: restaurant.OptionGroup.options:type_name -> restaurant.MenuOption
+	1,  // 3: restaurant.GetRestaurantsRequest.origin:type_name -> restaurant.GeoPoint
+	0,  // 4: restaurant.GetRestaurantsResponse.restaurants:type_name -> restaurant.Restaurant
+	0,  // 5: restaurant.GetRestaurantResponse.restaurant:type_name -> restaurant.Restaurant
+	2,  // 6: restaurant.GetMenuResponse.items:type_name -> restaurant.MenuItem
+	11, // 7: restaurant.GetMenuResponse.categories:type_name -> restaurant.MenuCategory
+	2,  // 8: restaurant.MenuCategory.items:type_name -> restaurant.MenuItem
+	2,  // 9: restaurant.GetMenuItemResponse.item:type_name -> restaurant.MenuItem
+	17, // 10: restaurant.ValidateMenuItemsRequest.lines:type_name -> restaurant.ItemSelection
+	18, // 11: restaurant.LineValidation.options:type_name -> restaurant.SelectedOption
+	20, // 12: restaurant.ValidateMenuItemsResponse.items:type_name -> restaurant.MenuItemValidation
+	19, // 13: restaurant.ValidateMenuItemsResponse.lines:type_name -> restaurant.LineValidation
+	0,  // 14: restaurant.SearchResult.restaurant:type_name -> restaurant.Restaurant
+	2,  // 15: restaurant.SearchResult.items:type_name -> restaurant.MenuItem
+	25, // 16: restaurant.SearchResponse.results:type_name -> restaurant.SearchResult
+	27, // 17: restaurant.CreateRestaurantRequest.actor:type_name -> restaurant.Actor
+	1,  // 18: restaurant.CreateRestaurantRequest.delivery_polygon:type_name -> restaurant.GeoPoint
+	0,  // 19: restaurant.CreateRestaurantResponse.restaurant:type_name -> restaurant.Restaurant
+	1,  // 20: restaurant.DeliveryPolygonUpdate.points:type_name -> restaurant.GeoPoint
+	27, // 21: restaurant.UpdateRestaurantRequest.actor:type_name -> restaurant.Actor
+	30, // 22: restaurant.UpdateRestaurantRequest.delivery_polygon:type_name -> restaurant.DeliveryPolygonUpdate
+	0,  // 23: restaurant.UpdateRestaurantResponse.restaurant:type_name -> restaurant.Restaurant
+	27, // 24: restaurant.CreateMenuItemRequest.actor:type_name -> restaurant.Actor
+	2,  // 25: restaurant.CreateMenuItemResponse.item:type_name -> restaurant.MenuItem
+	27, // 26: restaurant.UpdateMenuItemRequest.actor:type_name -> restaurant.Actor
+	2,  // 27: restaurant.UpdateMenuItemResponse.item:type_name -> restaurant.MenuItem
+	27, // 28: restaurant.DeleteMenuItemRequest.actor:type_name -> restaurant.Actor
+	27, // 29: restaurant.SetItemAvailabilityRequest.actor:type_name -> restaurant.Actor
+	2,  // 30: restaurant.SetItemAvailabilityResponse.item:type_name -> restaurant.MenuItem
+	27, // 31: restaurant.ImportMenuRequest.actor:type_name -> restaurant.Actor
+	42, // 32: restaurant.ImportMenuResponse.errors:type_name -> restaurant.ImportRowError
+	27, // 33: restaurant.ExportMenuRequest.actor:type_name -> restaurant.Actor
+	27, // 34: restaurant.SetMenuItemOptionsRequest.actor:type_name -> restaurant.Actor
+	3,  // 35: restaurant.SetMenuItemOptionsRequest.option_groups:type_name -> restaurant.OptionGroup
+	2,  // 36: restaurant.SetMenuItemOptionsResponse.item:type_name -> restaurant.MenuItem
+	48, // 37: restaurant.WeeklyHours.intervals:type_name -> restaurant.TimeInterval
+	48, // 38: restaurant.HoursException.intervals:type_name -> restaurant.TimeInterval
+	49, // 39: restaurant.OpeningHours.weekly:type_name -> restaurant.WeeklyHours
+	50, // 40: restaurant.OpeningHours.exceptions:type_name -> restaurant.HoursException
+	51, // 41: restaurant.GetOpeningHoursResponse.hours:type_name -> restaurant.OpeningHours
+	27, // 42: restaurant.SetOpeningHoursRequest.actor:type_name -> restaurant.Actor
+	49, // 43: restaurant.SetOpeningHoursRequest.weekly:type_name -> restaurant.WeeklyHours
+	50, // 44: restaurant.SetOpeningHoursRequest.exceptions:type_name -> restaurant.HoursException
+	51, // 45: restaurant.SetOpeningHoursResponse.hours:type_name -> restaurant.OpeningHours
+	27, // 46: restaurant.SetRestaurantPausedRequest.actor:type_name -> restaurant.Actor
+	0,  // 47: restaurant.SetRestaurantPausedResponse.restaurant:type_name -> restaurant.Restaurant
+	27, // 48: restaurant.ReorderMenuCategoriesRequest.actor:type_name -> restaurant.Actor
+	11, // 49: restaurant.ReorderMenuCategoriesResponse.categories:type_name -> restaurant.MenuCategory
+	27, // 50: restaurant.ReorderMenuItemsRequest.actor:type_name -> restaurant.Actor
+	2,  // 51: restaurant.ReorderMenuItemsResponse.items:type_name -> restaurant.MenuItem
+	5,  // 52: restaurant.RestaurantService.GetRestaurants:input_type -> restaurant.GetRestaurantsRequest
+	7,  // 53: restaurant.RestaurantService.GetRestaurant:input_type -> restaurant.GetRestaurantRequest
+	9,  // 54: restaurant.RestaurantService.GetMenu:input_type -> restaurant.GetMenuRequest
+	12, // 55: restaurant.RestaurantService.GetMenuItem:input_type -> restaurant.GetMenuItemRequest
+	14, // 56: restaurant.RestaurantService.GetRestaurantStatus:input_type -> restaurant.GetRestaurantStatusRequest
+	16, // 57: restaurant.RestaurantService.ValidateMenuItems:input_type -> restaurant.ValidateMenuItemsRequest
+	22, // 58: restaurant.RestaurantService.CheckDeliverable:input_type -> restaurant.CheckDeliverableRequest
+	24, // 59: restaurant.RestaurantService.Search:input_type -> restaurant.SearchRequest
+	28, // 60: restaurant.RestaurantService.CreateRestaurant:input_type -> restaurant.CreateRestaurantRequest
+	31, // 61: restaurant.RestaurantService.UpdateRestaurant:input_type -> restaurant.UpdateRestaurantRequest
+	33, // 62: restaurant.RestaurantService.CreateMenuItem:input_type -> restaurant.CreateMenuItemRequest
+	35, // 63: restaurant.RestaurantService.UpdateMenuItem:input_type -> restaurant.UpdateMenuItemRequest
+	37, // 64: restaurant.RestaurantService.DeleteMenuItem:input_type -> restaurant.DeleteMenuItemRequest
+	39, // 65: restaurant.RestaurantService.SetItemAvailability:input_type -> restaurant.SetItemAvailabilityRequest
+	41, // 66: restaurant.RestaurantService.ImportMenu:input_type -> restaurant.ImportMenuRequest
+	44, // 67: restaurant.RestaurantService.ExportMenu:input_type -> restaurant.ExportMenuRequest
+	46, // 68: restaurant.RestaurantService.SetMenuItemOptions:input_type -> restaurant.SetMenuItemOptionsRequest
+	52, // 69: restaurant.RestaurantService.GetOpeningHours:input_type -> restaurant.GetOpeningHoursRequest
+	54, // 70: restaurant.RestaurantService.SetOpeningHours:input_type -> restaurant.SetOpeningHoursRequest
+	56, // 71: restaurant.RestaurantService.SetRestaurantPaused:input_type -> restaurant.SetRestaurantPausedRequest
+	58, // 72: restaurant.RestaurantService.ReorderMenuCategories:input_type -> restaurant.ReorderMenuCategoriesRequest
+	60, // 73: restaurant.RestaurantService.ReorderMenuItems:input_type -> restaurant.ReorderMenuItemsRequest
+	6,  // 74: restaurant.RestaurantService.GetRestaurants:output_type -> restaurant.GetRestaurantsResponse
+	8,  // 75: restaurant.RestaurantService.GetRestaurant:output_type -> restaurant.GetRestaurantResponse
+	10, // 76: restaurant.RestaurantService.GetMenu:output_type -> restaurant.GetMenuResponse
+	13, // 77: restaurant.RestaurantService.GetMenuItem:output_type -> restaurant.GetMenuItemResponse
+	15, // 78: restaurant.RestaurantService.GetRestaurantStatus:output_type -> restaurant.GetRestaurantStatusResponse
+	21, // 79: restaurant.RestaurantService.ValidateMenuItems:output_type -> restaurant.ValidateMenuItemsResponse
+	23, // 80: restaurant.RestaurantService.CheckDeliverable:output_type -> restaurant.CheckDeliverableResponse
+	26, // 81: restaurant.RestaurantService.Search:output_type -> restaurant.SearchResponse
+	29, // 82: restaurant.RestaurantService.CreateRestaurant:output_type -> restaurant.CreateRestaurantResponse
+	32, // 83: restaurant.RestaurantService.UpdateRestaurant:output_type -> restaurant.UpdateRestaurantResponse
+	34, // 84: restaurant.RestaurantService.CreateMenuItem:output_type -> restaurant.CreateMenuItemResponse
+	36, // 85: restaurant.RestaurantService.UpdateMenuItem:output_type -> restaurant.UpdateMenuItemResponse
+	38, // 86: restaurant.RestaurantService.DeleteMenuItem:output_type -> restaurant.DeleteMenuItemResponse
+	40, // 87: restaurant.RestaurantService.SetItemAvailability:output_type -> restaurant.SetItemAvailabilityResponse
+	43, // 88: restaurant.RestaurantService.ImportMenu:output_type -> restaurant.ImportMenuResponse
+	45, // 89: restaurant.RestaurantService.ExportMenu:output_type -> restaurant.ExportMenuResponse
+	47, // 90: restaurant.RestaurantService.SetMenuItemOptions:output_type -> restaurant.SetMenuItemOptionsResponse
+	53, // 91: restaurant.RestaurantService.GetOpeningHours:output_type -> restaurant.GetOpeningHoursResponse
+	55, // 92: restaurant.RestaurantService.SetOpeningHours:output_type -> restaurant.SetOpeningHoursResponse
+	57, // 93: restaurant.RestaurantService.SetRestaurantPaused:output_type -> restaurant.SetRestaurantPausedResponse
+	59, // 94: restaurant.RestaurantService.ReorderMenuCategories:output_type -> restaurant.ReorderMenuCategoriesResponse
+	61, // 95: restaurant.RestaurantService.ReorderMenuItems:output_type -> restaurant.ReorderMenuItemsResponse
+	74, // [74:96] is the sub-list for method output_type
+	52, // [52:74] is the sub-list for method input_type
+	52, // [52:52] is the sub-list for extension type_name
+	52, // [52:52] is the sub-list for extension extendee
+	0,  // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_restaurant_proto_init() }
@@ -1067,13 +4700,16 @@ func file_restaurant_proto_init() {
 	if File_restaurant_proto != nil {
 		return
 	}
+	file_restaurant_proto_msgTypes[31].OneofWrappers = []any{}
+	file_restaurant_proto_msgTypes[33].OneofWrappers = []any{}
+	file_restaurant_proto_msgTypes[35].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_restaurant_proto_rawDesc), len(file_restaurant_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   62,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RestaurantService_GetRestaurants_FullMethodName      = "/restaurant.RestaurantService/GetRestaurants"
-	RestaurantService_GetRestaurant_FullMethodName       = "/restaurant.RestaurantService/GetRestaurant"
-	RestaurantService_GetMenu_FullMethodName             = "/restaurant.RestaurantService/GetMenu"
-	RestaurantService_GetMenuItem_FullMethodName         = "/restaurant.RestaurantService/GetMenuItem"
-	RestaurantService_GetRestaurantStatus_FullMethodName = "/restaurant.RestaurantService/GetRestaurantStatus"
-	RestaurantService_ValidateMenuItems_FullMethodName   = "/restaurant.RestaurantService/ValidateMenuItems"
+	RestaurantService_GetRestaurants_FullMethodName        = "/restaurant.RestaurantService/GetRestaurants"
+	RestaurantService_GetRestaurant_FullMethodName         = "/restaurant.RestaurantService/GetRestaurant"
+	RestaurantService_GetMenu_FullMethodName               = "/restaurant.RestaurantService/GetMenu"
+	RestaurantService_GetMenuItem_FullMethodName           = "/restaurant.RestaurantService/GetMenuItem"
+	RestaurantService_GetRestaurantStatus_FullMethodName   = "/restaurant.RestaurantService/GetRestaurantStatus"
+	RestaurantService_ValidateMenuItems_FullMethodName     = "/restaurant.RestaurantService/ValidateMenuItems"
+	RestaurantService_CheckDeliverable_FullMethodName      = "/restaurant.RestaurantService/CheckDeliverable"
+	RestaurantService_Search_FullMethodName                = "/restaurant.RestaurantService/Search"
+	RestaurantService_CreateRestaurant_FullMethodName      = "/restaurant.RestaurantService/CreateRestaurant"
+	RestaurantService_UpdateRestaurant_FullMethodName      = "/restaurant.RestaurantService/UpdateRestaurant"
+	RestaurantService_CreateMenuItem_FullMethodName        = "/restaurant.RestaurantService/CreateMenuItem"
+	RestaurantService_UpdateMenuItem_FullMethodName        = "/restaurant.RestaurantService/UpdateMenuItem"
+	RestaurantService_DeleteMenuItem_FullMethodName        = "/restaurant.RestaurantService/DeleteMenuItem"
+	RestaurantService_SetItemAvailability_FullMethodName   = "/restaurant.RestaurantService/SetItemAvailability"
+	RestaurantService_ImportMenu_FullMethodName            = "/restaurant.RestaurantService/ImportMenu"
+	RestaurantService_ExportMenu_FullMethodName            = "/restaurant.RestaurantService/ExportMenu"
+	RestaurantService_SetMenuItemOptions_FullMethodName    = "/restaurant.RestaurantService/SetMenuItemOptions"
+	RestaurantService_GetOpeningHours_FullMethodName       = "/restaurant.RestaurantService/GetOpeningHours"
+	RestaurantService_SetOpeningHours_FullMethodName       = "/restaurant.RestaurantService/SetOpeningHours"
+	RestaurantService_SetRestaurantPaused_FullMethodName   = "/restaurant.RestaurantService/SetRestaurantPaused"
+	RestaurantService_ReorderMenuCategories_FullMethodName = "/restaurant.RestaurantService/ReorderMenuCategories"
+	RestaurantService_ReorderMenuItems_FullMethodName      = "/restaurant.RestaurantService/ReorderMenuItems"
 )
 
 // RestaurantServiceClient is the client API for RestaurantService service.
@@ -37,6 +53,22 @@ type RestaurantServiceClient interface {
 	GetMenuItem(ctx context.Context, in *GetMenuItemRequest, opts ...grpc.CallOption) (*GetMenuItemResponse, error)
 	GetRestaurantStatus(ctx context.Context, in *GetRestaurantStatusRequest, opts ...grpc.CallOption) (*GetRestaurantStatusResponse, error)
 	ValidateMenuItems(ctx context.Context, in *ValidateMenuItemsRequest, opts ...grpc.CallOption) (*ValidateMenuItemsResponse, error)
+	CheckDeliverable(ctx context.Context, in *CheckDeliverableRequest, opts ...grpc.CallOption) (*CheckDeliverableResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	CreateRestaurant(ctx context.Context, in *CreateRestaurantRequest, opts ...grpc.CallOption) (*CreateRestaurantResponse, error)
+	UpdateRestaurant(ctx context.Context, in *UpdateRestaurantRequest, opts ...grpc.CallOption) (*UpdateRestaurantResponse, error)
+	CreateMenuItem(ctx context.Context, in *CreateMenuItemRequest, opts ...grpc.CallOption) (*CreateMenuItemResponse, error)
+	UpdateMenuItem(ctx context.Context, in *UpdateMenuItemRequest, opts ...grpc.CallOption) (*UpdateMenuItemResponse, error)
+	DeleteMenuItem(ctx context.Context, in *DeleteMenuItemRequest, opts ...grpc.CallOption) (*DeleteMenuItemResponse, error)
+	SetItemAvailability(ctx context.Context, in *SetItemAvailabilityRequest, opts ...grpc.CallOption) (*SetItemAvailabilityResponse, error)
+	ImportMenu(ctx context.Context, in *ImportMenuRequest, opts ...grpc.CallOption) (*ImportMenuResponse, error)
+	ExportMenu(ctx context.Context, in *ExportMenuRequest, opts ...grpc.CallOption) (*ExportMenuResponse, error)
+	SetMenuItemOptions(ctx context.Context, in *SetMenuItemOptionsRequest, opts ...grpc.CallOption) (*SetMenuItemOptionsResponse, error)
+	GetOpeningHours(ctx context.Context, in *GetOpeningHoursRequest, opts ...grpc.CallOption) (*GetOpeningHoursResponse, error)
+	SetOpeningHours(ctx context.Context, in *SetOpeningHoursRequest, opts ...grpc.CallOption) (*SetOpeningHoursResponse, error)
+	SetRestaurantPaused(ctx context.Context, in *SetRestaurantPausedRequest, opts ...grpc.CallOption) (*SetRestaurantPausedResponse, error)
+	ReorderMenuCategories(ctx context.Context, in *ReorderMenuCategoriesRequest, opts ...grpc.CallOption) (*ReorderMenuCategoriesResponse, error)
+	ReorderMenuItems(ctx context.Context, in *ReorderMenuItemsRequest, opts ...grpc.CallOption) (*ReorderMenuItemsResponse, error)
 }
 
 type restaurantServiceClient struct {
@@ -107,6 +139,166 @@ func (c *restaurantServiceClient) ValidateMenuItems(ctx context.Context, in *Val
 	return out, nil
 }
 
+func (c *restaurantServiceClient) CheckDeliverable(ctx context.Context, in *CheckDeliverableRequest, opts ...grpc.CallOption) (*CheckDeliverableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckDeliverableResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_CheckDeliverable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) CreateRestaurant(ctx context.Context, in *CreateRestaurantRequest, opts ...grpc.CallOption) (*CreateRestaurantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRestaurantResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_CreateRestaurant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) UpdateRestaurant(ctx context.Context, in *UpdateRestaurantRequest, opts ...grpc.CallOption) (*UpdateRestaurantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRestaurantResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_UpdateRestaurant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) CreateMenuItem(ctx context.Context, in *CreateMenuItemRequest, opts ...grpc.CallOption) (*CreateMenuItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMenuItemResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_CreateMenuItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) UpdateMenuItem(ctx context.Context, in *UpdateMenuItemRequest, opts ...grpc.CallOption) (*UpdateMenuItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMenuItemResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_UpdateMenuItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) DeleteMenuItem(ctx context.Context, in *DeleteMenuItemRequest, opts ...grpc.CallOption) (*DeleteMenuItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMenuItemResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_DeleteMenuItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) SetItemAvailability(ctx context.Context, in *SetItemAvailabilityRequest, opts ...grpc.CallOption) (*SetItemAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetItemAvailabilityResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_SetItemAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) ImportMenu(ctx context.Context, in *ImportMenuRequest, opts ...grpc.CallOption) (*ImportMenuResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportMenuResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_ImportMenu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) ExportMenu(ctx context.Context, in *ExportMenuRequest, opts ...grpc.CallOption) (*ExportMenuResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportMenuResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_ExportMenu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) SetMenuItemOptions(ctx context.Context, in *SetMenuItemOptionsRequest, opts ...grpc.CallOption) (*SetMenuItemOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMenuItemOptionsResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_SetMenuItemOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) GetOpeningHours(ctx context.Context, in *GetOpeningHoursRequest, opts ...grpc.CallOption) (*GetOpeningHoursResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOpeningHoursResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_GetOpeningHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) SetOpeningHours(ctx context.Context, in *SetOpeningHoursRequest, opts ...grpc.CallOption) (*SetOpeningHoursResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetOpeningHoursResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_SetOpeningHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) SetRestaurantPaused(ctx context.Context, in *SetRestaurantPausedRequest, opts ...grpc.CallOption) (*SetRestaurantPausedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRestaurantPausedResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_SetRestaurantPaused_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) ReorderMenuCategories(ctx context.Context, in *ReorderMenuCategoriesRequest, opts ...grpc.CallOption) (*ReorderMenuCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderMenuCategoriesResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_ReorderMenuCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *restaurantServiceClient) ReorderMenuItems(ctx context.Context, in *ReorderMenuItemsRequest, opts ...grpc.CallOption) (*ReorderMenuItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderMenuItemsResponse)
+	err := c.cc.Invoke(ctx, RestaurantService_ReorderMenuItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RestaurantServiceServer is the server API for RestaurantService service.
 // All implementations must embed UnimplementedRestaurantServiceServer
 // for forward compatibility.
@@ -117,6 +309,22 @@ type RestaurantServiceServer interface {
 	GetMenuItem(context.Context, *GetMenuItemRequest) (*GetMenuItemResponse, error)
 	GetRestaurantStatus(context.Context, *GetRestaurantStatusRequest) (*GetRestaurantStatusResponse, error)
 	ValidateMenuItems(context.Context, *ValidateMenuItemsRequest) (*ValidateMenuItemsResponse, error)
+	CheckDeliverable(context.Context, *CheckDeliverableRequest) (*CheckDeliverableResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	CreateRestaurant(context.Context, *CreateRestaurantRequest) (*CreateRestaurantResponse, error)
+	UpdateRestaurant(context.Context, *UpdateRestaurantRequest) (*UpdateRestaurantResponse, error)
+	CreateMenuItem(context.Context, *CreateMenuItemRequest) (*CreateMenuItemResponse, error)
+	UpdateMenuItem(context.Context, *UpdateMenuItemRequest) (*UpdateMenuItemResponse, error)
+	DeleteMenuItem(context.Context, *DeleteMenuItemRequest) (*DeleteMenuItemResponse, error)
+	SetItemAvailability(context.Context, *SetItemAvailabilityRequest) (*SetItemAvailabilityResponse, error)
+	ImportMenu(context.Context, *ImportMenuRequest) (*ImportMenuResponse, error)
+	ExportMenu(context.Context, *ExportMenuRequest) (*ExportMenuResponse, error)
+	SetMenuItemOptions(context.Context, *SetMenuItemOptionsRequest) (*SetMenuItemOptionsResponse, error)
+	GetOpeningHours(context.Context, *GetOpeningHoursRequest) (*GetOpeningHoursResponse, error)
+	SetOpeningHours(context.Context, *SetOpeningHoursRequest) (*SetOpeningHoursResponse, error)
+	SetRestaurantPaused(context.Context, *SetRestaurantPausedRequest) (*SetRestaurantPausedResponse, error)
+	ReorderMenuCategories(context.Context, *ReorderMenuCategoriesRequest) (*ReorderMenuCategoriesResponse, error)
+	ReorderMenuItems(context.Context, *ReorderMenuItemsRequest) (*ReorderMenuItemsResponse, error)
 	mustEmbedUnimplementedRestaurantServiceServer()
 }
 
@@ -145,6 +353,54 @@ func (UnimplementedRestaurantServiceServer) GetRestaurantStatus(context.Context,
 func (UnimplementedRestaurantServiceServer) ValidateMenuItems(context.Context, *ValidateMenuItemsRequest) (*ValidateMenuItemsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ValidateMenuItems not implemented")
 }
+func (UnimplementedRestaurantServiceServer) CheckDeliverable(context.Context, *CheckDeliverableRequest) (*CheckDeliverableResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckDeliverable not implemented")
+}
+func (UnimplementedRestaurantServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedRestaurantServiceServer) CreateRestaurant(context.Context, *CreateRestaurantRequest) (*CreateRestaurantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRestaurant not implemented")
+}
+func (UnimplementedRestaurantServiceServer) UpdateRestaurant(context.Context, *UpdateRestaurantRequest) (*UpdateRestaurantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRestaurant not implemented")
+}
+func (UnimplementedRestaurantServiceServer) CreateMenuItem(context.Context, *CreateMenuItemRequest) (*CreateMenuItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMenuItem not implemented")
+}
+func (UnimplementedRestaurantServiceServer) UpdateMenuItem(context.Context, *UpdateMenuItemRequest) (*UpdateMenuItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMenuItem not implemented")
+}
+func (UnimplementedRestaurantServiceServer) DeleteMenuItem(context.Context, *DeleteMenuItemRequest) (*DeleteMenuItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMenuItem not implemented")
+}
+func (UnimplementedRestaurantServiceServer) SetItemAvailability(context.Context, *SetItemAvailabilityRequest) (*SetItemAvailabilityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetItemAvailability not implemented")
+}
+func (UnimplementedRestaurantServiceServer) ImportMenu(context.Context, *ImportMenuRequest) (*ImportMenuResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportMenu not implemented")
+}
+func (UnimplementedRestaurantServiceServer) ExportMenu(context.Context, *ExportMenuRequest) (*ExportMenuResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportMenu not implemented")
+}
+func (UnimplementedRestaurantServiceServer) SetMenuItemOptions(context.Context, *SetMenuItemOptionsRequest) (*SetMenuItemOptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMenuItemOptions not implemented")
+}
+func (UnimplementedRestaurantServiceServer) GetOpeningHours(context.Context, *GetOpeningHoursRequest) (*GetOpeningHoursResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOpeningHours not implemented")
+}
+func (UnimplementedRestaurantServiceServer) SetOpeningHours(context.Context, *SetOpeningHoursRequest) (*SetOpeningHoursResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetOpeningHours not implemented")
+}
+func (UnimplementedRestaurantServiceServer) SetRestaurantPaused(context.Context, *SetRestaurantPausedRequest) (*SetRestaurantPausedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRestaurantPaused not implemented")
+}
+func (UnimplementedRestaurantServiceServer) ReorderMenuCategories(context.Context, *ReorderMenuCategoriesRequest) (*ReorderMenuCategoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderMenuCategories not implemented")
+}
+func (UnimplementedRestaurantServiceServer) ReorderMenuItems(context.Context, *ReorderMenuItemsRequest) (*ReorderMenuItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderMenuItems not implemented")
+}
 func (UnimplementedRestaurantServiceServer) mustEmbedUnimplementedRestaurantServiceServer() {}
 func (UnimplementedRestaurantServiceServer) testEmbeddedByValue()                           {}
 
@@ -274,6 +530,294 @@ func _RestaurantService_ValidateMenuItems_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RestaurantService_CheckDeliverable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckDeliverableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).CheckDeliverable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_CheckDeliverable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).CheckDeliverable(ctx, req.(*CheckDeliverableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_CreateRestaurant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRestaurantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).CreateRestaurant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_CreateRestaurant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).CreateRestaurant(ctx, req.(*CreateRestaurantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_UpdateRestaurant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRestaurantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).UpdateRestaurant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_UpdateRestaurant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).UpdateRestaurant(ctx, req.(*UpdateRestaurantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_CreateMenuItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMenuItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).CreateMenuItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_CreateMenuItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).CreateMenuItem(ctx, req.(*CreateMenuItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_UpdateMenuItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMenuItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).UpdateMenuItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_UpdateMenuItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).UpdateMenuItem(ctx, req.(*UpdateMenuItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_DeleteMenuItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMenuItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).DeleteMenuItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_DeleteMenuItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).DeleteMenuItem(ctx, req.(*DeleteMenuItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_SetItemAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetItemAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).SetItemAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_SetItemAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).SetItemAvailability(ctx, req.(*SetItemAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_ImportMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).ImportMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_ImportMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).ImportMenu(ctx, req.(*ImportMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_ExportMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).ExportMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_ExportMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).ExportMenu(ctx, req.(*ExportMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_SetMenuItemOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMenuItemOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).SetMenuItemOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_SetMenuItemOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).SetMenuItemOptions(ctx, req.(*SetMenuItemOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_GetOpeningHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpeningHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).GetOpeningHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_GetOpeningHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).GetOpeningHours(ctx, req.(*GetOpeningHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_SetOpeningHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOpeningHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).SetOpeningHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_SetOpeningHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).SetOpeningHours(ctx, req.(*SetOpeningHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_SetRestaurantPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRestaurantPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).SetRestaurantPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_SetRestaurantPaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).SetRestaurantPaused(ctx, req.(*SetRestaurantPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_ReorderMenuCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderMenuCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).ReorderMenuCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_ReorderMenuCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).ReorderMenuCategories(ctx, req.(*ReorderMenuCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RestaurantService_ReorderMenuItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderMenuItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RestaurantServiceServer).ReorderMenuItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RestaurantService_ReorderMenuItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RestaurantServiceServer).ReorderMenuItems(ctx, req.(*ReorderMenuItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RestaurantService_ServiceDesc is the grpc.ServiceDesc for RestaurantService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +849,70 @@ var RestaurantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateMenuItems",
 			Handler:    _RestaurantService_ValidateMenuItems_Handler,
 		},
+		{
+			MethodName: "CheckDeliverable",
+			Handler:    _RestaurantService_CheckDeliverable_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _RestaurantService_Search_Handler,
+		},
+		{
+			MethodName: "CreateRestaurant",
+			Handler:    _RestaurantService_CreateRestaurant_Handler,
+		},
+		{
+			MethodName: "UpdateRestaurant",
+			Handler:    _RestaurantService_UpdateRestaurant_Handler,
+		},
+		{
+			MethodName: "CreateMenuItem",
+			Handler:    _RestaurantService_CreateMenuItem_Handler,
+		},
+		{
+			MethodName: "UpdateMenuItem",
+			Handler:    _RestaurantService_UpdateMenuItem_Handler,
+		},
+		{
+			MethodName: "DeleteMenuItem",
+			Handler:    _RestaurantService_DeleteMenuItem_Handler,
+		},
+		{
+			MethodName: "SetItemAvailability",
+			Handler:    _RestaurantService_SetItemAvailability_Handler,
+		},
+		{
+			MethodName: "ImportMenu",
+			Handler:    _RestaurantService_ImportMenu_Handler,
+		},
+		{
+			MethodName: "ExportMenu",
+			Handler:    _RestaurantService_ExportMenu_Handler,
+		},
+		{
+			MethodName: "SetMenuItemOptions",
+			Handler:    _RestaurantService_SetMenuItemOptions_Handler,
+		},
+		{
+			MethodName: "GetOpeningHours",
+			Handler:    _RestaurantService_GetOpeningHours_Handler,
+		},
+		{
+			MethodName: "SetOpeningHours",
+			Handler:    _RestaurantService_SetOpeningHours_Handler,
+		},
+		{
+			MethodName: "SetRestaurantPaused",
+			Handler:    _RestaurantService_SetRestaurantPaused_Handler,
+		},
+		{
+			MethodName: "ReorderMenuCategories",
+			Handler:    _RestaurantService_ReorderMenuCategories_Handler,
+		},
+		{
+			MethodName: "ReorderMenuItems",
+			Handler:    _RestaurantService_ReorderMenuItems_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "restaurant.proto",
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CategoryRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCategoryRepository(db *pgxpool.Pool) *CategoryRepository {
+	return &CategoryRepository{db: db}
+}
+
+// MenuCategory orders the menu; items refer to it by id and keep a copy of
+// its name in their category column.
+type MenuCategory struct {
+	ID           string
+	RestaurantID string
+	Name         string
+	Position     int32
+}
+
+// GetCategories returns the restaurant's categories in display order.
+func (r *CategoryRepository) GetCategories(ctx context.Context, restaurantID string) ([]*MenuCategory, error) {
+	query := `
+		SELECT id, restaurant_id, name, position
+		FROM menu_categories
+		WHERE restaurant_id = $1
+		ORDER BY position, name
+	`
+
+	rows, err := r.db.Query(ctx, query, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query menu categories: %w", err)
+	}
+	defer rows.Close()
+
+	var categories []*MenuCategory
+	for rows.Next() {
+		var category MenuCategory
+		if err := rows.Scan(&category.ID, &category.RestaurantID, &category.Name, &category.Position); err != nil {
+			return nil, fmt.Errorf("failed to scan menu category: %w", err)
+		}
+		categories = append(categories, &category)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating menu categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+// Reorder puts the listed categories first, in the given order. Categories
+// that are not listed keep their relative order after them.
+func (r *CategoryRepository) Reorder(ctx context.Context, restaurantID string, ids []string) error {
+	query := `
+		UPDATE menu_categories c
+		SET position = o.position, updated_at = NOW()
+		FROM (
+			SELECT id, ROW_NUMBER() OVER (ORDER BY array_position($2::uuid[], id), position, name) - 1 AS position
+			FROM menu_categories
+			WHERE restaurant_id = $1
+		) o
+		WHERE c.id = o.id
+	`
+
+	_, err := r.db.Exec(ctx, query, restaurantID, ids)
+	if err != nil {
+		return fmt.Errorf("failed to reorder menu categories: %w", err)
+	}
+
+	return nil
+}
+
+// ensureCategory returns the id of the restaurant's category with the given
+// name, adding it at the end of the menu when it is new. Items without a
+// category get a NULL id.
+func ensureCategory(ctx context.Context, tx pgx.Tx, restaurantID, name string) (*string, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	// The no-op update makes RETURNING work for existing categories too
+	query := `
+		INSERT INTO menu_categories (id, restaurant_id, name, position, created_at, updated_at)
+		VALUES ($1, $2, $3,
+		        (SELECT COALESCE(MAX(position) + 1, 0) FROM menu_categories WHERE restaurant_id = $2),
+		        NOW(), NOW())
+		ON CONFLICT (restaurant_id, name) DO UPDATE SET name = EXCLUDED.name
+		RETURNING id
+	`
+
+	var id string
+	if err := tx.QueryRow(ctx, query, uuid.New().String(), restaurantID, name).Scan(&id); err != nil {
+		return nil, fmt.Errorf("failed to save menu category %s: %w", name, err)
+	}
+
+	return &id, nil
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type HoursRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewHoursRepository(db *pgxpool.Pool) *HoursRepository {
+	return &HoursRepository{db: db}
+}
+
+// HoursInterval is one opening interval of the weekly schedule. Times are
+// HH:MM; a closing time at or before the opening time runs past midnight.
+type HoursInterval struct {
+	Weekday  int // 0 is Sunday, like time.Weekday
+	OpensAt  string
+	ClosesAt string
+}
+
+// HoursException replaces the weekly schedule on one date. Exceptions
+// without times close the restaurant for the whole day.
+type HoursException struct {
+	Date     string // YYYY-MM-DD
+	OpensAt  string
+	ClosesAt string
+	Note     string
+}
+
+type OpeningHours struct {
+	Weekly     []*HoursInterval
+	Exceptions []*HoursException
+}
+
+// Get returns the weekly schedule and the exceptions dated since (YYYY-MM-DD)
+// or later.
+func (r *HoursRepository) Get(ctx context.Context, restaurantID, since string) (*OpeningHours, error) {
+	hours := &OpeningHours{}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT weekday, to_char(opens_at, 'HH24:MI'), to_char(closes_at, 'HH24:MI')
+		FROM restaurant_hours
+		WHERE restaurant_id = $1
+		ORDER BY weekday, opens_at
+	`, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query opening hours: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var interval HoursInterval
+		if err := rows.Scan(&interval.Weekday, &interval.OpensAt, &interval.ClosesAt); err != nil {
+			return nil, fmt.Errorf("failed to scan opening hours: %w", err)
+		}
+		hours.Weekly = append(hours.Weekly, &interval)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating opening hours: %w", err)
+	}
+
+	rows, err = r.db.Query(ctx, `
+		SELECT to_char(date, 'YYYY-MM-DD'),
+		       COALESCE(to_char(opens_at, 'HH24:MI'), ''), COALESCE(to_char(closes_at, 'HH24:MI'), ''),
+		       COALESCE(note, '')
+		FROM restaurant_hour_exceptions
+		WHERE restaurant_id = $1 AND date >= $2::date
+		ORDER BY date, opens_at NULLS FIRST
+	`, restaurantID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query opening hour exceptions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var exception HoursException
+		if err := rows.Scan(&exception.Date, &exception.OpensAt, &exception.ClosesAt, &exception.Note); err != nil {
+			return nil, fmt.Errorf("failed to scan opening hour exception: %w", err)
+		}
+		hours.Exceptions = append(hours.Exceptions, &exception)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating opening hour exceptions: %w", err)
+	}
+
+	return hours, nil
+}
+
+// Replace makes hours the restaurant's complete schedule and exception list.
+func (r *HoursRepository) Replace(ctx context.Context, restaurantID string, hours *OpeningHours) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM restaurant_hours WHERE restaurant_id = $1`, restaurantID); err != nil {
+		return fmt.Errorf("failed to delete opening hours: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM restaurant_hour_exceptions WHERE restaurant_id = $1`, restaurantID); err != nil {
+		return fmt.Errorf("failed to delete opening hour exceptions: %w", err)
+	}
+
+	for _, interval := range hours.Weekly {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO restaurant_hours (id, restaurant_id, weekday, opens_at, closes_at)
+			VALUES ($1, $2, $3, $4::text::time, $5::text::time)
+		`, uuid.New().String(), restaurantID, interval.Weekday, interval.OpensAt, interval.ClosesAt)
+		if err != nil {
+			return fmt.Errorf("failed to save opening hours: %w", err)
+		}
+	}
+
+	for _, exception := range hours.Exceptions {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO restaurant_hour_exceptions (id, restaurant_id, date, opens_at, closes_at, note)
+			VALUES ($1, $2, $3::date, NULLIF($4, '')::time, NULLIF($5, '')::time, NULLIF($6, ''))
+		`, uuid.New().String(), restaurantID, exception.Date, exception.OpensAt, exception.ClosesAt, exception.Note)
+		if err != nil {
+			return fmt.Errorf("failed to save opening hour exception: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -26,94 +26,356 @@ type MenuItem struct {
 	Category     string
 	CreatedAt    string
 	UpdatedAt    string
+	ExternalSKU  string
+	CategoryID   string
+	Position     int32 // order within the category
 }
 
-func (r *MenuItemRepository) GetMenu(ctx context.Context, restaurantID string, offset int32, limit int32) ([]*MenuItem, error) {
-	var items []*MenuItem
+const menuItemColumns = `
+	id, restaurant_id, name, COALESCE(description, ''), price, COALESCE(image_url, ''),
+	is_available, COALESCE(category, ''),
+	to_char(created_at, 'YYYY-MM-DD HH24:MI:SS'),
+	to_char(updated_at, 'YYYY-MM-DD HH24:MI:SS'),
+	COALESCE(external_sku, ''), COALESCE(category_id::text, ''), position
+`
+
+// menuOrder sorts items like the menu shows them: by category position,
+// then by item position. Items without a category come last.
+const menuOrder = `
+	COALESCE((SELECT c.position FROM menu_categories c WHERE c.id = menu_items.category_id), 2147483647),
+	COALESCE(category, ''), position, name, id
+`
+
+// MenuCursor is where a page of GetMenu ended: the menuOrder values of the
+// last item.
+type MenuCursor struct {
+	CategoryPosition int32  `json:"cp"`
+	Category         string `json:"c"`
+	Position         int32  `json:"p"`
+	Name             string `json:"n"`
+	ID               string `json:"i"`
+}
+
+// GetMenu returns up to limit items in menu order after the cursor, or from
+// the start when after is nil, and the cursor of the next page, which is nil
+// on the last page.
+func (r *MenuItemRepository) GetMenu(ctx context.Context, restaurantID string, after *MenuCursor, limit int32) ([]*MenuItem, *MenuCursor, error) {
+	args := []any{restaurantID, limit + 1}
+	where := "restaurant_id = $1"
+	if after != nil {
+		args = append(args, after.CategoryPosition, after.Category, after.Position, after.Name, after.ID)
+		where += ` AND (` + menuOrder + `) > ($3, $4, $5, $6, $7::uuid)`
+	}
 
+	// One extra row tells whether there is a next page
 	query := `
-		SELECT id, restaurant_id, name, COALESCE(description, ''), price, COALESCE(image_url, ''),
-		       is_available, COALESCE(category, ''), 
-		       to_char(created_at, 'YYYY-MM-DD HH24:MI:SS'), 
-		       to_char(updated_at, 'YYYY-MM-DD HH24:MI:SS')
+		SELECT ` + menuItemColumns + `,
+		       COALESCE((SELECT c.position FROM menu_categories c WHERE c.id = menu_items.category_id), 2147483647)
 		FROM menu_items
-		WHERE restaurant_id = $1
-		ORDER BY category, name
-		LIMIT $2 OFFSET $3
+		WHERE ` + where + `
+		ORDER BY ` + menuOrder + `
+		LIMIT $2
 	`
 
-	rows, err := r.db.Query(ctx, query, restaurantID, limit, offset)
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query menu items: %w", err)
+		return nil, nil, fmt.Errorf("failed to query menu items: %w", err)
 	}
 	defer rows.Close()
 
+	var items []*MenuItem
+	var categoryPositions []int32
 	for rows.Next() {
-		var item MenuItem
-		err := rows.Scan(
-			&item.ID, &item.RestaurantID, &item.Name, &item.Description,
-			&item.Price, &item.ImageURL, &item.IsAvailable, &item.Category,
-			&item.CreatedAt, &item.UpdatedAt,
-		)
+		var categoryPosition int32
+		item, err := scanMenuItem(rows, &categoryPosition)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan menu item: %w", err)
+			return nil, nil, fmt.Errorf("failed to scan menu item: %w", err)
 		}
-		items = append(items, &item)
+		items = append(items, item)
+		categoryPositions = append(categoryPositions, categoryPosition)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating menu items: %w", err)
+		return nil, nil, fmt.Errorf("error iterating menu items: %w", err)
 	}
 
-	return items, nil
+	if len(items) <= int(limit) {
+		return items, nil, nil
+	}
+
+	last := items[limit-1]
+	return items[:limit], &MenuCursor{
+		CategoryPosition: categoryPositions[limit-1],
+		Category:         last.Category,
+		Position:         last.Position,
+		Name:             last.Name,
+		ID:               last.ID,
+	}, nil
 }
 
-func (r *MenuItemRepository) GetMenuCount(ctx context.Context, restaurantID string) (int32, error) {
-	var count int32
-	query := `SELECT COUNT(*) FROM menu_items WHERE restaurant_id = $1`
-	err := r.db.QueryRow(ctx, query, restaurantID).Scan(&count)
-	if err != nil {
-		return 0, fmt.Errorf("failed to count menu items: %w", err)
-	}
-	return count, nil
+// GetAllMenuItems returns the restaurant's whole menu in GetMenu order.
+func (r *MenuItemRepository) GetAllMenuItems(ctx context.Context, restaurantID string) ([]*MenuItem, error) {
+	query := `
+		SELECT ` + menuItemColumns + `
+		FROM menu_items
+		WHERE restaurant_id = $1
+		ORDER BY ` + menuOrder + `
+	`
+
+	return r.queryMenuItems(ctx, query, restaurantID)
 }
 
 func (r *MenuItemRepository) GetMenuItem(ctx context.Context, id string) (*MenuItem, error) {
-	var item MenuItem
-
 	query := `
-		SELECT id, restaurant_id, name, COALESCE(description, ''), price, COALESCE(image_url, ''),
-		       is_available, COALESCE(category, ''), 
-		       to_char(created_at, 'YYYY-MM-DD HH24:MI:SS'), 
-		       to_char(updated_at, 'YYYY-MM-DD HH24:MI:SS')
+		SELECT ` + menuItemColumns + `
 		FROM menu_items
 		WHERE id = $1
 	`
 
-	err := r.db.QueryRow(ctx, query, id).Scan(
-		&item.ID, &item.RestaurantID, &item.Name, &item.Description,
-		&item.Price, &item.ImageURL, &item.IsAvailable, &item.Category,
-		&item.CreatedAt, &item.UpdatedAt,
+	item, err := scanMenuItem(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get menu item: %w", err)
+	}
+
+	return item, nil
+}
+
+// Search returns the menu items of the given restaurants that match the
+// search text, best match first.
+func (r *MenuItemRepository) Search(ctx context.Context, text string, restaurantIDs []string) ([]*MenuItem, error) {
+	query := `
+		WITH q AS (SELECT ` + searchQuery + ` AS query)
+		SELECT ` + menuItemColumns + `
+		FROM menu_items, q
+		WHERE restaurant_id = ANY($2) AND search_vector @@ q.query
+		ORDER BY ts_rank(search_vector, q.query) DESC, name
+	`
+
+	return r.queryMenuItems(ctx, query, text, restaurantIDs)
+}
+
+// CreateMenuItem adds the item at the end of its category, creating the
+// category when it is new.
+func (r *MenuItemRepository) CreateMenuItem(ctx context.Context, item *MenuItem) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	categoryID, err := ensureCategory(ctx, tx, item.RestaurantID, item.Category)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO menu_items (id, restaurant_id, name, description, price, image_url,
+		                        is_available, category, external_sku, category_id, position,
+		                        created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, ''), $10, ` + nextItemPosition("$2", "$10") + `, NOW(), NOW())
+	`
+
+	_, err = tx.Exec(ctx, query,
+		item.ID, item.RestaurantID, item.Name, item.Description, item.Price,
+		item.ImageURL, item.IsAvailable, item.Category, item.ExternalSKU, categoryID,
 	)
+	if err != nil {
+		return fmt.Errorf("failed to create menu item: %w", err)
+	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateMenuItem overwrites every editable column with the values in item.
+// An item moved to another category goes to the end of it.
+func (r *MenuItemRepository) UpdateMenuItem(ctx context.Context, item *MenuItem) error {
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get menu item: %w", err)
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback(ctx)
 
-	return &item, nil
+	categoryID, err := ensureCategory(ctx, tx, item.RestaurantID, item.Category)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE menu_items
+		SET name = $1, description = $2, price = $3, image_url = $4,
+		    is_available = $5, category = $6, external_sku = NULLIF($7, ''),
+		    position = CASE WHEN category_id IS DISTINCT FROM $9::uuid
+		                    THEN ` + nextItemPosition("menu_items.restaurant_id", "$9") + ` ELSE position END,
+		    category_id = $9, updated_at = NOW()
+		WHERE id = $8
+	`
+
+	_, err = tx.Exec(ctx, query,
+		item.Name, item.Description, item.Price, item.ImageURL,
+		item.IsAvailable, item.Category, item.ExternalSKU, item.ID, categoryID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update menu item: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// nextItemPosition is the position after the last item of a category. The
+// arguments are the SQL expressions for the restaurant and category ids.
+func nextItemPosition(restaurantID, categoryID string) string {
+	return fmt.Sprintf(`(
+		SELECT COALESCE(MAX(o.position) + 1, 0) FROM menu_items o
+		WHERE o.restaurant_id = %s AND o.category_id IS NOT DISTINCT FROM %s::uuid
+	)`, restaurantID, categoryID)
+}
+
+// ReorderItems puts the listed items of a category first, in the given
+// order; the category's other items keep their relative order after them.
+// An empty categoryID reorders the items without a category.
+func (r *MenuItemRepository) ReorderItems(ctx context.Context, restaurantID, categoryID string, ids []string) error {
+	query := `
+		UPDATE menu_items m
+		SET position = o.position, updated_at = NOW()
+		FROM (
+			SELECT id, ROW_NUMBER() OVER (ORDER BY array_position($3::uuid[], id), position, name) - 1 AS position
+			FROM menu_items
+			WHERE restaurant_id = $1 AND category_id IS NOT DISTINCT FROM NULLIF($2, '')::uuid
+		) o
+		WHERE m.id = o.id
+	`
+
+	_, err := r.db.Exec(ctx, query, restaurantID, categoryID, ids)
+	if err != nil {
+		return fmt.Errorf("failed to reorder menu items: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MenuItemRepository) SetAvailability(ctx context.Context, id string, isAvailable bool) error {
+	query := `UPDATE menu_items SET is_available = $1, updated_at = NOW() WHERE id = $2`
+
+	_, err := r.db.Exec(ctx, query, isAvailable, id)
+	if err != nil {
+		return fmt.Errorf("failed to set menu item availability: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteMenuItem removes the item. Orders keep their own copy of its name and price.
+func (r *MenuItemRepository) DeleteMenuItem(ctx context.Context, id string) error {
+	query := `DELETE FROM menu_items WHERE id = $1`
+
+	_, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete menu item: %w", err)
+	}
+
+	return nil
+}
+
+// GetIDsBySKU maps the restaurant's external SKUs to menu item ids. Items
+// without a SKU are keyed by their id, which is what ExportMenu writes for them.
+func (r *MenuItemRepository) GetIDsBySKU(ctx context.Context, restaurantID string) (map[string]string, error) {
+	query := `SELECT COALESCE(external_sku, id::text), id FROM menu_items WHERE restaurant_id = $1`
+
+	rows, err := r.db.Query(ctx, query, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query menu item skus: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make(map[string]string)
+	for rows.Next() {
+		var sku, id string
+		if err := rows.Scan(&sku, &id); err != nil {
+			return nil, fmt.Errorf("failed to scan menu item sku: %w", err)
+		}
+		ids[sku] = id
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating menu item skus: %w", err)
+	}
+
+	return ids, nil
+}
+
+// UpsertBySKU creates or updates every item in one transaction. Items are
+// matched on id, so callers look existing ones up with GetIDsBySKU first; an
+// item that had no SKU takes the one it is imported with. New categories and
+// new items are added in the order they come in.
+func (r *MenuItemRepository) UpsertBySKU(ctx context.Context, items []*MenuItem) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO menu_items (id, restaurant_id, name, description, price, image_url,
+		                        is_available, category, external_sku, category_id, position,
+		                        created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, ` + nextItemPosition("$2", "$10") + `, NOW(), NOW())
+		ON CONFLICT (id) DO UPDATE
+		SET name = EXCLUDED.name,
+		    description = EXCLUDED.description,
+		    price = EXCLUDED.price,
+		    image_url = EXCLUDED.image_url,
+		    is_available = EXCLUDED.is_available,
+		    category = EXCLUDED.category,
+		    position = CASE WHEN menu_items.category_id IS DISTINCT FROM EXCLUDED.category_id
+		                    THEN EXCLUDED.position ELSE menu_items.position END,
+		    category_id = EXCLUDED.category_id,
+		    external_sku = EXCLUDED.external_sku,
+		    updated_at = NOW()
+		WHERE menu_items.restaurant_id = EXCLUDED.restaurant_id
+	`
+
+	for _, item := range items {
+		categoryID, err := ensureCategory(ctx, tx, item.RestaurantID, item.Category)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, query,
+			item.ID, item.RestaurantID, item.Name, item.Description, item.Price,
+			item.ImageURL, item.IsAvailable, item.Category, item.ExternalSKU, categoryID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to upsert menu item %s: %w", item.ExternalSKU, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
 }
 
 type MenuItemValidation struct {
 	ItemID      string
 	IsAvailable bool
 	Name        string
+	Price       float64
 }
 
 func (r *MenuItemRepository) ValidateMenuItems(ctx context.Context, restaurantID string, itemIDs []string) ([]*MenuItemValidation, error) {
 	var validations []*MenuItemValidation
 
 	query := `
-		SELECT id, is_available, name
+		SELECT id, is_available, name, price
 		FROM menu_items
 		WHERE restaurant_id = $1 AND id = ANY($2)
 	`
@@ -126,7 +388,7 @@ func (r *MenuItemRepository) ValidateMenuItems(ctx context.Context, restaurantID
 
 	for rows.Next() {
 		var validation MenuItemValidation
-		err := rows.Scan(&validation.ItemID, &validation.IsAvailable, &validation.Name)
+		err := rows.Scan(&validation.ItemID, &validation.IsAvailable, &validation.Name, &validation.Price)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan menu item validation: %w", err)
 		}
@@ -139,3 +401,42 @@ func (r *MenuItemRepository) ValidateMenuItems(ctx context.Context, restaurantID
 
 	return validations, nil
 }
+
+func (r *MenuItemRepository) queryMenuItems(ctx context.Context, query string, args ...any) ([]*MenuItem, error) {
+	var items []*MenuItem
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query menu items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item, err := scanMenuItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan menu item: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating menu items: %w", err)
+	}
+
+	return items, nil
+}
+
+// scanMenuItem reads menuItemColumns followed by any extra columns.
+func scanMenuItem(row rowScanner, extra ...any) (*MenuItem, error) {
+	var item MenuItem
+	dest := []any{
+		&item.ID, &item.RestaurantID, &item.Name, &item.Description,
+		&item.Price, &item.ImageURL, &item.IsAvailable, &item.Category,
+		&item.CreatedAt, &item.UpdatedAt, &item.ExternalSKU, &item.CategoryID, &item.Position,
+	}
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
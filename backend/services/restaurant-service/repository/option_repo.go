@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type OptionRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewOptionRepository(db *pgxpool.Pool) *OptionRepository {
+	return &OptionRepository{db: db}
+}
+
+// OptionGroup is a choice offered with a menu item; customers pick between
+// MinSelect and MaxSelect of its options.
+type OptionGroup struct {
+	ID         string
+	MenuItemID string
+	Name       string
+	MinSelect  int32
+	MaxSelect  int32
+	Options    []*Option
+}
+
+type Option struct {
+	ID          string
+	GroupID     string
+	Name        string
+	PriceDelta  float64
+	IsAvailable bool
+}
+
+// GetForMenuItems returns the option groups of the given menu items, keyed by
+// menu item id, with groups and options in display order.
+func (r *OptionRepository) GetForMenuItems(ctx context.Context, menuItemIDs []string) (map[string][]*OptionGroup, error) {
+	groups := make(map[string][]*OptionGroup)
+	if len(menuItemIDs) == 0 {
+		return groups, nil
+	}
+
+	query := `
+		SELECT g.id, g.menu_item_id, g.name, g.min_select, g.max_select,
+		       o.id, o.name, o.price_delta, o.is_available
+		FROM menu_option_groups g
+		LEFT JOIN menu_options o ON o.group_id = g.id
+		WHERE g.menu_item_id = ANY($1)
+		ORDER BY g.menu_item_id, g.position, g.created_at, o.position, o.created_at
+	`
+
+	rows, err := r.db.Query(ctx, query, menuItemIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query option groups: %w", err)
+	}
+	defer rows.Close()
+
+	var current *OptionGroup
+	for rows.Next() {
+		var group OptionGroup
+		var optionID, optionName *string
+		var priceDelta *float64
+		var isAvailable *bool
+
+		err := rows.Scan(
+			&group.ID, &group.MenuItemID, &group.Name, &group.MinSelect, &group.MaxSelect,
+			&optionID, &optionName, &priceDelta, &isAvailable,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan option group: %w", err)
+		}
+
+		if current == nil || current.ID != group.ID {
+			current = &group
+			groups[group.MenuItemID] = append(groups[group.MenuItemID], current)
+		}
+
+		// Groups without options come back with NULL option columns
+		if optionID != nil {
+			current.Options = append(current.Options, &Option{
+				ID:          *optionID,
+				GroupID:     current.ID,
+				Name:        *optionName,
+				PriceDelta:  *priceDelta,
+				IsAvailable: *isAvailable,
+			})
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating option groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+// ReplaceForMenuItem makes groups the menu item's complete set of option
+// groups. Groups and options keep their ids, so existing ones are updated in
+// place and anything missing from groups is deleted.
+func (r *OptionRepository) ReplaceForMenuItem(ctx context.Context, menuItemID string, groups []*OptionGroup) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	groupIDs := make([]string, 0, len(groups))
+	optionIDs := make([]string, 0)
+	for _, group := range groups {
+		groupIDs = append(groupIDs, group.ID)
+		for _, option := range group.Options {
+			optionIDs = append(optionIDs, option.ID)
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		DELETE FROM menu_option_groups
+		WHERE menu_item_id = $1 AND NOT (id = ANY($2))
+	`, menuItemID, groupIDs)
+	if err != nil {
+		return fmt.Errorf("failed to delete option groups: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		DELETE FROM menu_options
+		WHERE group_id IN (SELECT id FROM menu_option_groups WHERE menu_item_id = $1)
+		  AND NOT (id = ANY($2))
+	`, menuItemID, optionIDs)
+	if err != nil {
+		return fmt.Errorf("failed to delete options: %w", err)
+	}
+
+	groupQuery := `
+		INSERT INTO menu_option_groups (id, menu_item_id, name, min_select, max_select, position, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		ON CONFLICT (id) DO UPDATE
+		SET name = EXCLUDED.name,
+		    min_select = EXCLUDED.min_select,
+		    max_select = EXCLUDED.max_select,
+		    position = EXCLUDED.position,
+		    updated_at = NOW()
+	`
+
+	optionQuery := `
+		INSERT INTO menu_options (id, group_id, name, price_delta, is_available, position, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		ON CONFLICT (id) DO UPDATE
+		SET group_id = EXCLUDED.group_id,
+		    name = EXCLUDED.name,
+		    price_delta = EXCLUDED.price_delta,
+		    is_available = EXCLUDED.is_available,
+		    position = EXCLUDED.position,
+		    updated_at = NOW()
+	`
+
+	for i, group := range groups {
+		_, err := tx.Exec(ctx, groupQuery, group.ID, menuItemID, group.Name, group.MinSelect, group.MaxSelect, i)
+		if err != nil {
+			return fmt.Errorf("failed to save option group: %w", err)
+		}
+
+		for j, option := range group.Options {
+			_, err := tx.Exec(ctx, optionQuery, option.ID, group.ID, option.Name, option.PriceDelta, option.IsAvailable, j)
+			if err != nil {
+				return fmt.Errorf("failed to save option: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
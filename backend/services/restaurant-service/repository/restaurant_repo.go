@@ -3,8 +3,11 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/kimashii-dan/food-delivery-app/backend/pkg"
 )
 
 type RestaurantRepository struct {
@@ -28,101 +31,335 @@ type Restaurant struct {
 	ClosingTime string
 	CreatedAt   string
 	UpdatedAt   string
+	OwnerUserID string
+	Timezone    string // IANA name, opening hours are in this zone
+	IsPaused    bool   // closed for orders by hand, whatever the schedule says
+
+	// Delivery zone: the polygon wins over the radius when it is set
+	DeliveryRadiusKm float64
+	DeliveryPolygon  []pkg.Point
+
+	// Only set by GetRestaurants when searching around a point
+	DistanceKm float64
+
+	// Only set by Search
+	Rank float64
+}
+
+// searchQuery matches a user's search text in either language.
+const searchQuery = `websearch_to_tsquery('russian', $1) || websearch_to_tsquery('english', $1)`
+
+// searchHits scores every restaurant that matches itself or through one of
+// its menu items by its best match.
+const searchHits = `
+	WITH q AS (SELECT ` + searchQuery + ` AS query),
+	hits AS (
+		SELECT id, ts_rank(search_vector, q.query) AS rank
+		FROM restaurants, q
+		WHERE search_vector @@ q.query
+		UNION ALL
+		SELECT restaurant_id, ts_rank(search_vector, q.query)
+		FROM menu_items, q
+		WHERE search_vector @@ q.query
+	),
+	scores AS (
+		SELECT id, MAX(rank) AS rank FROM hits GROUP BY id
+	)
+`
+
+const restaurantColumns = `
+	id, name, COALESCE(description, ''), address, phone, latitude, longitude,
+	COALESCE(logo_url, ''), opening_time::text, closing_time::text,
+	to_char(created_at, 'YYYY-MM-DD HH24:MI:SS'),
+	to_char(updated_at, 'YYYY-MM-DD HH24:MI:SS'),
+	delivery_radius_km, delivery_polygon, COALESCE(owner_user_id, ''),
+	timezone, is_paused
+`
+
+// RestaurantFilter narrows down GetRestaurants.
+type RestaurantFilter struct {
+	Origin     *pkg.Point // fills in DistanceKm and sorts nearest first
+	RadiusKm   float64    // with Origin, skips restaurants farther away; 0 means no limit
+	DeliversTo *pkg.Point // only restaurants whose delivery zone covers this point
+}
+
+// haversineSQL is the great-circle distance in km from the point in the
+// given placeholders to the restaurant, matching pkg.HaversineKm.
+func haversineSQL(lat, lng string) string {
+	return fmt.Sprintf(`(6371 * 2 * ASIN(SQRT(
+		POWER(SIN(RADIANS(latitude::float8 - %[1]s::float8) / 2), 2) +
+		COS(RADIANS(%[1]s::float8)) * COS(RADIANS(latitude::float8)) *
+		POWER(SIN(RADIANS(longitude::float8 - %[2]s::float8) / 2), 2)
+	)))`, lat, lng)
+}
+
+// deliversToSQL reports whether the point in the given placeholders is inside
+// the restaurant's delivery zone, matching the ray casting of
+// pkg.PointInPolygon for polygons of three or more vertices and the radius
+// around the restaurant otherwise.
+func deliversToSQL(lat, lng string) string {
+	return fmt.Sprintf(`(CASE
+		WHEN jsonb_typeof(delivery_polygon) = 'array' AND jsonb_array_length(delivery_polygon) >= 3 THEN (
+			SELECT COUNT(*) %% 2 = 1
+			FROM jsonb_array_elements(delivery_polygon) WITH ORDINALITY AS a(point, i)
+			JOIN jsonb_array_elements(delivery_polygon) WITH ORDINALITY AS b(point, j)
+			  ON b.j = CASE WHEN a.i = 1 THEN jsonb_array_length(delivery_polygon) ELSE a.i - 1 END
+			WHERE ((a.point->>'lat')::float8 > %[1]s::float8) <> ((b.point->>'lat')::float8 > %[1]s::float8)
+			  AND %[2]s::float8 < ((b.point->>'lng')::float8 - (a.point->>'lng')::float8)
+			      * (%[1]s::float8 - (a.point->>'lat')::float8)
+			      / NULLIF((b.point->>'lat')::float8 - (a.point->>'lat')::float8, 0)
+			      + (a.point->>'lng')::float8
+		)
+		ELSE %[3]s <= delivery_radius_km
+	END)`, lat, lng, haversineSQL(lat, lng))
+}
+
+// build returns the distance expression, the WHERE clause and their arguments.
+func (f RestaurantFilter) build() (string, string, []any) {
+	var args []any
+	distance := "0::float8"
+	conditions := []string{"TRUE"}
+
+	if f.Origin != nil {
+		args = append(args, f.Origin.Lat, f.Origin.Lng)
+		distance = haversineSQL("$1", "$2")
+
+		if f.RadiusKm > 0 {
+			args = append(args, f.RadiusKm)
+			conditions = append(conditions, fmt.Sprintf("%s <= $%d", distance, len(args)))
+		}
+	}
+
+	if f.DeliversTo != nil {
+		args = append(args, f.DeliversTo.Lat, f.DeliversTo.Lng)
+		n := len(args)
+		conditions = append(conditions, deliversToSQL(fmt.Sprintf("$%d", n-1), fmt.Sprintf("$%d", n)))
+	}
+
+	return distance, strings.Join(conditions, " AND "), args
 }
 
-func (r *RestaurantRepository) GetRestaurants(ctx context.Context, offset int32, limit int32) ([]*Restaurant, error) {
+// RestaurantCursor is where a page of GetRestaurants ended. Restaurants are
+// sorted by distance, newest first, then by id.
+type RestaurantCursor struct {
+	DistanceKm float64   `json:"d"`
+	CreatedAt  time.Time `json:"t"`
+	ID         string    `json:"i"`
+}
+
+// GetRestaurants returns up to limit restaurants after the cursor, or from
+// the start when after is nil, and the cursor of the next page, which is nil
+// on the last page.
+func (r *RestaurantRepository) GetRestaurants(ctx context.Context, filter RestaurantFilter, after *RestaurantCursor, limit int32) ([]*Restaurant, *RestaurantCursor, error) {
 	var restaurants []*Restaurant
+	var cursors []*RestaurantCursor
 
-	query := `
-		SELECT id, name, COALESCE(description, ''), address, phone, latitude, longitude, 
-		       COALESCE(logo_url, ''), opening_time::text, closing_time::text, 
-		       to_char(created_at, 'YYYY-MM-DD HH24:MI:SS'), 
-		       to_char(updated_at, 'YYYY-MM-DD HH24:MI:SS')
+	distance, where, args := filter.build()
+	if after != nil {
+		args = append(args, after.DistanceKm, after.CreatedAt, after.ID)
+		n := len(args)
+		where += fmt.Sprintf(` AND (%[1]s > $%[2]d OR (%[1]s = $%[2]d AND (
+			created_at < $%[3]d OR (created_at = $%[3]d AND id > $%[4]d::uuid))))`, distance, n-2, n-1, n)
+	}
+
+	// One extra row tells whether there is a next page
+	query := fmt.Sprintf(`
+		SELECT %s, %s AS distance_km, created_at
 		FROM restaurants
-		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2
-	`
+		WHERE %s
+		ORDER BY distance_km, created_at DESC, id
+		LIMIT $%d
+	`, restaurantColumns, distance, where, len(args)+1)
 
-	rows, err := r.db.Query(ctx, query, limit, offset)
+	rows, err := r.db.Query(ctx, query, append(args, limit+1)...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query restaurants: %w", err)
+		return nil, nil, fmt.Errorf("failed to query restaurants: %w", err)
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		var restaurant Restaurant
-		err := rows.Scan(
-			&restaurant.ID, &restaurant.Name, &restaurant.Description,
-			&restaurant.Address, &restaurant.Phone, &restaurant.Latitude,
-			&restaurant.Longitude, &restaurant.LogoURL, &restaurant.OpeningTime,
-			&restaurant.ClosingTime, &restaurant.CreatedAt, &restaurant.UpdatedAt,
-		)
+		var cursor RestaurantCursor
+		restaurant, err := scanRestaurant(rows, &cursor.DistanceKm, &cursor.CreatedAt)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan restaurant: %w", err)
+			return nil, nil, fmt.Errorf("failed to scan restaurant: %w", err)
 		}
-		restaurants = append(restaurants, &restaurant)
+		restaurant.DistanceKm = cursor.DistanceKm
+		cursor.ID = restaurant.ID
+		restaurants = append(restaurants, restaurant)
+		cursors = append(cursors, &cursor)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating restaurants: %w", err)
+		return nil, nil, fmt.Errorf("error iterating restaurants: %w", err)
 	}
 
-	return restaurants, nil
+	if len(restaurants) <= int(limit) {
+		return restaurants, nil, nil
+	}
+	return restaurants[:limit], cursors[limit-1], nil
 }
 
-func (r *RestaurantRepository) GetRestaurantsCount(ctx context.Context) (int32, error) {
-	var count int32
-	query := `SELECT COUNT(*) FROM restaurants`
-	err := r.db.QueryRow(ctx, query).Scan(&count)
+// Search ranks restaurants by full-text relevance, best match first.
+// SearchCursor is where a page of Search ended. Results are sorted by rank,
+// best first, then by name and id.
+type SearchCursor struct {
+	Rank float32 `json:"r"`
+	Name string  `json:"n"`
+	ID   string  `json:"i"`
+}
+
+// Search returns up to limit restaurants matching text after the cursor, or
+// from the start when after is nil, and the cursor of the next page, which
+// is nil on the last page.
+func (r *RestaurantRepository) Search(ctx context.Context, text string, after *SearchCursor, limit int32) ([]*Restaurant, *SearchCursor, error) {
+	var restaurants []*Restaurant
+	var cursors []*SearchCursor
+
+	args := []any{text}
+	where := "TRUE"
+	if after != nil {
+		args = append(args, after.Rank, after.Name, after.ID)
+		where = `scores.rank < $2::real OR (scores.rank = $2::real AND (
+			name > $3 OR (name = $3 AND id > $4::uuid)))`
+	}
+
+	// One extra row tells whether there is a next page
+	query := searchHits + fmt.Sprintf(`
+		SELECT `+restaurantColumns+`, scores.rank
+		FROM restaurants
+		JOIN scores USING (id)
+		WHERE %s
+		ORDER BY scores.rank DESC, name, id
+		LIMIT $%d
+	`, where, len(args)+1)
+
+	rows, err := r.db.Query(ctx, query, append(args, limit+1)...)
 	if err != nil {
-		return 0, fmt.Errorf("failed to count restaurants: %w", err)
+		return nil, nil, fmt.Errorf("failed to search restaurants: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cursor SearchCursor
+		restaurant, err := scanRestaurant(rows, &cursor.Rank)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to scan restaurant: %w", err)
+		}
+		restaurant.Rank = float64(cursor.Rank)
+		cursor.Name = restaurant.Name
+		cursor.ID = restaurant.ID
+		restaurants = append(restaurants, restaurant)
+		cursors = append(cursors, &cursor)
 	}
-	return count, nil
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("error iterating restaurants: %w", err)
+	}
+
+	if len(restaurants) <= int(limit) {
+		return restaurants, nil, nil
+	}
+	return restaurants[:limit], cursors[limit-1], nil
 }
 
 func (r *RestaurantRepository) GetRestaurant(ctx context.Context, id string) (*Restaurant, error) {
-	var restaurant Restaurant
-
 	query := `
-		SELECT id, name, COALESCE(description, ''), address, phone, latitude, longitude, 
-		       COALESCE(logo_url, ''), opening_time::text, closing_time::text, 
-		       to_char(created_at, 'YYYY-MM-DD HH24:MI:SS'), 
-		       to_char(updated_at, 'YYYY-MM-DD HH24:MI:SS')
+		SELECT ` + restaurantColumns + `
 		FROM restaurants
 		WHERE id = $1
 	`
 
-	err := r.db.QueryRow(ctx, query, id).Scan(
-		&restaurant.ID, &restaurant.Name, &restaurant.Description,
-		&restaurant.Address, &restaurant.Phone, &restaurant.Latitude,
-		&restaurant.Longitude, &restaurant.LogoURL, &restaurant.OpeningTime,
-		&restaurant.ClosingTime, &restaurant.CreatedAt, &restaurant.UpdatedAt,
-	)
-
+	restaurant, err := scanRestaurant(r.db.QueryRow(ctx, query, id))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get restaurant: %w", err)
 	}
 
-	return &restaurant, nil
+	return restaurant, nil
 }
 
-func (r *RestaurantRepository) GetRestaurantStatus(ctx context.Context, restaurantID string) (*Restaurant, error) {
-	var restaurant Restaurant
+func (r *RestaurantRepository) CreateRestaurant(ctx context.Context, restaurant *Restaurant) error {
+	query := `
+		INSERT INTO restaurants (id, name, description, address, phone, latitude, longitude,
+		                         logo_url, opening_time, closing_time, delivery_radius_km, delivery_polygon,
+		                         owner_user_id, timezone, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9::text::time, $10::text::time, $11, $12, NULLIF($13, ''), $14, NOW(), NOW())
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		restaurant.ID, restaurant.Name, restaurant.Description, restaurant.Address, restaurant.Phone,
+		restaurant.Latitude, restaurant.Longitude, restaurant.LogoURL,
+		restaurant.OpeningTime, restaurant.ClosingTime,
+		restaurant.DeliveryRadiusKm, polygonParam(restaurant.DeliveryPolygon), restaurant.OwnerUserID,
+		restaurant.Timezone,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create restaurant: %w", err)
+	}
+
+	return nil
+}
 
+// UpdateRestaurant overwrites every editable column with the values in restaurant.
+func (r *RestaurantRepository) UpdateRestaurant(ctx context.Context, restaurant *Restaurant) error {
 	query := `
-		SELECT id, opening_time, closing_time
-		FROM restaurants
-		WHERE id = $1
+		UPDATE restaurants
+		SET name = $1, description = $2, address = $3, phone = $4, latitude = $5, longitude = $6,
+		    logo_url = $7, opening_time = $8::text::time, closing_time = $9::text::time,
+		    delivery_radius_km = $10, delivery_polygon = $11, timezone = $12, updated_at = NOW()
+		WHERE id = $13
 	`
 
-	err := r.db.QueryRow(ctx, query, restaurantID).Scan(
-		&restaurant.ID,
-		&restaurant.OpeningTime, &restaurant.ClosingTime,
+	_, err := r.db.Exec(ctx, query,
+		restaurant.Name, restaurant.Description, restaurant.Address, restaurant.Phone,
+		restaurant.Latitude, restaurant.Longitude, restaurant.LogoURL,
+		restaurant.OpeningTime, restaurant.ClosingTime,
+		restaurant.DeliveryRadiusKm, polygonParam(restaurant.DeliveryPolygon), restaurant.Timezone,
+		restaurant.ID,
 	)
+	if err != nil {
+		return fmt.Errorf("failed to update restaurant: %w", err)
+	}
+
+	return nil
+}
 
+// polygonParam stores a missing polygon as NULL rather than JSON null.
+func polygonParam(polygon []pkg.Point) any {
+	if len(polygon) == 0 {
+		return nil
+	}
+	return polygon
+}
+
+func (r *RestaurantRepository) SetPaused(ctx context.Context, id string, paused bool) error {
+	query := `UPDATE restaurants SET is_paused = $1, updated_at = NOW() WHERE id = $2`
+
+	_, err := r.db.Exec(ctx, query, paused, id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get restaurant status: %w", err)
+		return fmt.Errorf("failed to set restaurant pause: %w", err)
 	}
 
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanRestaurant reads restaurantColumns followed by any extra columns.
+func scanRestaurant(row rowScanner, extra ...any) (*Restaurant, error) {
+	var restaurant Restaurant
+	dest := []any{
+		&restaurant.ID, &restaurant.Name, &restaurant.Description,
+		&restaurant.Address, &restaurant.Phone, &restaurant.Latitude,
+		&restaurant.Longitude, &restaurant.LogoURL, &restaurant.OpeningTime,
+		&restaurant.ClosingTime, &restaurant.CreatedAt, &restaurant.UpdatedAt,
+		&restaurant.DeliveryRadiusKm, &restaurant.DeliveryPolygon, &restaurant.OwnerUserID,
+		&restaurant.Timezone, &restaurant.IsPaused,
+	}
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return nil, err
+	}
 	return &restaurant, nil
 }
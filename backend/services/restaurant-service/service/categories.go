@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getGroupedMenu returns the whole menu by category in display order.
+// Categories without items are left out.
+func (s *RestaurantService) getGroupedMenu(ctx context.Context, restaurantID string) (*pb.GetMenuResponse, error) {
+	items, err := s.menuItemRepo.GetAllMenuItems(ctx, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get menu items: %v", err)
+	}
+
+	categories, err := s.categoryRepo.GetCategories(ctx, restaurantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get menu categories: %v", err)
+	}
+
+	pbItems, err := s.withOptions(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	byCategory := make(map[string][]*pb.MenuItem)
+	for _, item := range pbItems {
+		byCategory[item.CategoryId] = append(byCategory[item.CategoryId], item)
+	}
+
+	pbCategories := make([]*pb.MenuCategory, 0, len(categories)+1)
+	for _, category := range categories {
+		if len(byCategory[category.ID]) == 0 {
+			continue
+		}
+		pbCategory := toPbMenuCategory(category)
+		pbCategory.Items = byCategory[category.ID]
+		pbCategories = append(pbCategories, pbCategory)
+	}
+
+	if uncategorized := byCategory[""]; len(uncategorized) > 0 {
+		pbCategories = append(pbCategories, &pb.MenuCategory{
+			Position: int32(len(categories)),
+			Items:    uncategorized,
+		})
+	}
+
+	return &pb.GetMenuResponse{
+		Categories: pbCategories,
+	}, nil
+}
+
+func (s *RestaurantService) ReorderMenuCategories(ctx context.Context, req *pb.ReorderMenuCategoriesRequest) (*pb.ReorderMenuCategoriesResponse, error) {
+	restaurant, err := s.getManagedRestaurant(ctx, req.Actor, req.RestaurantId)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := s.categoryRepo.GetCategories(ctx, restaurant.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get menu categories: %w", err)
+	}
+
+	known := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		known[category.ID] = true
+	}
+
+	if err := checkOrder(req.CategoryIds, known, "category"); err != nil {
+		return nil, err
+	}
+
+	if err := s.categoryRepo.Reorder(ctx, restaurant.ID, req.CategoryIds); err != nil {
+		return nil, err
+	}
+
+	categories, err = s.categoryRepo.GetCategories(ctx, restaurant.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get menu categories: %w", err)
+	}
+
+	pbCategories := make([]*pb.MenuCategory, len(categories))
+	for i, category := range categories {
+		pbCategories[i] = toPbMenuCategory(category)
+	}
+
+	return &pb.ReorderMenuCategoriesResponse{
+		Categories: pbCategories,
+	}, nil
+}
+
+func (s *RestaurantService) ReorderMenuItems(ctx context.Context, req *pb.ReorderMenuItemsRequest) (*pb.ReorderMenuItemsResponse, error) {
+	restaurant, err := s.getManagedRestaurant(ctx, req.Actor, req.RestaurantId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.CategoryId != "" {
+		categories, err := s.categoryRepo.GetCategories(ctx, restaurant.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get menu categories: %w", err)
+		}
+
+		found := slices.ContainsFunc(categories, func(category *repository.MenuCategory) bool {
+			return category.ID == req.CategoryId
+		})
+		if !found {
+			return nil, status.Error(codes.NotFound, "menu category not found")
+		}
+	}
+
+	items, err := s.menuItemRepo.GetAllMenuItems(ctx, restaurant.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get menu items: %w", err)
+	}
+
+	known := make(map[string]bool)
+	for _, item := range items {
+		if item.CategoryID == req.CategoryId {
+			known[item.ID] = true
+		}
+	}
+
+	if err := checkOrder(req.ItemIds, known, "menu item"); err != nil {
+		return nil, err
+	}
+
+	if err := s.menuItemRepo.ReorderItems(ctx, restaurant.ID, req.CategoryId, req.ItemIds); err != nil {
+		return nil, err
+	}
+
+	items, err = s.menuItemRepo.GetAllMenuItems(ctx, restaurant.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get menu items: %w", err)
+	}
+
+	var reordered []*repository.MenuItem
+	for _, item := range items {
+		if item.CategoryID == req.CategoryId {
+			reordered = append(reordered, item)
+		}
+	}
+
+	pbItems, err := s.withOptions(ctx, reordered)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReorderMenuItemsResponse{
+		Items: pbItems,
+	}, nil
+}
+
+// checkOrder makes sure every id is known and listed only once
+func checkOrder(ids []string, known map[string]bool, what string) error {
+	if len(ids) == 0 {
+		return status.Errorf(codes.InvalidArgument, "list the %s ids in their new order", what)
+	}
+
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if !known[id] {
+			return status.Errorf(codes.InvalidArgument, "unknown %s id %s", what, id)
+		}
+		if seen[id] {
+			return status.Errorf(codes.InvalidArgument, "%s id %s is listed more than once", what, id)
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+func toPbMenuCategory(category *repository.MenuCategory) *pb.MenuCategory {
+	return &pb.MenuCategory{
+		Id:       category.ID,
+		Name:     category.Name,
+		Position: category.Position,
+	}
+}
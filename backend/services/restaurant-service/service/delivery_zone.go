@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kimashii-dan/food-delivery-app/backend/pkg"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/repository"
+	userpb "github.com/kimashii-dan/food-delivery-app/backend/services/user-service/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *RestaurantService) CheckDeliverable(ctx context.Context, req *pb.CheckDeliverableRequest) (*pb.CheckDeliverableResponse, error) {
+	if req.RestaurantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "restaurant id is required")
+	}
+
+	if !validCoordinates(req.Latitude, req.Longitude) {
+		return nil, status.Error(codes.InvalidArgument, "invalid coordinates")
+	}
+
+	restaurant, err := s.restaurantRepo.GetRestaurant(ctx, req.RestaurantId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "restaurant not found")
+		}
+		return nil, fmt.Errorf("failed to get restaurant: %w", err)
+	}
+
+	deliverable, distanceKm := delivers(restaurant, req.Latitude, req.Longitude)
+
+	return &pb.CheckDeliverableResponse{
+		Deliverable: deliverable,
+		DistanceKm:  distanceKm,
+	}, nil
+}
+
+// deliveryPoint returns the position of the user's address, for filtering
+// restaurants by their delivery zone.
+func (s *RestaurantService) deliveryPoint(ctx context.Context, addressID, userID string) (*pkg.Point, error) {
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required to filter by address")
+	}
+
+	addressResp, err := s.userClient.GetAddress(ctx, &userpb.GetAddressRequest{
+		AddressId: addressID,
+		UserId:    userID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.NotFound, "address not found")
+		}
+		return nil, fmt.Errorf("failed to get address: %w", err)
+	}
+
+	return &pkg.Point{Lat: addressResp.Address.Latitude, Lng: addressResp.Address.Longitude}, nil
+}
+
+// delivers reports whether the point is inside the restaurant's delivery
+// zone, along with its distance from the restaurant.
+func delivers(restaurant *repository.Restaurant, lat, lng float64) (bool, float64) {
+	distanceKm := pkg.HaversineKm(restaurant.Latitude, restaurant.Longitude, lat, lng)
+
+	if len(restaurant.DeliveryPolygon) >= 3 {
+		return pkg.PointInPolygon(pkg.Point{Lat: lat, Lng: lng}, restaurant.DeliveryPolygon), distanceKm
+	}
+
+	return distanceKm <= restaurant.DeliveryRadiusKm, distanceKm
+}
+
+func validCoordinates(lat, lng float64) bool {
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
@@ -0,0 +1,408 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/kimashii-dan/food-delivery-app/backend/pkg"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Roles allowed to manage restaurants, as stored on users in user-service
+const (
+	RoleRestaurant = "restaurant"
+	RoleAdmin      = "admin"
+)
+
+func (s *RestaurantService) CreateRestaurant(ctx context.Context, req *pb.CreateRestaurantRequest) (*pb.CreateRestaurantResponse, error) {
+	actor := req.GetActor()
+	if actor.GetUserId() == "" {
+		return nil, status.Error(codes.Unauthenticated, "actor is required")
+	}
+
+	ownerUserID := actor.UserId
+	switch actor.Role {
+	case RoleAdmin:
+		// Admins set up restaurants on behalf of their owners, and a
+		// restaurant without an owner could only ever be managed by admins
+		if req.OwnerUserId == "" {
+			return nil, status.Error(codes.InvalidArgument, "owner_user_id is required when an admin creates a restaurant")
+		}
+		ownerUserID = req.OwnerUserId
+	case RoleRestaurant:
+		if req.OwnerUserId != "" && req.OwnerUserId != actor.UserId {
+			return nil, status.Error(codes.PermissionDenied, "restaurant users can only create restaurants they own")
+		}
+	default:
+		return nil, status.Error(codes.PermissionDenied, "only restaurant users and admins can create restaurants")
+	}
+
+	restaurant := &repository.Restaurant{
+		ID:               uuid.New().String(),
+		Name:             strings.TrimSpace(req.Name),
+		Description:      req.Description,
+		Address:          strings.TrimSpace(req.Address),
+		Phone:            strings.TrimSpace(req.Phone),
+		Latitude:         req.Latitude,
+		Longitude:        req.Longitude,
+		LogoURL:          req.LogoUrl,
+		OpeningTime:      req.OpeningTime,
+		ClosingTime:      req.ClosingTime,
+		DeliveryRadiusKm: req.DeliveryRadiusKm,
+		DeliveryPolygon:  toPoints(req.DeliveryPolygon),
+		OwnerUserID:      ownerUserID,
+		Timezone:         strings.TrimSpace(req.Timezone),
+	}
+
+	if restaurant.DeliveryRadiusKm == 0 {
+		restaurant.DeliveryRadiusKm = 5
+	}
+	if restaurant.Timezone == "" {
+		restaurant.Timezone = DefaultTimezone
+	}
+
+	if err := validateRestaurant(restaurant); err != nil {
+		return nil, err
+	}
+
+	if err := s.restaurantRepo.CreateRestaurant(ctx, restaurant); err != nil {
+		return nil, fmt.Errorf("failed to create restaurant: %w", err)
+	}
+
+	created, err := s.restaurantRepo.GetRestaurant(ctx, restaurant.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get created restaurant: %w", err)
+	}
+
+	return &pb.CreateRestaurantResponse{
+		Restaurant: toPbRestaurant(created),
+	}, nil
+}
+
+func (s *RestaurantService) UpdateRestaurant(ctx context.Context, req *pb.UpdateRestaurantRequest) (*pb.UpdateRestaurantResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "restaurant id is required")
+	}
+
+	restaurant, err := s.getManagedRestaurant(ctx, req.Actor, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		restaurant.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		restaurant.Description = *req.Description
+	}
+	if req.Address != nil {
+		restaurant.Address = strings.TrimSpace(*req.Address)
+	}
+	if req.Phone != nil {
+		restaurant.Phone = strings.TrimSpace(*req.Phone)
+	}
+	if req.Latitude != nil {
+		restaurant.Latitude = *req.Latitude
+	}
+	if req.Longitude != nil {
+		restaurant.Longitude = *req.Longitude
+	}
+	if req.LogoUrl != nil {
+		restaurant.LogoURL = *req.LogoUrl
+	}
+	if req.OpeningTime != nil {
+		restaurant.OpeningTime = *req.OpeningTime
+	}
+	if req.ClosingTime != nil {
+		restaurant.ClosingTime = *req.ClosingTime
+	}
+	if req.DeliveryRadiusKm != nil {
+		restaurant.DeliveryRadiusKm = *req.DeliveryRadiusKm
+	}
+	if req.DeliveryPolygon != nil {
+		restaurant.DeliveryPolygon = toPoints(req.DeliveryPolygon.Points)
+	}
+	if req.Timezone != nil {
+		restaurant.Timezone = strings.TrimSpace(*req.Timezone)
+	}
+
+	if err := validateRestaurant(restaurant); err != nil {
+		return nil, err
+	}
+
+	if err := s.restaurantRepo.UpdateRestaurant(ctx, restaurant); err != nil {
+		return nil, fmt.Errorf("failed to update restaurant: %w", err)
+	}
+
+	updated, err := s.restaurantRepo.GetRestaurant(ctx, restaurant.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get updated restaurant: %w", err)
+	}
+
+	return &pb.UpdateRestaurantResponse{
+		Restaurant: toPbRestaurant(updated),
+	}, nil
+}
+
+func (s *RestaurantService) CreateMenuItem(ctx context.Context, req *pb.CreateMenuItemRequest) (*pb.CreateMenuItemResponse, error) {
+	if req.RestaurantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "restaurant id is required")
+	}
+
+	if _, err := s.getManagedRestaurant(ctx, req.Actor, req.RestaurantId); err != nil {
+		return nil, err
+	}
+
+	item := &repository.MenuItem{
+		ID:           uuid.New().String(),
+		RestaurantID: req.RestaurantId,
+		Name:         strings.TrimSpace(req.Name),
+		Description:  req.Description,
+		Price:        req.Price,
+		ImageURL:     req.ImageUrl,
+		IsAvailable:  req.IsAvailable == nil || *req.IsAvailable,
+		Category:     strings.TrimSpace(req.Category),
+		ExternalSKU:  strings.TrimSpace(req.ExternalSku),
+	}
+
+	if err := validateMenuItem(item); err != nil {
+		return nil, err
+	}
+
+	if err := s.menuItemRepo.CreateMenuItem(ctx, item); err != nil {
+		if isUniqueViolation(err) {
+			return nil, status.Error(codes.AlreadyExists, "another menu item already uses this sku")
+		}
+		return nil, fmt.Errorf("failed to create menu item: %w", err)
+	}
+
+	created, err := s.menuItemRepo.GetMenuItem(ctx, item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get created menu item: %w", err)
+	}
+
+	return &pb.CreateMenuItemResponse{
+		Item: toPbMenuItem(created),
+	}, nil
+}
+
+func (s *RestaurantService) UpdateMenuItem(ctx context.Context, req *pb.UpdateMenuItemRequest) (*pb.UpdateMenuItemResponse, error) {
+	item, err := s.getManagedMenuItem(ctx, req.Actor, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		item.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		item.Description = *req.Description
+	}
+	if req.Price != nil {
+		item.Price = *req.Price
+	}
+	if req.ImageUrl != nil {
+		item.ImageURL = *req.ImageUrl
+	}
+	if req.Category != nil {
+		item.Category = strings.TrimSpace(*req.Category)
+	}
+	if req.IsAvailable != nil {
+		item.IsAvailable = *req.IsAvailable
+	}
+	if req.ExternalSku != nil {
+		item.ExternalSKU = strings.TrimSpace(*req.ExternalSku)
+	}
+
+	if err := validateMenuItem(item); err != nil {
+		return nil, err
+	}
+
+	if err := s.menuItemRepo.UpdateMenuItem(ctx, item); err != nil {
+		if isUniqueViolation(err) {
+			return nil, status.Error(codes.AlreadyExists, "another menu item already uses this sku")
+		}
+		return nil, fmt.Errorf("failed to update menu item: %w", err)
+	}
+
+	updated, err := s.menuItemRepo.GetMenuItem(ctx, item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get updated menu item: %w", err)
+	}
+
+	return &pb.UpdateMenuItemResponse{
+		Item: toPbMenuItem(updated),
+	}, nil
+}
+
+func (s *RestaurantService) DeleteMenuItem(ctx context.Context, req *pb.DeleteMenuItemRequest) (*pb.DeleteMenuItemResponse, error) {
+	item, err := s.getManagedMenuItem(ctx, req.Actor, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.menuItemRepo.DeleteMenuItem(ctx, item.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete menu item: %w", err)
+	}
+
+	return &pb.DeleteMenuItemResponse{}, nil
+}
+
+func (s *RestaurantService) SetItemAvailability(ctx context.Context, req *pb.SetItemAvailabilityRequest) (*pb.SetItemAvailabilityResponse, error) {
+	item, err := s.getManagedMenuItem(ctx, req.Actor, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.menuItemRepo.SetAvailability(ctx, item.ID, req.IsAvailable); err != nil {
+		return nil, fmt.Errorf("failed to set menu item availability: %w", err)
+	}
+
+	updated, err := s.menuItemRepo.GetMenuItem(ctx, item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get updated menu item: %w", err)
+	}
+
+	return &pb.SetItemAvailabilityResponse{
+		Item: toPbMenuItem(updated),
+	}, nil
+}
+
+// getManagedRestaurant loads a restaurant the actor is allowed to change:
+// admins can change any restaurant, restaurant users only the ones they own.
+func (s *RestaurantService) getManagedRestaurant(ctx context.Context, actor *pb.Actor, restaurantID string) (*repository.Restaurant, error) {
+	if actor.GetUserId() == "" {
+		return nil, status.Error(codes.Unauthenticated, "actor is required")
+	}
+
+	if actor.Role != RoleAdmin && actor.Role != RoleRestaurant {
+		return nil, status.Error(codes.PermissionDenied, "only restaurant users and admins can manage restaurants")
+	}
+
+	restaurant, err := s.restaurantRepo.GetRestaurant(ctx, restaurantID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "restaurant not found")
+		}
+		return nil, fmt.Errorf("failed to get restaurant: %w", err)
+	}
+
+	if actor.Role != RoleAdmin && restaurant.OwnerUserID != actor.UserId {
+		return nil, status.Error(codes.PermissionDenied, "you do not own this restaurant")
+	}
+
+	return restaurant, nil
+}
+
+// getManagedMenuItem loads a menu item whose restaurant the actor may change.
+func (s *RestaurantService) getManagedMenuItem(ctx context.Context, actor *pb.Actor, itemID string) (*repository.MenuItem, error) {
+	if itemID == "" {
+		return nil, status.Error(codes.InvalidArgument, "menu item id is required")
+	}
+
+	item, err := s.menuItemRepo.GetMenuItem(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "menu item not found")
+		}
+		return nil, fmt.Errorf("failed to get menu item: %w", err)
+	}
+
+	if _, err := s.getManagedRestaurant(ctx, actor, item.RestaurantID); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func validateRestaurant(restaurant *repository.Restaurant) error {
+	if restaurant.Name == "" || restaurant.Address == "" || restaurant.Phone == "" {
+		return status.Error(codes.InvalidArgument, "name, address and phone are required")
+	}
+
+	if !validCoordinates(restaurant.Latitude, restaurant.Longitude) {
+		return status.Error(codes.InvalidArgument, "invalid coordinates")
+	}
+
+	openingTime, err := normalizeTime(restaurant.OpeningTime)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "opening time must look like 09:00")
+	}
+	closingTime, err := normalizeTime(restaurant.ClosingTime)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "closing time must look like 23:00")
+	}
+	restaurant.OpeningTime = openingTime
+	restaurant.ClosingTime = closingTime
+
+	if _, err := loadTimezone(restaurant.Timezone); err != nil {
+		return status.Errorf(codes.InvalidArgument, "unknown timezone %q, use an IANA name like Asia/Almaty", restaurant.Timezone)
+	}
+
+	if restaurant.DeliveryRadiusKm <= 0 {
+		return status.Error(codes.InvalidArgument, "delivery radius must be positive")
+	}
+
+	if len(restaurant.DeliveryPolygon) > 0 && len(restaurant.DeliveryPolygon) < 3 {
+		return status.Error(codes.InvalidArgument, "delivery polygon needs at least three points")
+	}
+	for _, p := range restaurant.DeliveryPolygon {
+		if !validCoordinates(p.Lat, p.Lng) {
+			return status.Error(codes.InvalidArgument, "invalid delivery polygon coordinates")
+		}
+	}
+
+	return nil
+}
+
+func validateMenuItem(item *repository.MenuItem) error {
+	if item.Name == "" {
+		return status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	if item.Price <= 0 {
+		return status.Error(codes.InvalidArgument, "price must be positive")
+	}
+
+	if len(item.Category) > 50 {
+		return status.Error(codes.InvalidArgument, "category is too long")
+	}
+
+	if len(item.ExternalSKU) > 100 {
+		return status.Error(codes.InvalidArgument, "sku is too long")
+	}
+
+	return nil
+}
+
+// normalizeTime accepts HH:MM or HH:MM:SS and returns HH:MM:SS.
+func normalizeTime(value string) (string, error) {
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Format("15:04:05"), nil
+		}
+	}
+	return "", fmt.Errorf("invalid time %q", value)
+}
+
+func toPoints(points []*pb.GeoPoint) []pkg.Point {
+	result := make([]pkg.Point, len(points))
+	for i, p := range points {
+		result[i] = pkg.Point{Lat: p.Latitude, Lng: p.Longitude}
+	}
+	return result
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
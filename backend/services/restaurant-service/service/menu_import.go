@@ -0,0 +1,368 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Menu file formats
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+const maxImportRows = 5000
+
+// menuColumns are the CSV header columns and JSON keys of a menu file
+var menuColumns = []string{"sku", "name", "description", "price", "category", "image_url", "is_available"}
+
+// menuRow is one menu item as it appears in an import or export file.
+type menuRow struct {
+	SKU         string  `json:"sku"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Price       float64 `json:"price"`
+	Category    string  `json:"category"`
+	ImageURL    string  `json:"image_url"`
+	IsAvailable *bool   `json:"is_available,omitempty"`
+
+	row int32 // CSV line or 1-based JSON index, for error reporting
+}
+
+// ImportMenu creates and updates menu items from a CSV or JSON file, matching
+// existing items on their external SKU. The import is all or nothing: when any
+// row is invalid, or on a dry run, nothing is written and the response only
+// reports what would have happened.
+func (s *RestaurantService) ImportMenu(ctx context.Context, req *pb.ImportMenuRequest) (*pb.ImportMenuResponse, error) {
+	if req.RestaurantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "restaurant id is required")
+	}
+
+	if _, err := s.getManagedRestaurant(ctx, req.Actor, req.RestaurantId); err != nil {
+		return nil, err
+	}
+
+	var rows []*menuRow
+	var rowErrors []*pb.ImportRowError
+	var err error
+
+	switch strings.ToLower(req.Format) {
+	case FormatCSV:
+		rows, rowErrors, err = parseMenuCSV(req.Data)
+	case FormatJSON:
+		rows, err = parseMenuJSON(req.Data)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "format must be csv or json")
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(rows)+len(rowErrors) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "file has no menu items")
+	}
+	if len(rows)+len(rowErrors) > maxImportRows {
+		return nil, status.Errorf(codes.InvalidArgument, "file has more than %d menu items", maxImportRows)
+	}
+
+	existing, err := s.menuItemRepo.GetIDsBySKU(ctx, req.RestaurantId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get menu item skus: %w", err)
+	}
+
+	resp := &pb.ImportMenuResponse{}
+	items := make([]*repository.MenuItem, 0, len(rows))
+	seen := make(map[string]int32)
+
+	for _, row := range rows {
+		errs := validateMenuRow(row)
+		if first, ok := seen[row.SKU]; ok && row.SKU != "" {
+			errs = append(errs, &pb.ImportRowError{
+				Row:     row.row,
+				Field:   "sku",
+				Message: fmt.Sprintf("duplicate sku, first used on row %d", first),
+			})
+		}
+		if len(errs) > 0 {
+			rowErrors = append(rowErrors, errs...)
+			continue
+		}
+		seen[row.SKU] = row.row
+
+		id, ok := existing[row.SKU]
+		if ok {
+			resp.Updated++
+		} else {
+			id = uuid.New().String()
+			resp.Created++
+		}
+
+		items = append(items, &repository.MenuItem{
+			ID:           id,
+			RestaurantID: req.RestaurantId,
+			Name:         row.Name,
+			Description:  row.Description,
+			Price:        row.Price,
+			ImageURL:     row.ImageURL,
+			IsAvailable:  row.IsAvailable == nil || *row.IsAvailable,
+			Category:     row.Category,
+			ExternalSKU:  row.SKU,
+		})
+	}
+
+	resp.Errors = rowErrors
+	if len(rowErrors) > 0 || req.DryRun {
+		return resp, nil
+	}
+
+	if err := s.menuItemRepo.UpsertBySKU(ctx, items); err != nil {
+		return nil, fmt.Errorf("failed to import menu: %w", err)
+	}
+	resp.Applied = true
+
+	return resp, nil
+}
+
+// ExportMenu writes the restaurant's whole menu in the same format ImportMenu
+// reads, so a menu can be exported, edited and imported back.
+func (s *RestaurantService) ExportMenu(ctx context.Context, req *pb.ExportMenuRequest) (*pb.ExportMenuResponse, error) {
+	if req.RestaurantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "restaurant id is required")
+	}
+
+	format := strings.ToLower(req.Format)
+	if format == "" {
+		format = FormatCSV
+	}
+	if format != FormatCSV && format != FormatJSON {
+		return nil, status.Error(codes.InvalidArgument, "format must be csv or json")
+	}
+
+	if _, err := s.getManagedRestaurant(ctx, req.Actor, req.RestaurantId); err != nil {
+		return nil, err
+	}
+
+	items, err := s.menuItemRepo.GetAllMenuItems(ctx, req.RestaurantId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get menu: %w", err)
+	}
+
+	rows := make([]*menuRow, len(items))
+	for i, item := range items {
+		// Items created before imports have no SKU; their id round-trips
+		// through ImportMenu instead
+		sku := item.ExternalSKU
+		if sku == "" {
+			sku = item.ID
+		}
+
+		isAvailable := item.IsAvailable
+		rows[i] = &menuRow{
+			SKU:         sku,
+			Name:        item.Name,
+			Description: item.Description,
+			Price:       item.Price,
+			Category:    item.Category,
+			ImageURL:    item.ImageURL,
+			IsAvailable: &isAvailable,
+		}
+	}
+
+	resp := &pb.ExportMenuResponse{
+		Filename: fmt.Sprintf("menu-%s.%s", req.RestaurantId, format),
+	}
+
+	if format == FormatJSON {
+		resp.ContentType = "application/json"
+		resp.Data, err = json.MarshalIndent(rows, "", "  ")
+	} else {
+		resp.ContentType = "text/csv; charset=utf-8"
+		resp.Data, err = encodeMenuCSV(rows)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode menu: %w", err)
+	}
+
+	return resp, nil
+}
+
+// parseMenuCSV reads a CSV file with a header row. Columns may come in any
+// order; sku, name and price are required, unknown columns are ignored. Rows
+// whose values cannot be parsed are reported as row errors.
+func parseMenuCSV(data []byte) ([]*menuRow, []*pb.ImportRowError, error) {
+	// Spreadsheet exports often start with a UTF-8 byte order mark
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, errors.New("file is empty")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid csv: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"sku", "name", "price"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("csv header is missing the %s column", required)
+		}
+	}
+
+	var rows []*menuRow
+	var rowErrors []*pb.ImportRowError
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid csv: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := &menuRow{row: int32(line)}
+
+		value := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		row.SKU = value("sku")
+		row.Name = value("name")
+		row.Description = value("description")
+		row.Category = value("category")
+		row.ImageURL = value("image_url")
+
+		valid := true
+		if price := value("price"); price != "" {
+			row.Price, err = strconv.ParseFloat(price, 64)
+			if err != nil {
+				rowErrors = append(rowErrors, &pb.ImportRowError{Row: row.row, Field: "price", Message: "price must be a number"})
+				valid = false
+			}
+		}
+		if available := value("is_available"); available != "" {
+			isAvailable, err := strconv.ParseBool(available)
+			if err != nil {
+				rowErrors = append(rowErrors, &pb.ImportRowError{Row: row.row, Field: "is_available", Message: "is_available must be true or false"})
+				valid = false
+			}
+			row.IsAvailable = &isAvailable
+		}
+
+		if valid {
+			rows = append(rows, row)
+		}
+	}
+
+	return rows, rowErrors, nil
+}
+
+// parseMenuJSON reads a JSON array of objects keyed like the CSV columns.
+func parseMenuJSON(data []byte) ([]*menuRow, error) {
+	var rows []*menuRow
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, fmt.Errorf("invalid json: %w", err)
+	}
+
+	for i, row := range rows {
+		if row == nil {
+			return nil, fmt.Errorf("invalid json: item %d is null", i+1)
+		}
+		row.row = int32(i + 1)
+		row.SKU = strings.TrimSpace(row.SKU)
+		row.Name = strings.TrimSpace(row.Name)
+		row.Category = strings.TrimSpace(row.Category)
+		row.ImageURL = strings.TrimSpace(row.ImageURL)
+	}
+
+	return rows, nil
+}
+
+func validateMenuRow(row *menuRow) []*pb.ImportRowError {
+	var errs []*pb.ImportRowError
+	fail := func(field, message string) {
+		errs = append(errs, &pb.ImportRowError{Row: row.row, Field: field, Message: message})
+	}
+
+	if row.SKU == "" {
+		fail("sku", "sku is required")
+	} else if len(row.SKU) > 100 {
+		fail("sku", "sku is too long")
+	}
+
+	if row.Name == "" {
+		fail("name", "name is required")
+	} else if len(row.Name) > 255 {
+		fail("name", "name is too long")
+	}
+
+	if row.Price <= 0 {
+		fail("price", "price must be positive")
+	}
+
+	if len(row.Category) > 50 {
+		fail("category", "category is too long")
+	}
+
+	if len(row.ImageURL) > 500 {
+		fail("image_url", "image url is too long")
+	}
+
+	return errs
+}
+
+func encodeMenuCSV(rows []*menuRow) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write(menuColumns); err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		record := []string{
+			row.SKU,
+			row.Name,
+			row.Description,
+			strconv.FormatFloat(row.Price, 'f', -1, 64),
+			row.Category,
+			row.ImageURL,
+			strconv.FormatBool(row.IsAvailable == nil || *row.IsAvailable),
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
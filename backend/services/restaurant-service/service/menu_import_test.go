@@ -0,0 +1,187 @@
+package service
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
+)
+
+func TestParseMenuCSV(t *testing.T) {
+	yes, no := true, false
+
+	tests := []struct {
+		name       string
+		data       string
+		want       []menuRow
+		wantErrors []string
+		wantErr    string
+	}{
+		{
+			name: "all columns",
+			data: "sku,name,description,price,category,image_url,is_available\n" +
+				"P-1,Pizza,\"Cheese, tomato\",2500,Mains,https://img/p.jpg,true\n",
+			want: []menuRow{{SKU: "P-1", Name: "Pizza", Description: "Cheese, tomato", Price: 2500, Category: "Mains", ImageURL: "https://img/p.jpg", IsAvailable: &yes, row: 2}},
+		},
+		{
+			name: "columns in any order, case and spacing",
+			data: " Price , SKU ,Name,Extra\n1200,  T-1 , Tea ,ignored\n",
+			want: []menuRow{{SKU: "T-1", Name: "Tea", Price: 1200, row: 2}},
+		},
+		{
+			name: "byte order mark",
+			data: "\xef\xbb\xbfsku,name,price\nT-1,Tea,1200\n",
+			want: []menuRow{{SKU: "T-1", Name: "Tea", Price: 1200, row: 2}},
+		},
+		{
+			name: "short rows leave the rest empty",
+			data: "sku,name,price,is_available\nT-1,Tea\n",
+			want: []menuRow{{SKU: "T-1", Name: "Tea", row: 2}},
+		},
+		{
+			name: "quoted line breaks keep the line numbers right",
+			data: "sku,name,price,description\nA,Soup,900,\"hot\nand thick\"\nB,Bread,300,\nC,Cake,x,\n",
+			want: []menuRow{
+				{SKU: "A", Name: "Soup", Price: 900, Description: "hot\nand thick", row: 2},
+				{SKU: "B", Name: "Bread", Price: 300, row: 4},
+			},
+			wantErrors: []string{"5:price"},
+		},
+		{
+			name:       "unparsable values",
+			data:       "sku,name,price,is_available\nA,Soup,cheap,true\nB,Bread,300,maybe\nC,Cake,700,0\n",
+			want:       []menuRow{{SKU: "C", Name: "Cake", Price: 700, IsAvailable: &no, row: 4}},
+			wantErrors: []string{"2:price", "3:is_available"},
+		},
+		{name: "empty file", data: "", wantErr: "file is empty"},
+		{name: "missing column", data: "sku,name\nA,Soup\n", wantErr: "csv header is missing the price column"},
+		{name: "broken quoting", data: "sku,name,price\nA,\"Soup,900\n", wantErr: "invalid csv"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, rowErrors, err := parseMenuCSV([]byte(tt.data))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []menuRow
+			for _, row := range rows {
+				got = append(got, *row)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rows = %+v, want %+v", got, tt.want)
+			}
+
+			if got := rowErrorFields(rowErrors); !slices.Equal(got, tt.wantErrors) {
+				t.Errorf("row errors = %v, want %v", got, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestParseMenuJSON(t *testing.T) {
+	rows, err := parseMenuJSON([]byte(`[{"sku":" A ","name":" Soup ","price":900,"category":" Mains "},{"sku":"B","name":"Bread","price":300,"is_available":false}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	no := false
+	want := []menuRow{
+		{SKU: "A", Name: "Soup", Price: 900, Category: "Mains", row: 1},
+		{SKU: "B", Name: "Bread", Price: 300, IsAvailable: &no, row: 2},
+	}
+	var got []menuRow
+	for _, row := range rows {
+		got = append(got, *row)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rows = %+v, want %+v", got, want)
+	}
+
+	for _, data := range []string{`{"sku":"A"}`, `[{"sku":"A"},null]`, `[{"price":"cheap"}]`} {
+		if _, err := parseMenuJSON([]byte(data)); err == nil {
+			t.Errorf("parseMenuJSON(%s) accepted the file", data)
+		}
+	}
+}
+
+func TestValidateMenuRow(t *testing.T) {
+	valid := menuRow{SKU: "A", Name: "Soup", Price: 900, row: 7}
+
+	tests := []struct {
+		name   string
+		change func(row *menuRow)
+		want   []string
+	}{
+		{name: "valid", change: func(row *menuRow) {}},
+		{name: "no sku", change: func(row *menuRow) { row.SKU = "" }, want: []string{"7:sku"}},
+		{name: "long sku", change: func(row *menuRow) { row.SKU = strings.Repeat("s", 101) }, want: []string{"7:sku"}},
+		{name: "no name", change: func(row *menuRow) { row.Name = "" }, want: []string{"7:name"}},
+		{name: "long name", change: func(row *menuRow) { row.Name = strings.Repeat("n", 256) }, want: []string{"7:name"}},
+		{name: "free", change: func(row *menuRow) { row.Price = 0 }, want: []string{"7:price"}},
+		{name: "negative price", change: func(row *menuRow) { row.Price = -1 }, want: []string{"7:price"}},
+		{name: "long category", change: func(row *menuRow) { row.Category = strings.Repeat("c", 51) }, want: []string{"7:category"}},
+		{name: "long image url", change: func(row *menuRow) { row.ImageURL = strings.Repeat("u", 501) }, want: []string{"7:image_url"}},
+		{
+			name:   "every problem is reported",
+			change: func(row *menuRow) { row.SKU, row.Name, row.Price = "", "", 0 },
+			want:   []string{"7:sku", "7:name", "7:price"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := valid
+			tt.change(&row)
+
+			if got := rowErrorFields(validateMenuRow(&row)); !slices.Equal(got, tt.want) {
+				t.Errorf("errors = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMenuCSVRoundTrip(t *testing.T) {
+	yes, no := true, false
+	rows := []*menuRow{
+		{SKU: "A", Name: "Soup, hot", Description: "with \"croutons\"\nand herbs", Price: 900.5, Category: "Mains", IsAvailable: &yes},
+		{SKU: "B", Name: "Bread", Price: 300, ImageURL: "https://img/b.jpg", IsAvailable: &no},
+	}
+
+	data, err := encodeMenuCSV(rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, rowErrors, err := parseMenuCSV(data)
+	if err != nil || len(rowErrors) > 0 {
+		t.Fatalf("parseMenuCSV = %v, %v", rowErrors, err)
+	}
+	if len(parsed) != len(rows) {
+		t.Fatalf("got %d rows, want %d", len(parsed), len(rows))
+	}
+	for i, row := range parsed {
+		row.row = 0
+		if !reflect.DeepEqual(row, rows[i]) {
+			t.Errorf("row %d = %+v, want %+v", i, row, rows[i])
+		}
+	}
+}
+
+func rowErrorFields(errs []*pb.ImportRowError) []string {
+	var fields []string
+	for _, err := range errs {
+		fields = append(fields, fmt.Sprintf("%d:%s", err.Row, err.Field))
+	}
+	return fields
+}
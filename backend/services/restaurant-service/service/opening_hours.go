@@ -0,0 +1,426 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultTimezone is used for restaurants created without one
+	DefaultTimezone = "Asia/Almaty"
+
+	// scheduleHorizonDays bounds the search for the next opening or closing
+	scheduleHorizonDays = 60
+
+	maxIntervalsPerDay = 10
+	maxHoursExceptions = 100
+	maxHoursNoteLength = 200
+)
+
+func (s *RestaurantService) GetRestaurantStatus(ctx context.Context, req *pb.GetRestaurantStatusRequest) (*pb.GetRestaurantStatusResponse, error) {
+	if req.RestaurantId == "" {
+		return nil, fmt.Errorf("restaurant id is required")
+	}
+
+	restaurant, err := s.restaurantRepo.GetRestaurant(ctx, req.RestaurantId)
+	if err != nil {
+		return nil, fmt.Errorf("restaurant not found: %v", err)
+	}
+
+	loc, err := loadTimezone(restaurant.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone for restaurant %s: %w", restaurant.ID, err)
+	}
+
+	// Start from yesterday, its hours may run past midnight into today
+	now := time.Now().In(loc)
+	from := time.Date(now.Year(), now.Month(), now.Day()-1, 0, 0, 0, 0, loc)
+
+	hours, err := s.hoursRepo.Get(ctx, restaurant.ID, from.Format(time.DateOnly))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get opening hours: %w", err)
+	}
+
+	spans := openSpans(restaurant, hours, from, scheduleHorizonDays+1)
+
+	resp := &pb.GetRestaurantStatusResponse{
+		OpeningTime: restaurant.OpeningTime,
+		ClosingTime: restaurant.ClosingTime,
+		Timezone:    restaurant.Timezone,
+		IsPaused:    restaurant.IsPaused,
+	}
+
+	for _, span := range spans {
+		if !span.start.After(now) && now.Before(span.end) {
+			resp.IsOpen = true
+		}
+		if resp.NextOpenAt == "" && span.start.After(now) {
+			resp.NextOpenAt = span.start.Format(time.RFC3339)
+		}
+		if resp.NextCloseAt == "" && span.end.After(now) {
+			resp.NextCloseAt = span.end.Format(time.RFC3339)
+		}
+	}
+
+	resp.IsAcceptingOrders = resp.IsOpen && !restaurant.IsPaused
+
+	// Nobody knows when a paused restaurant comes back
+	if restaurant.IsPaused {
+		resp.NextOpenAt = ""
+		resp.NextCloseAt = ""
+	}
+
+	return resp, nil
+}
+
+func (s *RestaurantService) GetOpeningHours(ctx context.Context, req *pb.GetOpeningHoursRequest) (*pb.GetOpeningHoursResponse, error) {
+	if req.RestaurantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "restaurant id is required")
+	}
+
+	restaurant, err := s.restaurantRepo.GetRestaurant(ctx, req.RestaurantId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "restaurant not found")
+		}
+		return nil, fmt.Errorf("failed to get restaurant: %w", err)
+	}
+
+	hours, err := s.getOpeningHours(ctx, restaurant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetOpeningHoursResponse{
+		Hours: hours,
+	}, nil
+}
+
+func (s *RestaurantService) SetOpeningHours(ctx context.Context, req *pb.SetOpeningHoursRequest) (*pb.SetOpeningHoursResponse, error) {
+	restaurant, err := s.getManagedRestaurant(ctx, req.Actor, req.RestaurantId)
+	if err != nil {
+		return nil, err
+	}
+
+	hours, err := toOpeningHours(req.Weekly, req.Exceptions)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.hoursRepo.Replace(ctx, restaurant.ID, hours); err != nil {
+		return nil, fmt.Errorf("failed to save opening hours: %w", err)
+	}
+
+	pbHours, err := s.getOpeningHours(ctx, restaurant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetOpeningHoursResponse{
+		Hours: pbHours,
+	}, nil
+}
+
+func (s *RestaurantService) SetRestaurantPaused(ctx context.Context, req *pb.SetRestaurantPausedRequest) (*pb.SetRestaurantPausedResponse, error) {
+	restaurant, err := s.getManagedRestaurant(ctx, req.Actor, req.RestaurantId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.restaurantRepo.SetPaused(ctx, restaurant.ID, req.Paused); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.restaurantRepo.GetRestaurant(ctx, restaurant.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get updated restaurant: %w", err)
+	}
+
+	return &pb.SetRestaurantPausedResponse{
+		Restaurant: toPbRestaurant(updated),
+	}, nil
+}
+
+// getOpeningHours returns the weekly hours and the exceptions from today on,
+// today being the date in the restaurant's timezone.
+func (s *RestaurantService) getOpeningHours(ctx context.Context, restaurant *repository.Restaurant) (*pb.OpeningHours, error) {
+	loc, err := loadTimezone(restaurant.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone for restaurant %s: %w", restaurant.ID, err)
+	}
+
+	today := time.Now().In(loc).Format(time.DateOnly)
+
+	hours, err := s.hoursRepo.Get(ctx, restaurant.ID, today)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get opening hours: %w", err)
+	}
+
+	pbHours := toPbOpeningHours(hours)
+	pbHours.Timezone = restaurant.Timezone
+	pbHours.IsPaused = restaurant.IsPaused
+	return pbHours, nil
+}
+
+// loadTimezone only accepts IANA names; time.LoadLocation would also take ""
+// and "Local", which depend on the server rather than the restaurant.
+func loadTimezone(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("unknown time zone %q", name)
+	}
+	return time.LoadLocation(name)
+}
+
+type openSpan struct {
+	start, end time.Time
+}
+
+// openSpans lists when the restaurant is open on the given number of days
+// starting at from, which must be midnight in the restaurant's timezone. An
+// exception replaces the weekly hours of its date, and restaurants without
+// weekly hours open at OpeningTime and close at ClosingTime every day.
+// Overlapping and touching spans are merged, so a span ending at midnight and
+// one starting then make a single span.
+func openSpans(restaurant *repository.Restaurant, hours *repository.OpeningHours, from time.Time, days int) []openSpan {
+	weekly := make(map[int][][2]string)
+	for _, interval := range hours.Weekly {
+		weekly[interval.Weekday] = append(weekly[interval.Weekday], [2]string{interval.OpensAt, interval.ClosesAt})
+	}
+
+	exceptions := make(map[string][][2]string)
+	for _, exception := range hours.Exceptions {
+		// Rows without times close the day but still mark it as an exception
+		if _, ok := exceptions[exception.Date]; !ok {
+			exceptions[exception.Date] = [][2]string{}
+		}
+		if exception.OpensAt != "" {
+			exceptions[exception.Date] = append(exceptions[exception.Date], [2]string{exception.OpensAt, exception.ClosesAt})
+		}
+	}
+
+	var spans []openSpan
+	for i := 0; i < days; i++ {
+		day := time.Date(from.Year(), from.Month(), from.Day()+i, 0, 0, 0, 0, from.Location())
+
+		intervals, ok := exceptions[day.Format(time.DateOnly)]
+		switch {
+		case ok:
+		case len(hours.Weekly) > 0:
+			intervals = weekly[int(day.Weekday())]
+		default:
+			intervals = [][2]string{{restaurant.OpeningTime, restaurant.ClosingTime}}
+		}
+
+		for _, interval := range intervals {
+			span, err := spanOn(day, interval[0], interval[1])
+			if err != nil {
+				// Stored times are validated on the way in
+				continue
+			}
+			spans = append(spans, span)
+		}
+	}
+
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].start.Before(spans[j].start)
+	})
+
+	merged := spans[:0]
+	for _, span := range spans {
+		last := len(merged) - 1
+		if last >= 0 && !span.start.After(merged[last].end) {
+			if span.end.After(merged[last].end) {
+				merged[last].end = span.end
+			}
+			continue
+		}
+		merged = append(merged, span)
+	}
+
+	return merged
+}
+
+// spanOn places an interval on a day. A closing time at or before the
+// opening time belongs to the next day, so 00:00-00:00 is the whole day.
+func spanOn(day time.Time, opensAt, closesAt string) (openSpan, error) {
+	opens, err := parseClock(opensAt)
+	if err != nil {
+		return openSpan{}, err
+	}
+	closes, err := parseClock(closesAt)
+	if err != nil {
+		return openSpan{}, err
+	}
+
+	closeDay := day.Day()
+	if closes <= opens {
+		closeDay++
+	}
+
+	// time.Date moves times that fall in a DST gap, which is what we want
+	return openSpan{
+		start: time.Date(day.Year(), day.Month(), day.Day(), 0, opens, 0, 0, day.Location()),
+		end:   time.Date(day.Year(), day.Month(), closeDay, 0, closes, 0, 0, day.Location()),
+	}, nil
+}
+
+// parseClock returns the minutes after midnight of an HH:MM or HH:MM:SS time
+func parseClock(value string) (int, error) {
+	normalized, err := normalizeTime(value)
+	if err != nil {
+		return 0, err
+	}
+	t, _ := time.Parse("15:04:05", normalized)
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// normalizeClock accepts HH:MM or HH:MM:SS and returns HH:MM
+func normalizeClock(value string) (string, error) {
+	normalized, err := normalizeTime(strings.TrimSpace(value))
+	if err != nil {
+		return "", err
+	}
+	return normalized[:5], nil
+}
+
+func toIntervals(pbIntervals []*pb.TimeInterval) ([][2]string, error) {
+	if len(pbIntervals) > maxIntervalsPerDay {
+		return nil, status.Errorf(codes.InvalidArgument, "a day can have at most %d intervals", maxIntervalsPerDay)
+	}
+
+	intervals := make([][2]string, len(pbIntervals))
+	for i, pbInterval := range pbIntervals {
+		opensAt, err := normalizeClock(pbInterval.OpensAt)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "opening time %q must look like 09:00", pbInterval.OpensAt)
+		}
+		closesAt, err := normalizeClock(pbInterval.ClosesAt)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "closing time %q must look like 23:00", pbInterval.ClosesAt)
+		}
+		intervals[i] = [2]string{opensAt, closesAt}
+	}
+
+	return intervals, nil
+}
+
+// toOpeningHours validates the requested schedule and flattens it into rows
+func toOpeningHours(pbWeekly []*pb.WeeklyHours, pbExceptions []*pb.HoursException) (*repository.OpeningHours, error) {
+	hours := &repository.OpeningHours{}
+
+	seenWeekdays := make(map[int32]bool)
+	for _, day := range pbWeekly {
+		if day.Weekday < 0 || day.Weekday > 6 {
+			return nil, status.Error(codes.InvalidArgument, "weekday must be between 0 (Sunday) and 6 (Saturday)")
+		}
+		if seenWeekdays[day.Weekday] {
+			return nil, status.Errorf(codes.InvalidArgument, "weekday %d is listed more than once", day.Weekday)
+		}
+		seenWeekdays[day.Weekday] = true
+
+		intervals, err := toIntervals(day.Intervals)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, interval := range intervals {
+			hours.Weekly = append(hours.Weekly, &repository.HoursInterval{
+				Weekday:  int(day.Weekday),
+				OpensAt:  interval[0],
+				ClosesAt: interval[1],
+			})
+		}
+	}
+
+	if len(pbExceptions) > maxHoursExceptions {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d exceptions are allowed", maxHoursExceptions)
+	}
+
+	seenDates := make(map[string]bool)
+	for _, exception := range pbExceptions {
+		date, err := time.Parse(time.DateOnly, strings.TrimSpace(exception.Date))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "exception date %q must look like 2024-12-31", exception.Date)
+		}
+		key := date.Format(time.DateOnly)
+		if seenDates[key] {
+			return nil, status.Errorf(codes.InvalidArgument, "%s has more than one exception", key)
+		}
+		seenDates[key] = true
+
+		note := strings.TrimSpace(exception.Note)
+		if len(note) > maxHoursNoteLength {
+			return nil, status.Errorf(codes.InvalidArgument, "notes can be at most %d characters", maxHoursNoteLength)
+		}
+
+		intervals, err := toIntervals(exception.Intervals)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(intervals) == 0 {
+			hours.Exceptions = append(hours.Exceptions, &repository.HoursException{
+				Date: key,
+				Note: note,
+			})
+			continue
+		}
+
+		for _, interval := range intervals {
+			hours.Exceptions = append(hours.Exceptions, &repository.HoursException{
+				Date:     key,
+				OpensAt:  interval[0],
+				ClosesAt: interval[1],
+				Note:     note,
+			})
+		}
+	}
+
+	return hours, nil
+}
+
+func toPbOpeningHours(hours *repository.OpeningHours) *pb.OpeningHours {
+	pbHours := &pb.OpeningHours{}
+
+	// Rows come ordered by weekday and by date
+	for _, interval := range hours.Weekly {
+		last := len(pbHours.Weekly) - 1
+		if last < 0 || pbHours.Weekly[last].Weekday != int32(interval.Weekday) {
+			pbHours.Weekly = append(pbHours.Weekly, &pb.WeeklyHours{Weekday: int32(interval.Weekday)})
+			last++
+		}
+		pbHours.Weekly[last].Intervals = append(pbHours.Weekly[last].Intervals, &pb.TimeInterval{
+			OpensAt:  interval.OpensAt,
+			ClosesAt: interval.ClosesAt,
+		})
+	}
+
+	for _, exception := range hours.Exceptions {
+		last := len(pbHours.Exceptions) - 1
+		if last < 0 || pbHours.Exceptions[last].Date != exception.Date {
+			pbHours.Exceptions = append(pbHours.Exceptions, &pb.HoursException{
+				Date: exception.Date,
+				Note: exception.Note,
+			})
+			last++
+		}
+		if exception.OpensAt != "" {
+			pbHours.Exceptions[last].Intervals = append(pbHours.Exceptions[last].Intervals, &pb.TimeInterval{
+				OpensAt:  exception.OpensAt,
+				ClosesAt: exception.ClosesAt,
+			})
+		}
+	}
+
+	return pbHours
+}
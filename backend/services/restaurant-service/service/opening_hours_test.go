@@ -0,0 +1,161 @@
+package service
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/repository"
+)
+
+func TestOpenSpans(t *testing.T) {
+	almaty, err := time.LoadLocation("Asia/Almaty")
+	if err != nil {
+		t.Fatal(err)
+	}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	at := func(loc *time.Location, value string) time.Time {
+		t.Helper()
+		parsed, err := time.ParseInLocation("2006-01-02 15:04", value, loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return parsed
+	}
+
+	type span struct{ start, end string }
+
+	tests := []struct {
+		name       string
+		loc        *time.Location
+		restaurant *repository.Restaurant
+		hours      *repository.OpeningHours
+		from       string
+		days       int
+		want       []span
+		wantLength []time.Duration // elapsed time of each span, which DST changes
+	}{
+		{
+			name: "closing after midnight ends the next day",
+			loc:  almaty,
+			hours: &repository.OpeningHours{Weekly: []*repository.HoursInterval{
+				{Weekday: 5, OpensAt: "18:00", ClosesAt: "02:00"},
+			}},
+			from: "2026-10-16 00:00",
+			days: 2,
+			want: []span{{"2026-10-16 18:00", "2026-10-17 02:00"}},
+		},
+		{
+			name: "spans touching at midnight are merged",
+			loc:  almaty,
+			hours: &repository.OpeningHours{Weekly: []*repository.HoursInterval{
+				{Weekday: 5, OpensAt: "20:00", ClosesAt: "00:00"},
+				{Weekday: 6, OpensAt: "00:00", ClosesAt: "03:00"},
+			}},
+			from: "2026-10-16 00:00",
+			days: 2,
+			want: []span{{"2026-10-16 20:00", "2026-10-17 03:00"}},
+		},
+		{
+			name:       "round the clock without weekly hours",
+			loc:        almaty,
+			restaurant: &repository.Restaurant{OpeningTime: "00:00:00", ClosingTime: "00:00:00"},
+			hours:      &repository.OpeningHours{},
+			from:       "2026-10-16 00:00",
+			days:       3,
+			want:       []span{{"2026-10-16 00:00", "2026-10-19 00:00"}},
+		},
+		{
+			name: "exception without times closes the day",
+			loc:  almaty,
+			hours: &repository.OpeningHours{
+				Weekly: []*repository.HoursInterval{
+					{Weekday: 5, OpensAt: "09:00", ClosesAt: "17:00"},
+					{Weekday: 6, OpensAt: "09:00", ClosesAt: "17:00"},
+				},
+				Exceptions: []*repository.HoursException{{Date: "2026-10-16"}},
+			},
+			from: "2026-10-16 00:00",
+			days: 2,
+			want: []span{{"2026-10-17 09:00", "2026-10-17 17:00"}},
+		},
+		{
+			name: "exception replaces the weekly hours",
+			loc:  almaty,
+			hours: &repository.OpeningHours{
+				Weekly: []*repository.HoursInterval{
+					{Weekday: 5, OpensAt: "09:00", ClosesAt: "17:00"},
+				},
+				Exceptions: []*repository.HoursException{
+					{Date: "2026-10-16", OpensAt: "12:00", ClosesAt: "15:00"},
+				},
+			},
+			from: "2026-10-16 00:00",
+			days: 1,
+			want: []span{{"2026-10-16 12:00", "2026-10-16 15:00"}},
+		},
+		{
+			name: "clocks going forward shorten the night",
+			loc:  berlin,
+			hours: &repository.OpeningHours{Weekly: []*repository.HoursInterval{
+				{Weekday: 6, OpensAt: "22:00", ClosesAt: "04:00"},
+			}},
+			from:       "2026-03-28 00:00",
+			days:       2,
+			want:       []span{{"2026-03-28 22:00", "2026-03-29 04:00"}},
+			wantLength: []time.Duration{5 * time.Hour},
+		},
+		{
+			name: "clocks going back lengthen the night",
+			loc:  berlin,
+			hours: &repository.OpeningHours{Weekly: []*repository.HoursInterval{
+				{Weekday: 6, OpensAt: "22:00", ClosesAt: "04:00"},
+			}},
+			from:       "2026-10-24 00:00",
+			days:       2,
+			want:       []span{{"2026-10-24 22:00", "2026-10-25 04:00"}},
+			wantLength: []time.Duration{7 * time.Hour},
+		},
+		{
+			name: "whole day on the short day",
+			loc:  berlin,
+			hours: &repository.OpeningHours{Weekly: []*repository.HoursInterval{
+				{Weekday: 0, OpensAt: "00:00", ClosesAt: "00:00"},
+			}},
+			from:       "2026-03-29 00:00",
+			days:       1,
+			want:       []span{{"2026-03-29 00:00", "2026-03-30 00:00"}},
+			wantLength: []time.Duration{23 * time.Hour},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restaurant := tt.restaurant
+			if restaurant == nil {
+				restaurant = &repository.Restaurant{}
+			}
+
+			got := openSpans(restaurant, tt.hours, at(tt.loc, tt.from), tt.days)
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d spans %v, want %d", len(got), got, len(tt.want))
+			}
+			for i, want := range tt.want {
+				start, end := at(tt.loc, want.start), at(tt.loc, want.end)
+				if !got[i].start.Equal(start) || !got[i].end.Equal(end) {
+					t.Errorf("span %d = %v - %v, want %v - %v", i, got[i].start, got[i].end, start, end)
+				}
+				if tt.wantLength != nil {
+					if length := got[i].end.Sub(got[i].start); length != tt.wantLength[i] {
+						t.Errorf("span %d lasts %v, want %v", i, length, tt.wantLength[i])
+					}
+				}
+			}
+		})
+	}
+}
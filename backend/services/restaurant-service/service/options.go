@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxOptionGroups     = 20
+	maxOptionsPerGroup  = 50
+	maxOptionNameLength = 100
+)
+
+func (s *RestaurantService) SetMenuItemOptions(ctx context.Context, req *pb.SetMenuItemOptionsRequest) (*pb.SetMenuItemOptionsResponse, error) {
+	item, err := s.getManagedMenuItem(ctx, req.Actor, req.MenuItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.optionRepo.GetForMenuItems(ctx, []string{item.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get option groups: %w", err)
+	}
+
+	groups, err := toOptionGroups(item.ID, req.OptionGroups, current[item.ID])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.optionRepo.ReplaceForMenuItem(ctx, item.ID, groups); err != nil {
+		return nil, fmt.Errorf("failed to save option groups: %w", err)
+	}
+
+	pbItems, err := s.withOptions(ctx, []*repository.MenuItem{item})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetMenuItemOptionsResponse{
+		Item: pbItems[0],
+	}, nil
+}
+
+// withOptions converts menu items and attaches their option groups
+func (s *RestaurantService) withOptions(ctx context.Context, items []*repository.MenuItem) ([]*pb.MenuItem, error) {
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+
+	groups, err := s.optionRepo.GetForMenuItems(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get option groups: %w", err)
+	}
+
+	pbItems := make([]*pb.MenuItem, len(items))
+	for i, item := range items {
+		pbItems[i] = toPbMenuItem(item)
+		pbItems[i].OptionGroups = toPbOptionGroups(groups[item.ID])
+	}
+
+	return pbItems, nil
+}
+
+// validateLines checks the options chosen on every line against the item's
+// option groups and prices the line. items holds the menu items found in the
+// restaurant, keyed by id.
+func (s *RestaurantService) validateLines(ctx context.Context, items map[string]*repository.MenuItemValidation, lines []*pb.ItemSelection) ([]*pb.LineValidation, bool, error) {
+	if len(lines) == 0 {
+		return nil, true, nil
+	}
+
+	ids := make([]string, 0, len(items))
+	for id := range items {
+		ids = append(ids, id)
+	}
+
+	groups, err := s.optionRepo.GetForMenuItems(ctx, ids)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get option groups: %w", err)
+	}
+
+	allValid := true
+	validations := make([]*pb.LineValidation, len(lines))
+
+	for i, line := range lines {
+		validation := &pb.LineValidation{
+			MenuItemId: line.MenuItemId,
+			Quantity:   line.Quantity,
+		}
+		if validation.Quantity == 0 {
+			validation.Quantity = 1
+		}
+		if validation.Quantity < 0 {
+			validation.Errors = append(validation.Errors, "quantity must be positive")
+		}
+
+		item, ok := items[line.MenuItemId]
+		if !ok {
+			validation.Errors = append(validation.Errors, "menu item not found")
+		} else {
+			validation.Name = item.Name
+			if !item.IsAvailable {
+				validation.Errors = append(validation.Errors, fmt.Sprintf("%s is unavailable", item.Name))
+			}
+
+			options, optionsPrice, errs := selectOptions(groups[item.ItemID], line.OptionIds)
+			validation.Options = options
+			validation.Errors = append(validation.Errors, errs...)
+			validation.UnitPrice = roundPrice(item.Price + optionsPrice)
+			validation.LinePrice = roundPrice(validation.UnitPrice * float64(validation.Quantity))
+		}
+
+		validation.Valid = len(validation.Errors) == 0
+		if !validation.Valid {
+			allValid = false
+		}
+		validations[i] = validation
+	}
+
+	return validations, allValid, nil
+}
+
+// selectOptions resolves the chosen option ids against the item's groups. It
+// returns the chosen options in menu order, the sum of their price deltas
+// and a message for every rule the choice breaks.
+func selectOptions(groups []*repository.OptionGroup, optionIDs []string) ([]*pb.SelectedOption, float64, []string) {
+	var errs []string
+
+	chosen := make(map[string]bool, len(optionIDs))
+	for _, id := range optionIDs {
+		if chosen[id] {
+			errs = append(errs, fmt.Sprintf("option %s is chosen more than once", id))
+		}
+		chosen[id] = true
+	}
+
+	var selected []*pb.SelectedOption
+	var price float64
+
+	for _, group := range groups {
+		var count int32
+		for _, option := range group.Options {
+			if !chosen[option.ID] {
+				continue
+			}
+			delete(chosen, option.ID)
+			count++
+
+			if !option.IsAvailable {
+				errs = append(errs, fmt.Sprintf("%s is unavailable", option.Name))
+			}
+
+			selected = append(selected, &pb.SelectedOption{
+				Id:         option.ID,
+				GroupName:  group.Name,
+				Name:       option.Name,
+				PriceDelta: option.PriceDelta,
+			})
+			price += option.PriceDelta
+		}
+
+		switch {
+		case count < group.MinSelect && group.MinSelect == 1:
+			errs = append(errs, fmt.Sprintf("choose an option for %s", group.Name))
+		case count < group.MinSelect:
+			errs = append(errs, fmt.Sprintf("choose at least %d options for %s", group.MinSelect, group.Name))
+		case count > group.MaxSelect && group.MaxSelect == 1:
+			errs = append(errs, fmt.Sprintf("choose only one option for %s", group.Name))
+		case count > group.MaxSelect:
+			errs = append(errs, fmt.Sprintf("choose at most %d options for %s", group.MaxSelect, group.Name))
+		}
+	}
+
+	// Whatever is left was not found in any of the item's groups
+	for _, id := range optionIDs {
+		if chosen[id] {
+			errs = append(errs, fmt.Sprintf("option %s is not offered with this item", id))
+			delete(chosen, id)
+		}
+	}
+
+	return selected, price, errs
+}
+
+// toOptionGroups validates the requested option groups and assigns ids to
+// new groups and options. Ids that are sent must belong to the item already.
+func toOptionGroups(menuItemID string, pbGroups []*pb.OptionGroup, current []*repository.OptionGroup) ([]*repository.OptionGroup, error) {
+	if len(pbGroups) > maxOptionGroups {
+		return nil, status.Errorf(codes.InvalidArgument, "a menu item can have at most %d option groups", maxOptionGroups)
+	}
+
+	knownGroups := make(map[string]bool)
+	knownOptions := make(map[string]bool)
+	for _, group := range current {
+		knownGroups[group.ID] = true
+		for _, option := range group.Options {
+			knownOptions[option.ID] = true
+		}
+	}
+
+	usedIDs := make(map[string]bool)
+	useID := func(id string, known map[string]bool) (string, error) {
+		if id == "" {
+			return uuid.New().String(), nil
+		}
+		if !known[id] || usedIDs[id] {
+			return "", status.Errorf(codes.InvalidArgument, "unknown or repeated id %s", id)
+		}
+		usedIDs[id] = true
+		return id, nil
+	}
+
+	groups := make([]*repository.OptionGroup, len(pbGroups))
+	for i, pbGroup := range pbGroups {
+		id, err := useID(pbGroup.Id, knownGroups)
+		if err != nil {
+			return nil, err
+		}
+
+		group := &repository.OptionGroup{
+			ID:         id,
+			MenuItemID: menuItemID,
+			Name:       strings.TrimSpace(pbGroup.Name),
+			MinSelect:  pbGroup.MinSelect,
+			MaxSelect:  pbGroup.MaxSelect,
+		}
+
+		if group.Name == "" || len(group.Name) > maxOptionNameLength {
+			return nil, status.Errorf(codes.InvalidArgument, "option group names must be 1 to %d characters", maxOptionNameLength)
+		}
+		if len(pbGroup.Options) == 0 || len(pbGroup.Options) > maxOptionsPerGroup {
+			return nil, status.Errorf(codes.InvalidArgument, "%s must have 1 to %d options", group.Name, maxOptionsPerGroup)
+		}
+		if group.MinSelect < 0 || group.MaxSelect < 1 || group.MinSelect > group.MaxSelect {
+			return nil, status.Errorf(codes.InvalidArgument, "%s needs 0 <= min_select <= max_select and max_select >= 1", group.Name)
+		}
+		if int(group.MaxSelect) > len(pbGroup.Options) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s allows more choices than it has options", group.Name)
+		}
+
+		for _, pbOption := range pbGroup.Options {
+			id, err := useID(pbOption.Id, knownOptions)
+			if err != nil {
+				return nil, err
+			}
+
+			option := &repository.Option{
+				ID:          id,
+				GroupID:     group.ID,
+				Name:        strings.TrimSpace(pbOption.Name),
+				PriceDelta:  pbOption.PriceDelta,
+				IsAvailable: pbOption.IsAvailable,
+			}
+
+			if option.Name == "" || len(option.Name) > maxOptionNameLength {
+				return nil, status.Errorf(codes.InvalidArgument, "option names must be 1 to %d characters", maxOptionNameLength)
+			}
+			if option.PriceDelta < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "price of %s cannot be negative", option.Name)
+			}
+
+			group.Options = append(group.Options, option)
+		}
+
+		groups[i] = group
+	}
+
+	return groups, nil
+}
+
+func toPbOptionGroups(groups []*repository.OptionGroup) []*pb.OptionGroup {
+	pbGroups := make([]*pb.OptionGroup, len(groups))
+	for i, group := range groups {
+		options := make([]*pb.MenuOption, len(group.Options))
+		for j, option := range group.Options {
+			options[j] = &pb.MenuOption{
+				Id:          option.ID,
+				Name:        option.Name,
+				PriceDelta:  option.PriceDelta,
+				IsAvailable: option.IsAvailable,
+			}
+		}
+
+		pbGroups[i] = &pb.OptionGroup{
+			Id:        group.ID,
+			Name:      group.Name,
+			MinSelect: group.MinSelect,
+			MaxSelect: group.MaxSelect,
+			Options:   options,
+		}
+	}
+	return pbGroups
+}
+
+func roundPrice(price float64) float64 {
+	return math.Round(price*100) / 100
+}
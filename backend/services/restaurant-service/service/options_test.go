@@ -0,0 +1,135 @@
+package service
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/repository"
+)
+
+func TestSelectOptions(t *testing.T) {
+	groups := []*repository.OptionGroup{
+		{
+			Name:      "Size",
+			MinSelect: 1,
+			MaxSelect: 1,
+			Options: []*repository.Option{
+				{ID: "small", Name: "Small", IsAvailable: true},
+				{ID: "large", Name: "Large", PriceDelta: 500, IsAvailable: true},
+			},
+		},
+		{
+			Name:      "Toppings",
+			MinSelect: 0,
+			MaxSelect: 2,
+			Options: []*repository.Option{
+				{ID: "cheese", Name: "Cheese", PriceDelta: 200, IsAvailable: true},
+				{ID: "bacon", Name: "Bacon", PriceDelta: 300, IsAvailable: true},
+				{ID: "olives", Name: "Olives", PriceDelta: 100, IsAvailable: false},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		optionIDs []string
+		wantIDs   []string
+		wantPrice float64
+		wantErrs  []string
+	}{
+		{
+			name:      "required choice only",
+			optionIDs: []string{"small"},
+			wantIDs:   []string{"small"},
+		},
+		{
+			name:      "options come back in menu order",
+			optionIDs: []string{"bacon", "large", "cheese"},
+			wantIDs:   []string{"large", "cheese", "bacon"},
+			wantPrice: 1000,
+		},
+		{
+			name:      "missing required group",
+			optionIDs: []string{"cheese"},
+			wantIDs:   []string{"cheese"},
+			wantPrice: 200,
+			wantErrs:  []string{"choose an option for Size"},
+		},
+		{
+			name:      "too many in a single choice group",
+			optionIDs: []string{"small", "large"},
+			wantIDs:   []string{"small", "large"},
+			wantPrice: 500,
+			wantErrs:  []string{"choose only one option for Size"},
+		},
+		{
+			name:      "too many in a multiple choice group",
+			optionIDs: []string{"small", "cheese", "bacon", "olives"},
+			wantIDs:   []string{"small", "cheese", "bacon", "olives"},
+			wantPrice: 600,
+			wantErrs:  []string{"Olives is unavailable", "choose at most 2 options for Toppings"},
+		},
+		{
+			name:      "unavailable option",
+			optionIDs: []string{"small", "olives"},
+			wantIDs:   []string{"small", "olives"},
+			wantPrice: 100,
+			wantErrs:  []string{"Olives is unavailable"},
+		},
+		{
+			name:      "repeated option",
+			optionIDs: []string{"small", "cheese", "cheese"},
+			wantIDs:   []string{"small", "cheese"},
+			wantPrice: 200,
+			wantErrs:  []string{"option cheese is chosen more than once"},
+		},
+		{
+			name:      "option from another item",
+			optionIDs: []string{"small", "ketchup"},
+			wantIDs:   []string{"small"},
+			wantErrs:  []string{"option ketchup is not offered with this item"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, price, errs := selectOptions(groups, tt.optionIDs)
+
+			var ids []string
+			for _, option := range selected {
+				ids = append(ids, option.Id)
+			}
+
+			if !slices.Equal(ids, tt.wantIDs) {
+				t.Errorf("selected = %v, want %v", ids, tt.wantIDs)
+			}
+			if price != tt.wantPrice {
+				t.Errorf("price = %v, want %v", price, tt.wantPrice)
+			}
+			if !slices.Equal(errs, tt.wantErrs) {
+				t.Errorf("errors = %q, want %q", errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestSelectOptionsMinimum(t *testing.T) {
+	groups := []*repository.OptionGroup{
+		{
+			Name:      "Sauces",
+			MinSelect: 2,
+			MaxSelect: 3,
+			Options: []*repository.Option{
+				{ID: "bbq", Name: "BBQ", IsAvailable: true},
+				{ID: "garlic", Name: "Garlic", IsAvailable: true},
+			},
+		},
+	}
+
+	_, _, errs := selectOptions(groups, []string{"bbq"})
+
+	want := []string{"choose at least 2 options for Sauces"}
+	if !slices.Equal(errs, want) {
+		t.Errorf("errors = %q, want %q", errs, want)
+	}
+}
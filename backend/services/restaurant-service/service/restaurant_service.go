@@ -2,94 +2,122 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"time"
+	"slices"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/kimashii-dan/food-delivery-app/backend/pkg"
 	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
 	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/repository"
+	userpb "github.com/kimashii-dan/food-delivery-app/backend/services/user-service/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// defaultPageSize is used by list RPCs when the request leaves page_size out
+const defaultPageSize = 10
+
 type RestaurantService struct {
 	pb.UnimplementedRestaurantServiceServer
 	restaurantRepo *repository.RestaurantRepository
 	menuItemRepo   *repository.MenuItemRepository
+	optionRepo     *repository.OptionRepository
+	hoursRepo      *repository.HoursRepository
+	categoryRepo   *repository.CategoryRepository
+	userClient     userpb.UserServiceClient
 }
 
-func NewRestaurantService(restaurantRepo *repository.RestaurantRepository, menuItemRepo *repository.MenuItemRepository) *RestaurantService {
+func NewRestaurantService(
+	restaurantRepo *repository.RestaurantRepository,
+	menuItemRepo *repository.MenuItemRepository,
+	optionRepo *repository.OptionRepository,
+	hoursRepo *repository.HoursRepository,
+	categoryRepo *repository.CategoryRepository,
+	userClient userpb.UserServiceClient,
+) *RestaurantService {
 	return &RestaurantService{
 		restaurantRepo: restaurantRepo,
 		menuItemRepo:   menuItemRepo,
+		optionRepo:     optionRepo,
+		hoursRepo:      hoursRepo,
+		categoryRepo:   categoryRepo,
+		userClient:     userClient,
 	}
 }
 
 func (s *RestaurantService) GetRestaurants(ctx context.Context, req *pb.GetRestaurantsRequest) (*pb.GetRestaurantsResponse, error) {
-	page := req.Page
-	if page < 1 {
-		page = 1
+	pageSize, err := pkg.PageSize(req.PageSize, defaultPageSize)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pageFilter := pkg.PageFilter(req.AddressId, req.UserId, req.Origin.GetLatitude(), req.Origin.GetLongitude(), req.Origin != nil, req.RadiusKm)
+	after, err := pkg.DecodePageToken[repository.RestaurantCursor](req.PageToken, pageFilter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	var limit int32 = 10
-	offset := (page - 1) * limit
+	var filter repository.RestaurantFilter
+
+	if req.Origin != nil {
+		if !validCoordinates(req.Origin.Latitude, req.Origin.Longitude) || req.RadiusKm < 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid search origin or radius")
+		}
+		filter.Origin = &pkg.Point{Lat: req.Origin.Latitude, Lng: req.Origin.Longitude}
+		filter.RadiusKm = req.RadiusKm
+	}
+
+	if req.AddressId != "" {
+		point, err := s.deliveryPoint(ctx, req.AddressId, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		filter.DeliversTo = point
+	}
 
-	restaurants, err := s.restaurantRepo.GetRestaurants(ctx, offset, limit)
+	restaurants, next, err := s.restaurantRepo.GetRestaurants(ctx, filter, after, pageSize)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get restaurants: %v", err)
 	}
 
-	total, err := s.restaurantRepo.GetRestaurantsCount(ctx)
+	nextPageToken, err := pkg.EncodePageToken(pageFilter, next)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get restaurants count: %v", err)
+		return nil, err
 	}
 
 	pbRestaurants := make([]*pb.Restaurant, len(restaurants))
 	for i, r := range restaurants {
-		pbRestaurants[i] = &pb.Restaurant{
-			Id:          r.ID,
-			Name:        r.Name,
-			Description: r.Description,
-			Address:     r.Address,
-			Phone:       r.Phone,
-			Latitude:    r.Latitude,
-			Longitude:   r.Longitude,
-			LogoUrl:     r.LogoURL,
-			OpeningTime: r.OpeningTime,
-			ClosingTime: r.ClosingTime,
-			CreatedAt:   r.CreatedAt,
-			UpdatedAt:   r.UpdatedAt,
-		}
+		pbRestaurants[i] = toPbRestaurant(r)
 	}
 
 	return &pb.GetRestaurantsResponse{
-		Restaurants: pbRestaurants,
-		Total:       total,
+		Restaurants:   pbRestaurants,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 func (s *RestaurantService) GetRestaurant(ctx context.Context, req *pb.GetRestaurantRequest) (*pb.GetRestaurantResponse, error) {
 	if req.Id == "" {
-		return nil, fmt.Errorf("restaurant id is required")
+		return nil, status.Error(codes.InvalidArgument, "restaurant id is required")
+	}
+
+	// ids that aren't uuids can't match, and postgres would reject them
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.NotFound, "restaurant not found")
 	}
 
 	restaurant, err := s.restaurantRepo.GetRestaurant(ctx, req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("restaurant not found: %v", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "restaurant not found")
+		}
+		return nil, fmt.Errorf("failed to get restaurant: %w", err)
 	}
 
 	return &pb.GetRestaurantResponse{
-		Restaurant: &pb.Restaurant{
-			Id:          restaurant.ID,
-			Name:        restaurant.Name,
-			Description: restaurant.Description,
-			Address:     restaurant.Address,
-			Phone:       restaurant.Phone,
-			Latitude:    restaurant.Latitude,
-			Longitude:   restaurant.Longitude,
-			LogoUrl:     restaurant.LogoURL,
-			OpeningTime: restaurant.OpeningTime,
-			ClosingTime: restaurant.ClosingTime,
-			CreatedAt:   restaurant.CreatedAt,
-			UpdatedAt:   restaurant.UpdatedAt,
-		},
+		Restaurant: toPbRestaurant(restaurant),
 	}, nil
 }
 
@@ -98,43 +126,39 @@ func (s *RestaurantService) GetMenu(ctx context.Context, req *pb.GetMenuRequest)
 		return nil, fmt.Errorf("restaurant id is required")
 	}
 
-	page := req.Page
-	if page < 1 {
-		page = 1
+	if req.Grouped {
+		return s.getGroupedMenu(ctx, req.RestaurantId)
 	}
 
-	var limit int32 = 10
-	offset := (page - 1) * limit
+	pageSize, err := pkg.PageSize(req.PageSize, defaultPageSize)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	items, err := s.menuItemRepo.GetMenu(ctx, req.RestaurantId, offset, limit)
+	pageFilter := pkg.PageFilter(req.RestaurantId)
+	after, err := pkg.DecodePageToken[repository.MenuCursor](req.PageToken, pageFilter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	items, next, err := s.menuItemRepo.GetMenu(ctx, req.RestaurantId, after, pageSize)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get menu items: %v", err)
 	}
 
-	total, err := s.menuItemRepo.GetMenuCount(ctx, req.RestaurantId)
+	nextPageToken, err := pkg.EncodePageToken(pageFilter, next)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get menu items count: %v", err)
-	}
-
-	pbItems := make([]*pb.MenuItem, len(items))
-	for i, item := range items {
-		pbItems[i] = &pb.MenuItem{
-			Id:           item.ID,
-			RestaurantId: item.RestaurantID,
-			Name:         item.Name,
-			Description:  item.Description,
-			Price:        item.Price,
-			ImageUrl:     item.ImageURL,
-			IsAvailable:  item.IsAvailable,
-			Category:     item.Category,
-			CreatedAt:    item.CreatedAt,
-			UpdatedAt:    item.UpdatedAt,
-		}
+		return nil, err
+	}
+
+	pbItems, err := s.withOptions(ctx, items)
+	if err != nil {
+		return nil, err
 	}
 
 	return &pb.GetMenuResponse{
-		Items: pbItems,
-		Total: total,
+		Items:         pbItems,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -148,38 +172,13 @@ func (s *RestaurantService) GetMenuItem(ctx context.Context, req *pb.GetMenuItem
 		return nil, fmt.Errorf("menu item not found: %v", err)
 	}
 
-	return &pb.GetMenuItemResponse{
-		Item: &pb.MenuItem{
-			Id:           item.ID,
-			RestaurantId: item.RestaurantID,
-			Name:         item.Name,
-			Description:  item.Description,
-			Price:        item.Price,
-			ImageUrl:     item.ImageURL,
-			IsAvailable:  item.IsAvailable,
-			Category:     item.Category,
-			CreatedAt:    item.CreatedAt,
-			UpdatedAt:    item.UpdatedAt,
-		},
-	}, nil
-}
-
-func (s *RestaurantService) GetRestaurantStatus(ctx context.Context, req *pb.GetRestaurantStatusRequest) (*pb.GetRestaurantStatusResponse, error) {
-	if req.RestaurantId == "" {
-		return nil, fmt.Errorf("restaurant id is required")
-	}
-
-	restaurant, err := s.restaurantRepo.GetRestaurantStatus(ctx, req.RestaurantId)
+	pbItems, err := s.withOptions(ctx, []*repository.MenuItem{item})
 	if err != nil {
-		return nil, fmt.Errorf("restaurant not found: %v", err)
+		return nil, err
 	}
 
-	isAcceptingOrders := s.isWithinOpeningHours(restaurant.OpeningTime, restaurant.ClosingTime)
-
-	return &pb.GetRestaurantStatusResponse{
-		IsAcceptingOrders: isAcceptingOrders,
-		OpeningTime:       restaurant.OpeningTime,
-		ClosingTime:       restaurant.ClosingTime,
+	return &pb.GetMenuItemResponse{
+		Item: pbItems[0],
 	}, nil
 }
 
@@ -188,12 +187,20 @@ func (s *RestaurantService) ValidateMenuItems(ctx context.Context, req *pb.Valid
 		return nil, fmt.Errorf("restaurant id is required")
 	}
 
-	if len(req.ItemIds) == 0 {
+	if len(req.ItemIds) == 0 && len(req.Lines) == 0 {
 		return nil, fmt.Errorf("at least one item id is required")
 	}
 
+	// Items on lines are checked like the ones listed in item_ids
+	itemIDs := slices.Clone(req.ItemIds)
+	for _, line := range req.Lines {
+		if !slices.Contains(itemIDs, line.MenuItemId) {
+			itemIDs = append(itemIDs, line.MenuItemId)
+		}
+	}
+
 	// Get validations from repository
-	validations, err := s.menuItemRepo.ValidateMenuItems(ctx, req.RestaurantId, req.ItemIds)
+	validations, err := s.menuItemRepo.ValidateMenuItems(ctx, req.RestaurantId, itemIDs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to validate menu items: %v", err)
 	}
@@ -205,11 +212,11 @@ func (s *RestaurantService) ValidateMenuItems(ctx context.Context, req *pb.Valid
 	}
 
 	// Build response
-	pbValidations := make([]*pb.MenuItemValidation, 0, len(req.ItemIds))
+	pbValidations := make([]*pb.MenuItemValidation, 0, len(itemIDs))
 	unavailableItems := make([]string, 0)
 	allAvailable := true
 
-	for _, itemID := range req.ItemIds {
+	for _, itemID := range itemIDs {
 		validation, exists := validationMap[itemID]
 
 		if !exists {
@@ -231,24 +238,67 @@ func (s *RestaurantService) ValidateMenuItems(ctx context.Context, req *pb.Valid
 				ItemId:      validation.ItemID,
 				IsAvailable: validation.IsAvailable,
 				Name:        validation.Name,
+				Price:       validation.Price,
 			})
 		}
 	}
 
+	lines, allValid, err := s.validateLines(ctx, validationMap, req.Lines)
+	if err != nil {
+		return nil, err
+	}
+
 	return &pb.ValidateMenuItemsResponse{
 		AllAvailable:     allAvailable,
 		Items:            pbValidations,
 		UnavailableItems: unavailableItems,
+		Lines:            lines,
+		AllValid:         allAvailable && allValid,
 	}, nil
 }
 
-func (s *RestaurantService) isWithinOpeningHours(openingTime, closingTime string) bool {
-	now := time.Now()
-	currentTime := now.Format("15:04:05")
+func toPbRestaurant(r *repository.Restaurant) *pb.Restaurant {
+	polygon := make([]*pb.GeoPoint, len(r.DeliveryPolygon))
+	for i, p := range r.DeliveryPolygon {
+		polygon[i] = &pb.GeoPoint{Latitude: p.Lat, Longitude: p.Lng}
+	}
 
-	if closingTime < openingTime {
-		return currentTime >= openingTime || currentTime <= closingTime
+	return &pb.Restaurant{
+		Id:               r.ID,
+		Name:             r.Name,
+		Description:      r.Description,
+		Address:          r.Address,
+		Phone:            r.Phone,
+		Latitude:         r.Latitude,
+		Longitude:        r.Longitude,
+		LogoUrl:          r.LogoURL,
+		OpeningTime:      r.OpeningTime,
+		ClosingTime:      r.ClosingTime,
+		CreatedAt:        r.CreatedAt,
+		UpdatedAt:        r.UpdatedAt,
+		DeliveryRadiusKm: r.DeliveryRadiusKm,
+		DeliveryPolygon:  polygon,
+		DistanceKm:       r.DistanceKm,
+		OwnerUserId:      r.OwnerUserID,
+		Timezone:         r.Timezone,
+		IsPaused:         r.IsPaused,
 	}
+}
 
-	return currentTime >= openingTime && currentTime <= closingTime
+func toPbMenuItem(item *repository.MenuItem) *pb.MenuItem {
+	return &pb.MenuItem{
+		Id:           item.ID,
+		RestaurantId: item.RestaurantID,
+		Name:         item.Name,
+		Description:  item.Description,
+		Price:        item.Price,
+		ImageUrl:     item.ImageURL,
+		IsAvailable:  item.IsAvailable,
+		Category:     item.Category,
+		CreatedAt:    item.CreatedAt,
+		UpdatedAt:    item.UpdatedAt,
+		ExternalSku:  item.ExternalSKU,
+		CategoryId:   item.CategoryID,
+		Position:     item.Position,
+	}
 }
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/pkg"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/restaurant-service/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxItemsPerResult caps how many matching dishes are shown under one restaurant
+const maxItemsPerResult = 5
+
+func (s *RestaurantService) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	text := strings.TrimSpace(req.Query)
+	if text == "" {
+		return nil, status.Error(codes.InvalidArgument, "search query is required")
+	}
+
+	pageSize, err := pkg.PageSize(req.PageSize, defaultPageSize)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pageFilter := pkg.PageFilter(text)
+	after, err := pkg.DecodePageToken[repository.SearchCursor](req.PageToken, pageFilter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	restaurants, next, err := s.restaurantRepo.Search(ctx, text, after, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search restaurants: %w", err)
+	}
+
+	nextPageToken, err := pkg.EncodePageToken(pageFilter, next)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*pb.SearchResult, len(restaurants))
+	byRestaurant := make(map[string]*pb.SearchResult, len(restaurants))
+	restaurantIDs := make([]string, len(restaurants))
+	for i, r := range restaurants {
+		results[i] = &pb.SearchResult{
+			Restaurant: toPbRestaurant(r),
+			Items:      []*pb.MenuItem{},
+			Rank:       r.Rank,
+		}
+		byRestaurant[r.ID] = results[i]
+		restaurantIDs[i] = r.ID
+	}
+
+	if len(restaurantIDs) > 0 {
+		items, err := s.menuItemRepo.Search(ctx, text, restaurantIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search menu items: %w", err)
+		}
+
+		for _, item := range items {
+			result := byRestaurant[item.RestaurantID]
+			if len(result.Items) < maxItemsPerResult {
+				result.Items = append(result.Items, toPbMenuItem(item))
+			}
+		}
+	}
+
+	return &pb.SearchResponse{
+		Results:       results,
+		NextPageToken: nextPageToken,
+	}, nil
+}
@@ -0,0 +1,87 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+)
+
+// Message is a plain text email to one recipient
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers the emails user-service sends, like verification and
+// password reset links
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Config selects and configures the mailer
+type Config struct {
+	Backend string // "log", "file" or "smtp"
+	From    string
+
+	// file
+	Dir string
+
+	// smtp
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+}
+
+// New returns the mailer for cfg.Backend. There is no default: the log
+// mailer prints working links, so it has to be asked for.
+func New(cfg Config) (Mailer, error) {
+	switch cfg.Backend {
+	case "":
+		return nil, errors.New("no mail backend set, use log, file or smtp")
+	case "log":
+		return NewLogMailer(), nil
+	case "file":
+		return NewFileMailer(cfg.Dir, cfg.From)
+	case "smtp":
+		return NewSMTPMailer(SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			From:     cfg.From,
+		})
+	default:
+		return nil, fmt.Errorf("unknown mail backend %q", cfg.Backend)
+	}
+}
+
+// validHeader rejects values that would end the header and start another
+func validHeader(value string) bool {
+	return !strings.ContainsAny(value, "\r\n")
+}
+
+// compose renders msg as an RFC 5322 message with a UTF-8 plain text body
+func compose(from string, msg Message, date time.Time) ([]byte, error) {
+	if !validHeader(from) || !validHeader(msg.To) || !validHeader(msg.Subject) {
+		return nil, errors.New("email headers must not contain line breaks")
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n"))
+
+	return buf.Bytes(), nil
+}
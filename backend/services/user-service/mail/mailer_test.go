@@ -0,0 +1,131 @@
+package mail
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestComposeRejectsHeaderInjection(t *testing.T) {
+	const from = "Food Delivery <no-reply@example.com>"
+	valid := Message{To: "user@example.com", Subject: "Verify your email", Body: "Hello"}
+
+	tests := []struct {
+		name   string
+		from   string
+		change func(msg *Message)
+	}{
+		{name: "line feed in to", from: from, change: func(msg *Message) { msg.To = "user@example.com\nBcc: victim@example.com" }},
+		{name: "carriage return in to", from: from, change: func(msg *Message) { msg.To = "user@example.com\rBcc: victim@example.com" }},
+		{name: "line break in subject", from: from, change: func(msg *Message) { msg.Subject = "Hi\r\nBcc: victim@example.com" }},
+		{name: "line break in from", from: from + "\r\nBcc: victim@example.com", change: func(msg *Message) {}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := valid
+			tt.change(&msg)
+
+			if data, err := compose(tt.from, msg, time.Now()); err == nil {
+				t.Errorf("compose accepted the message:\n%s", data)
+			}
+		})
+	}
+}
+
+func TestCompose(t *testing.T) {
+	date := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	msg := Message{
+		To:      "user@example.com",
+		Subject: "Réinitialiser le mot de passe",
+		Body:    "Hello,\nopen the link:\r\nhttps://example.com/reset?token=abc\n",
+	}
+
+	data, err := compose("no-reply@example.com", msg, date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header, body, ok := strings.Cut(string(data), "\r\n\r\n")
+	if !ok {
+		t.Fatalf("no blank line between header and body:\n%s", data)
+	}
+
+	for _, line := range []string{
+		"From: no-reply@example.com",
+		"To: user@example.com",
+		"Subject: =?utf-8?q?R=C3=A9initialiser_le_mot_de_passe?=",
+		"Date: Fri, 01 Mar 2024 12:00:00 +0000",
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=utf-8",
+	} {
+		if !strings.Contains(header+"\r\n", line+"\r\n") {
+			t.Errorf("header is missing %q:\n%s", line, header)
+		}
+	}
+
+	// every line ends in CRLF, whatever the template used
+	if want := "Hello,\r\nopen the link:\r\nhttps://example.com/reset?token=abc\r\n"; body != want {
+		t.Errorf("body = %q, want %q", body, want)
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{name: "no backend", cfg: Config{}, wantErr: true},
+		{name: "unknown backend", cfg: Config{Backend: "sendmail"}, wantErr: true},
+		{name: "log", cfg: Config{Backend: "log"}},
+		{name: "file", cfg: Config{Backend: "file", Dir: t.TempDir()}},
+		{name: "file without a directory", cfg: Config{Backend: "file"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mailer, err := New(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New error = %v, want error %v", err, tt.wantErr)
+			}
+			if err == nil && mailer == nil {
+				t.Error("New returned no mailer")
+			}
+		})
+	}
+}
+
+func TestFileMailerSend(t *testing.T) {
+	dir := t.TempDir()
+	mailer, err := NewFileMailer(dir, "no-reply@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := mailer.Send(context.Background(), Message{To: "user@example.com", Subject: "Hi", Body: "Hello"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := mailer.Send(context.Background(), Message{To: "user@example.com\nBcc: victim@example.com", Subject: "Hi"}); err == nil {
+		t.Error("Send accepted a line break in the recipient")
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("wrote %d emails, want 1", len(files))
+	}
+
+	data, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(data), "From: no-reply@example.com\r\nTo: user@example.com\r\n") {
+		t.Errorf("email starts with %q", data)
+	}
+}
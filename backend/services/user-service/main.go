@@ -4,9 +4,11 @@ import (
 	"log"
 	"net"
 	"os"
+	"strings"
 
 	"github.com/joho/godotenv"
 	"github.com/kimashii-dan/food-delivery-app/backend/pkg"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/user-service/mail"
 	"github.com/kimashii-dan/food-delivery-app/backend/services/user-service/pb"
 	"github.com/kimashii-dan/food-delivery-app/backend/services/user-service/repository"
 	"github.com/kimashii-dan/food-delivery-app/backend/services/user-service/service"
@@ -28,10 +30,47 @@ func main() {
 
 	userRepo := repository.NewUserRepository(db)
 	addressRepo := repository.NewAddressRepository(db)
+	tokenRepo := repository.NewRefreshTokenRepository(db)
+	userTokenRepo := repository.NewUserTokenRepository(db)
 
-	jwtSecret := os.Getenv("JWT_SECRET")
-	jwtService := pkg.NewJWTService(jwtSecret)
-	userService := service.NewUserService(userRepo, addressRepo, jwtService)
+	// tokens are signed with the private key when one is set, HS256 with the secret is for local dev
+	jwtService, err := pkg.LoadJWTService(
+		os.Getenv("JWT_SECRET"),
+		os.Getenv("JWT_PRIVATE_KEY_FILE"),
+		os.Getenv("JWT_PUBLIC_KEY_FILES"),
+	)
+	if err != nil {
+		log.Fatalf("Failed to load JWT keys: %v", err)
+	}
+	// "log" prints emails (local dev only, the links work), "file" writes them to MAIL_DIR, "smtp" sends them
+	mailDir := os.Getenv("MAIL_DIR")
+	if mailDir == "" {
+		mailDir = "mail"
+	}
+	mailer, err := mail.New(mail.Config{
+		Backend:      os.Getenv("MAIL_BACKEND"),
+		From:         os.Getenv("MAIL_FROM"),
+		Dir:          mailDir,
+		SMTPHost:     os.Getenv("SMTP_HOST"),
+		SMTPPort:     os.Getenv("SMTP_PORT"),
+		SMTPUsername: os.Getenv("SMTP_USERNAME"),
+		SMTPPassword: os.Getenv("SMTP_PASSWORD"),
+	})
+	if err != nil {
+		log.Fatalf("Failed to init mailer: %v", err)
+	}
+
+	// links in emails point to the frontend
+	accounts := service.DefaultAccountSettings
+	if value := os.Getenv("APP_URL"); value != "" {
+		accounts.AppURL = strings.TrimSuffix(value, "/")
+	}
+	accounts.RequireVerifiedEmail = os.Getenv("REQUIRE_VERIFIED_EMAIL") == "true"
+
+	userService := service.NewUserService(
+		userRepo, addressRepo, tokenRepo, userTokenRepo,
+		jwtService, mailer, accounts,
+	)
 
 	port := os.Getenv("PORT")
 	lis, err := net.Listen("tcp", port)
@@ -0,0 +1,22 @@
+-- A family is the chain of refresh tokens issued from one login; reusing a
+-- rotated token revokes the whole family
+CREATE TABLE IF NOT EXISTS refresh_token_families (
+    id              VARCHAR(36) PRIMARY KEY,
+    user_id         VARCHAR(36) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    revoked_at      TIMESTAMP,
+    created_at      TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_token_families_user_id ON refresh_token_families(user_id);
+
+-- Only the SHA-256 of a token is stored, its expiry is in the token itself;
+-- used_at is set once it was rotated
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    jti             VARCHAR(36) PRIMARY KEY,
+    family_id       VARCHAR(36) NOT NULL REFERENCES refresh_token_families(id) ON DELETE CASCADE,
+    token_hash      CHAR(64) NOT NULL,
+    used_at         TIMESTAMP,
+    created_at      TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens(family_id);
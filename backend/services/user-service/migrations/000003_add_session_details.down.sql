@@ -0,0 +1,4 @@
+ALTER TABLE refresh_token_families
+DROP COLUMN IF EXISTS user_agent,
+DROP COLUMN IF EXISTS ip_address,
+DROP COLUMN IF EXISTS last_used_at;
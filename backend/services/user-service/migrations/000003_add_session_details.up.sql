@@ -0,0 +1,5 @@
+-- Token families double as sessions: one per login, listed and revoked by the user
+ALTER TABLE refresh_token_families
+ADD COLUMN user_agent TEXT NOT NULL DEFAULT '',
+ADD COLUMN ip_address VARCHAR(45) NOT NULL DEFAULT '',
+ADD COLUMN last_used_at TIMESTAMP DEFAULT NOW();
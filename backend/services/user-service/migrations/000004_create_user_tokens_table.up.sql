@@ -0,0 +1,17 @@
+-- NULL until the user follows the link mailed to them
+ALTER TABLE users
+ADD COLUMN email_verified_at TIMESTAMP;
+
+-- One-time tokens mailed to users, to verify their email or reset their
+-- password. Only the SHA-256 of a token is stored; used_at is set once it
+-- was redeemed.
+CREATE TABLE IF NOT EXISTS user_tokens (
+    token_hash      CHAR(64) PRIMARY KEY,
+    user_id         VARCHAR(36) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    purpose         VARCHAR(20) NOT NULL,
+    expires_at      TIMESTAMP NOT NULL,
+    used_at         TIMESTAMP,
+    created_at      TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_tokens_user_id_purpose ON user_tokens(user_id, purpose);
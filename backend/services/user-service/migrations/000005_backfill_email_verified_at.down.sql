@@ -0,0 +1,3 @@
+-- The backfilled users can't be told apart from verified ones, so they stay
+-- verified.
+SELECT 1;
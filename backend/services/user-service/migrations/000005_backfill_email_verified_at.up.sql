@@ -0,0 +1,9 @@
+-- Users who signed up before 000004 were never mailed a link, so they count
+-- as verified. Users who signed up since have a verification token.
+UPDATE users
+SET email_verified_at = COALESCE(created_at, NOW())
+WHERE email_verified_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM user_tokens
+        WHERE user_tokens.user_id = users.id AND user_tokens.purpose = 'verify_email'
+    );
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -53,47 +54,80 @@ func (r *AddressRepository) Create(ctx context.Context, address *Address) error
 	return nil
 }
 
-func (r *AddressRepository) GetByUserID(ctx context.Context, userID string) ([]*Address, error) {
+// AddressCursor is where a page of GetByUserID ended. The default address
+// comes first, then the newest ones.
+type AddressCursor struct {
+	IsDefault bool      `json:"d"`
+	CreatedAt time.Time `json:"t"`
+	ID        string    `json:"i"`
+}
+
+// GetByUserID returns up to limit of the user's addresses after the cursor,
+// or from the start when after is nil, and the cursor of the next page,
+// which is nil on the last page.
+func (r *AddressRepository) GetByUserID(ctx context.Context, userID string, after *AddressCursor, limit int32) ([]*Address, *AddressCursor, error) {
+	args := []any{userID, limit + 1}
+	where := "user_id = $1"
+	if after != nil {
+		args = append(args, after.IsDefault, after.CreatedAt, after.ID)
+		where += " AND (COALESCE(is_default, FALSE), created_at, id) < ($3, $4, $5)"
+	}
+
+	// One extra row tells whether there is a next page
 	query := `
-        SELECT id, user_id, street, city, postal_code, latitude, longitude, is_default,
-               to_char(created_at, 'YYYY-MM-DD HH24:MI:SS')
-        FROM addresses 
-        WHERE user_id = $1
-        ORDER BY is_default DESC, created_at DESC
+        SELECT id, user_id, street, city, postal_code, latitude, longitude, COALESCE(is_default, FALSE),
+               to_char(created_at, 'YYYY-MM-DD HH24:MI:SS'), created_at
+        FROM addresses
+        WHERE ` + where + `
+        ORDER BY COALESCE(is_default, FALSE) DESC, created_at DESC, id DESC
+        LIMIT $2
     `
 
-	rows, err := r.db.Query(ctx, query, userID)
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query addresses: %w", err)
+		return nil, nil, fmt.Errorf("failed to query addresses: %w", err)
 	}
 	defer rows.Close()
 
 	addresses := []*Address{}
+	var createdAt []time.Time
 	for rows.Next() {
 		var addr Address
+		var created time.Time
 		err := rows.Scan(
 			&addr.ID, &addr.UserID, &addr.Street, &addr.City,
 			&addr.PostalCode, &addr.Latitude, &addr.Longitude, &addr.IsDefault,
-			&addr.CreatedAt,
+			&addr.CreatedAt, &created,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan address: %w", err)
+			return nil, nil, fmt.Errorf("failed to scan address: %w", err)
 		}
 		addresses = append(addresses, &addr)
+		createdAt = append(createdAt, created)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating addresses: %w", err)
+		return nil, nil, fmt.Errorf("error iterating addresses: %w", err)
+	}
+
+	if len(addresses) <= int(limit) {
+		return addresses, nil, nil
 	}
 
-	return addresses, nil
+	last := addresses[limit-1]
+	return addresses[:limit], &AddressCursor{
+		IsDefault: last.IsDefault,
+		CreatedAt: createdAt[limit-1],
+		ID:        last.ID,
+	}, nil
 }
 
 func (r *AddressRepository) GetByID(ctx context.Context, addressID string) (*Address, error) {
 	var addr Address
 
 	query := `
-        SELECT id, user_id, street, city, postal_code, latitude, longitude, is_default
+        SELECT id, user_id, street, city, postal_code, latitude, longitude, is_default,
+               to_char(created_at, 'YYYY-MM-DD HH24:MI:SS')
         FROM addresses 
         WHERE id = $1
     `
@@ -101,6 +135,7 @@ func (r *AddressRepository) GetByID(ctx context.Context, addressID string) (*Add
 	err := r.db.QueryRow(ctx, query, addressID).Scan(
 		&addr.ID, &addr.UserID, &addr.Street, &addr.City,
 		&addr.PostalCode, &addr.Latitude, &addr.Longitude, &addr.IsDefault,
+		&addr.CreatedAt,
 	)
 
 	if err != nil {
@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	// ErrRefreshTokenInvalid is returned by Rotate for tokens that were never
+	// issued or whose family was revoked
+	ErrRefreshTokenInvalid = errors.New("refresh token is invalid or revoked")
+	// ErrRefreshTokenReused is returned by Rotate for a token that was
+	// already rotated; its family is revoked by then
+	ErrRefreshTokenReused = errors.New("refresh token was already used")
+)
+
+type RefreshTokenRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRefreshTokenRepository(db *pgxpool.Pool) *RefreshTokenRepository {
+	return &RefreshTokenRepository{db: db}
+}
+
+// RefreshToken is an issued refresh token; only a hash of it is kept
+type RefreshToken struct {
+	JTI       string
+	FamilyID  string
+	TokenHash string
+}
+
+// Client is the device a token family was last used from
+type Client struct {
+	UserAgent string
+	IPAddress string
+}
+
+// Session is a token family as shown to its user
+type Session struct {
+	ID         string
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  string
+	LastUsedAt string
+}
+
+// CreateFamily starts a token family for a login with its first token
+func (r *RefreshTokenRepository) CreateFamily(ctx context.Context, userID string, token *RefreshToken, client Client) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO refresh_token_families (id, user_id, user_agent, ip_address, created_at, last_used_at)
+		VALUES ($1, $2, $3, $4, NOW(), NOW())
+	`
+
+	if _, err := tx.Exec(ctx, query, token.FamilyID, userID, client.UserAgent, client.IPAddress); err != nil {
+		return fmt.Errorf("failed to create token family: %w", err)
+	}
+
+	if err := insertRefreshToken(ctx, tx, token); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit token family: %w", err)
+	}
+
+	return nil
+}
+
+// Rotate uses up the presented token and stores next, the token replacing
+// it in the same family, and records the client as the family's last use.
+// Presenting a token that was already used revokes the family, since either
+// it or its successor is in the wrong hands.
+func (r *RefreshTokenRepository) Rotate(ctx context.Context, presented, next *RefreshToken, client Client) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		SELECT t.token_hash, t.used_at IS NOT NULL, f.revoked_at IS NOT NULL
+		FROM refresh_tokens t
+		JOIN refresh_token_families f ON f.id = t.family_id
+		WHERE t.jti = $1 AND t.family_id = $2
+		FOR UPDATE
+	`
+
+	var tokenHash string
+	var used, revoked bool
+	err = tx.QueryRow(ctx, query, presented.JTI, presented.FamilyID).Scan(&tokenHash, &used, &revoked)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrRefreshTokenInvalid
+		}
+		return fmt.Errorf("failed to get refresh token: %w", err)
+	}
+
+	if tokenHash != presented.TokenHash || revoked {
+		return ErrRefreshTokenInvalid
+	}
+
+	if used {
+		if err := revokeFamily(ctx, tx, presented.FamilyID); err != nil {
+			return err
+		}
+
+		if err := tx.Commit(ctx); err != nil {
+			return fmt.Errorf("failed to commit token family revocation: %w", err)
+		}
+
+		return ErrRefreshTokenReused
+	}
+
+	usedQuery := `UPDATE refresh_tokens SET used_at = NOW() WHERE jti = $1`
+	if _, err := tx.Exec(ctx, usedQuery, presented.JTI); err != nil {
+		return fmt.Errorf("failed to use refresh token: %w", err)
+	}
+
+	if err := insertRefreshToken(ctx, tx, next); err != nil {
+		return err
+	}
+
+	familyQuery := `
+		UPDATE refresh_token_families
+		SET user_agent = $2, ip_address = $3, last_used_at = NOW()
+		WHERE id = $1
+	`
+
+	if _, err := tx.Exec(ctx, familyQuery, next.FamilyID, client.UserAgent, client.IPAddress); err != nil {
+		return fmt.Errorf("failed to update token family: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit refresh token rotation: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeFamily ends the login a token family belongs to. It reports false
+// when the family is not the user's or was already revoked.
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID, userID string) (bool, error) {
+	query := `
+		UPDATE refresh_token_families
+		SET revoked_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+	`
+
+	tag, err := r.db.Exec(ctx, query, familyID, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to revoke token family: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// RevokeOtherFamilies ends every login of the user but keepID and returns
+// how many were ended
+func (r *RefreshTokenRepository) RevokeOtherFamilies(ctx context.Context, userID, keepID string) (int64, error) {
+	query := `
+		UPDATE refresh_token_families
+		SET revoked_at = NOW()
+		WHERE user_id = $1 AND id <> $2 AND revoked_at IS NULL
+	`
+
+	tag, err := r.db.Exec(ctx, query, userID, keepID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke token families: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// ListSessions returns the user's token families that are not revoked and
+// were used within the last idleSeconds, most recently used first
+func (r *RefreshTokenRepository) ListSessions(ctx context.Context, userID string, idleSeconds int64) ([]*Session, error) {
+	query := `
+		SELECT id, user_agent, ip_address,
+		       to_char(created_at, 'YYYY-MM-DD HH24:MI:SS'),
+		       to_char(last_used_at, 'YYYY-MM-DD HH24:MI:SS')
+		FROM refresh_token_families
+		WHERE user_id = $1 AND revoked_at IS NULL
+		  AND last_used_at > NOW() - make_interval(secs => $2)
+		ORDER BY last_used_at DESC, id
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, idleSeconds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sessions: %w", err)
+	}
+	defer rows.Close()
+
+	sessions := []*Session{}
+	for rows.Next() {
+		var session Session
+		err := rows.Scan(
+			&session.ID, &session.UserAgent, &session.IPAddress,
+			&session.CreatedAt, &session.LastUsedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan session: %w", err)
+		}
+		sessions = append(sessions, &session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating sessions: %w", err)
+	}
+
+	return sessions, nil
+}
+
+func revokeFamily(ctx context.Context, tx pgx.Tx, familyID string) error {
+	query := `
+		UPDATE refresh_token_families
+		SET revoked_at = NOW()
+		WHERE id = $1 AND revoked_at IS NULL
+	`
+
+	if _, err := tx.Exec(ctx, query, familyID); err != nil {
+		return fmt.Errorf("failed to revoke token family: %w", err)
+	}
+
+	return nil
+}
+
+func insertRefreshToken(ctx context.Context, tx pgx.Tx, token *RefreshToken) error {
+	query := `
+		INSERT INTO refresh_tokens (jti, family_id, token_hash, created_at)
+		VALUES ($1, $2, $3, NOW())
+	`
+
+	if _, err := tx.Exec(ctx, query, token.JTI, token.FamilyID, token.TokenHash); err != nil {
+		return fmt.Errorf("failed to store refresh token: %w", err)
+	}
+
+	return nil
+}
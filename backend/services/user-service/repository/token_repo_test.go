@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// testDB connects to TEST_DATABASE_URL and migrates it; tests that need a
+// database are skipped without one
+func testDB(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	m, err := migrate.New("file://../migrations", url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		t.Fatal(err)
+	}
+
+	db, err := pgxpool.New(context.Background(), url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(db.Close)
+
+	return db
+}
+
+// testUser creates a user that is deleted, with its tokens, after the test
+func testUser(t *testing.T, db *pgxpool.Pool) string {
+	t.Helper()
+	ctx := context.Background()
+
+	userID := uuid.New().String()
+	err := NewUserRepository(db).Create(ctx, &User{
+		ID:           userID,
+		Email:        userID + "@example.com",
+		PasswordHash: "hash",
+		Name:         "Test",
+		Phone:        "+10000000000",
+		Role:         "customer",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		db.Exec(ctx, `DELETE FROM users WHERE id = $1`, userID)
+	})
+
+	return userID
+}
+
+func TestRotateRevokesFamilyOnReuse(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	repo := NewRefreshTokenRepository(db)
+	userID := testUser(t, db)
+
+	familyID := uuid.New().String()
+	// hashes fill the CHAR(64) column like real ones, shorter ones come back padded
+	token := func(hash string) *RefreshToken {
+		return &RefreshToken{JTI: uuid.New().String(), FamilyID: familyID, TokenHash: strings.Repeat(hash, 64)}
+	}
+	client := Client{UserAgent: "test", IPAddress: "127.0.0.1"}
+
+	first, second, third := token("1"), token("2"), token("3")
+	if err := repo.CreateFamily(ctx, userID, first, client); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		presented *RefreshToken
+		next      *RefreshToken
+		want      error
+	}{
+		{name: "first use", presented: first, next: second},
+		{name: "wrong hash", presented: &RefreshToken{JTI: second.JTI, FamilyID: familyID, TokenHash: strings.Repeat("x", 64)}, next: token("4"), want: ErrRefreshTokenInvalid},
+		{name: "unknown token", presented: token("5"), next: token("6"), want: ErrRefreshTokenInvalid},
+		{name: "reused token", presented: first, next: third, want: ErrRefreshTokenReused},
+		// the reuse revoked the family, so the current token is dead too
+		{name: "successor after reuse", presented: second, next: third, want: ErrRefreshTokenInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := repo.Rotate(ctx, tt.presented, tt.next, client); !errors.Is(err, tt.want) {
+				t.Fatalf("Rotate = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	sessions, err := repo.ListSessions(ctx, userID, 3600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 0 {
+		t.Errorf("user has %d sessions after reuse, want 0", len(sessions))
+	}
+}
+
+func TestRevokeSessions(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	repo := NewRefreshTokenRepository(db)
+	userID, otherID := testUser(t, db), testUser(t, db)
+
+	login := func(userID string) string {
+		t.Helper()
+		familyID := uuid.New().String()
+		token := &RefreshToken{JTI: uuid.New().String(), FamilyID: familyID, TokenHash: "hash"}
+		if err := repo.CreateFamily(ctx, userID, token, Client{UserAgent: "test"}); err != nil {
+			t.Fatal(err)
+		}
+		return familyID
+	}
+
+	current, phone, laptop := login(userID), login(userID), login(userID)
+	foreign := login(otherID)
+
+	// someone else's session can't be revoked, an ended one not twice
+	if revoked, err := repo.RevokeFamily(ctx, foreign, userID); err != nil || revoked {
+		t.Errorf("RevokeFamily(other user's session) = %v, %v, want false", revoked, err)
+	}
+	if revoked, err := repo.RevokeFamily(ctx, phone, userID); err != nil || !revoked {
+		t.Errorf("RevokeFamily(own session) = %v, %v, want true", revoked, err)
+	}
+	if revoked, err := repo.RevokeFamily(ctx, phone, userID); err != nil || revoked {
+		t.Errorf("RevokeFamily(revoked session) = %v, %v, want false", revoked, err)
+	}
+
+	// only the laptop is left to end besides the current session
+	if n, err := repo.RevokeOtherFamilies(ctx, userID, current); err != nil || n != 1 {
+		t.Errorf("RevokeOtherFamilies = %d, %v, want 1", n, err)
+	}
+
+	sessions, err := repo.ListSessions(ctx, userID, 3600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 1 || sessions[0].ID != current {
+		t.Errorf("sessions = %+v, want only %s (not %s)", sessions, current, laptop)
+	}
+
+	others, err := repo.ListSessions(ctx, otherID, 3600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(others) != 1 || others[0].ID != foreign {
+		t.Errorf("other user's sessions = %+v, want only %s", others, foreign)
+	}
+}
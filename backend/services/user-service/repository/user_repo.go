@@ -16,13 +16,14 @@ func NewUserRepository(db *pgxpool.Pool) *UserRepository {
 }
 
 type User struct {
-	ID           string
-	Email        string
-	PasswordHash string
-	Name         string
-	Phone        string
-	Role         string
-	CreatedAt    string
+	ID            string
+	Email         string
+	PasswordHash  string
+	Name          string
+	Phone         string
+	Role          string
+	EmailVerified bool
+	CreatedAt     string
 }
 
 func (r *UserRepository) Create(ctx context.Context, user *User) error {
@@ -47,7 +48,8 @@ func (r *UserRepository) GetByID(ctx context.Context, id string) (*User, error)
 	var user User
 
 	query := `
-        SELECT id, email, password_hash, name, phone, role, 
+        SELECT id, email, password_hash, name, phone, role,
+               email_verified_at IS NOT NULL,
                to_char(created_at, 'YYYY-MM-DD HH24:MI:SS')
         FROM users 
         WHERE id = $1
@@ -55,7 +57,8 @@ func (r *UserRepository) GetByID(ctx context.Context, id string) (*User, error)
 
 	err := r.db.QueryRow(ctx, query, id).Scan(
 		&user.ID, &user.Email, &user.PasswordHash,
-		&user.Name, &user.Phone, &user.Role, &user.CreatedAt,
+		&user.Name, &user.Phone, &user.Role, &user.EmailVerified,
+		&user.CreatedAt,
 	)
 
 	if err != nil {
@@ -69,7 +72,8 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*User, e
 	var user User
 
 	query := `
-        SELECT id, email, password_hash, name, phone, role, 
+        SELECT id, email, password_hash, name, phone, role,
+               email_verified_at IS NOT NULL,
                to_char(created_at, 'YYYY-MM-DD HH24:MI:SS')
         FROM users 
         WHERE email = $1
@@ -77,7 +81,8 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*User, e
 
 	err := r.db.QueryRow(ctx, query, email).Scan(
 		&user.ID, &user.Email, &user.PasswordHash,
-		&user.Name, &user.Phone, &user.Role, &user.CreatedAt,
+		&user.Name, &user.Phone, &user.Role, &user.EmailVerified,
+		&user.CreatedAt,
 	)
 
 	if err != nil {
@@ -116,3 +121,20 @@ func (r *UserRepository) Update(ctx context.Context, userID, name, phone string)
 
 	return nil
 }
+
+// UpdateRole sets the role of a user. It reports false when there is no
+// such user.
+func (r *UserRepository) UpdateRole(ctx context.Context, userID, role string) (bool, error) {
+	query := `
+		UPDATE users
+		SET role = $1, updated_at = NOW()
+		WHERE id = $2
+	`
+
+	tag, err := r.db.Exec(ctx, query, role, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to update user role: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
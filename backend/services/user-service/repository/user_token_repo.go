@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Purposes of user tokens
+const (
+	PurposeVerifyEmail   = "verify_email"
+	PurposeResetPassword = "reset_password"
+)
+
+var (
+	// ErrUserTokenInvalid is returned for tokens that were never issued,
+	// expired, were already used or replaced by a newer one
+	ErrUserTokenInvalid = errors.New("token is invalid or expired")
+	// ErrUserTokenThrottled is returned by Create when a token of the same
+	// purpose was issued to the user too recently
+	ErrUserTokenThrottled = errors.New("token was issued too recently")
+)
+
+type UserTokenRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewUserTokenRepository(db *pgxpool.Pool) *UserTokenRepository {
+	return &UserTokenRepository{db: db}
+}
+
+// Create stores a token for the user that is valid for ttl. Earlier unused
+// tokens of the same purpose stop working, so only the latest mail counts.
+// No token is created when the latest one is younger than cooldown.
+func (r *UserTokenRepository) Create(ctx context.Context, userID, purpose, tokenHash string, ttl, cooldown time.Duration) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// serializes concurrent requests for the same user
+	lockQuery := `SELECT id FROM users WHERE id = $1 FOR UPDATE`
+	if _, err := tx.Exec(ctx, lockQuery, userID); err != nil {
+		return fmt.Errorf("failed to lock user: %w", err)
+	}
+
+	recentQuery := `
+		SELECT EXISTS (
+			SELECT 1 FROM user_tokens
+			WHERE user_id = $1 AND purpose = $2
+			  AND created_at > NOW() - make_interval(secs => $3)
+		)
+	`
+
+	var recent bool
+	if err := tx.QueryRow(ctx, recentQuery, userID, purpose, cooldown.Seconds()).Scan(&recent); err != nil {
+		return fmt.Errorf("failed to check recent tokens: %w", err)
+	}
+	if recent {
+		return ErrUserTokenThrottled
+	}
+
+	deleteQuery := `DELETE FROM user_tokens WHERE user_id = $1 AND purpose = $2 AND used_at IS NULL`
+	if _, err := tx.Exec(ctx, deleteQuery, userID, purpose); err != nil {
+		return fmt.Errorf("failed to delete earlier tokens: %w", err)
+	}
+
+	insertQuery := `
+		INSERT INTO user_tokens (token_hash, user_id, purpose, expires_at, created_at)
+		VALUES ($1, $2, $3, NOW() + make_interval(secs => $4), NOW())
+	`
+
+	if _, err := tx.Exec(ctx, insertQuery, tokenHash, userID, purpose, ttl.Seconds()); err != nil {
+		return fmt.Errorf("failed to store token: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit token: %w", err)
+	}
+
+	return nil
+}
+
+// VerifyEmail uses up a verification token and marks the email of its user
+// as verified. It returns the user's id.
+func (r *UserTokenRepository) VerifyEmail(ctx context.Context, tokenHash string) (string, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	userID, err := useToken(ctx, tx, tokenHash, PurposeVerifyEmail)
+	if err != nil {
+		return "", err
+	}
+
+	query := `
+		UPDATE users
+		SET email_verified_at = COALESCE(email_verified_at, NOW()), updated_at = NOW()
+		WHERE id = $1
+	`
+
+	if _, err := tx.Exec(ctx, query, userID); err != nil {
+		return "", fmt.Errorf("failed to verify email: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", fmt.Errorf("failed to commit email verification: %w", err)
+	}
+
+	return userID, nil
+}
+
+// ResetPassword uses up a password reset token, sets the new password of
+// its user and logs them out everywhere. Following the link also proves
+// the email, so it is marked verified. It returns the user's id.
+func (r *UserTokenRepository) ResetPassword(ctx context.Context, tokenHash, passwordHash string) (string, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	userID, err := useToken(ctx, tx, tokenHash, PurposeResetPassword)
+	if err != nil {
+		return "", err
+	}
+
+	userQuery := `
+		UPDATE users
+		SET password_hash = $2, email_verified_at = COALESCE(email_verified_at, NOW()), updated_at = NOW()
+		WHERE id = $1
+	`
+
+	if _, err := tx.Exec(ctx, userQuery, userID, passwordHash); err != nil {
+		return "", fmt.Errorf("failed to update password: %w", err)
+	}
+
+	familiesQuery := `
+		UPDATE refresh_token_families
+		SET revoked_at = NOW()
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`
+
+	if _, err := tx.Exec(ctx, familiesQuery, userID); err != nil {
+		return "", fmt.Errorf("failed to revoke token families: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", fmt.Errorf("failed to commit password reset: %w", err)
+	}
+
+	return userID, nil
+}
+
+// useToken marks a valid token as used and returns its user. The update
+// is conditional, so of two concurrent uses only one succeeds.
+func useToken(ctx context.Context, tx pgx.Tx, tokenHash, purpose string) (string, error) {
+	query := `
+		UPDATE user_tokens
+		SET used_at = NOW()
+		WHERE token_hash = $1 AND purpose = $2
+		  AND used_at IS NULL AND expires_at > NOW()
+		RETURNING user_id
+	`
+
+	var userID string
+	if err := tx.QueryRow(ctx, query, tokenHash, purpose).Scan(&userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrUserTokenInvalid
+		}
+		return "", fmt.Errorf("failed to use token: %w", err)
+	}
+
+	return userID, nil
+}
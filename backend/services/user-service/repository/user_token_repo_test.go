@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUserTokens(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	repo := NewUserTokenRepository(db)
+	userID := testUser(t, db)
+
+	// hashes fill the CHAR(64) column like real ones
+	hash := func(s string) string { return strings.Repeat(s, 64) }
+
+	verified := func() bool {
+		t.Helper()
+		user, err := NewUserRepository(db).GetByID(ctx, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return user.EmailVerified
+	}
+
+	if err := repo.Create(ctx, userID, PurposeVerifyEmail, hash("a"), time.Hour, 0); err != nil {
+		t.Fatal(err)
+	}
+	// a second mail within the cooldown is not sent
+	if err := repo.Create(ctx, userID, PurposeVerifyEmail, hash("b"), time.Hour, time.Minute); !errors.Is(err, ErrUserTokenThrottled) {
+		t.Fatalf("Create within cooldown = %v, want ErrUserTokenThrottled", err)
+	}
+	// a newer token replaces the one mailed before
+	if err := repo.Create(ctx, userID, PurposeVerifyEmail, hash("c"), time.Hour, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Create(ctx, userID, PurposeResetPassword, hash("d"), -time.Second, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		use  func() (string, error)
+		want error
+	}{
+		{name: "replaced token", use: func() (string, error) { return repo.VerifyEmail(ctx, hash("a")) }, want: ErrUserTokenInvalid},
+		{name: "throttled token was never stored", use: func() (string, error) { return repo.VerifyEmail(ctx, hash("b")) }, want: ErrUserTokenInvalid},
+		{name: "token of another purpose", use: func() (string, error) { return repo.ResetPassword(ctx, hash("c"), "new hash") }, want: ErrUserTokenInvalid},
+		{name: "latest token", use: func() (string, error) { return repo.VerifyEmail(ctx, hash("c")) }},
+		{name: "used token", use: func() (string, error) { return repo.VerifyEmail(ctx, hash("c")) }, want: ErrUserTokenInvalid},
+		{name: "expired token", use: func() (string, error) { return repo.ResetPassword(ctx, hash("d"), "new hash") }, want: ErrUserTokenInvalid},
+	}
+
+	if verified() {
+		t.Fatal("new user is already verified")
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := tt.use()
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("error = %v, want %v", err, tt.want)
+			}
+			if err == nil && id != userID {
+				t.Errorf("token belongs to %s, want %s", id, userID)
+			}
+		})
+	}
+
+	if !verified() {
+		t.Error("email is not verified after following the link")
+	}
+}
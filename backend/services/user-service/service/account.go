@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/services/user-service/mail"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/user-service/pb"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/user-service/repository"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	verifyEmailTokenDuration   = 24 * time.Hour
+	resetPasswordTokenDuration = time.Hour
+	// userTokenCooldown limits how often one user can be mailed a link
+	userTokenCooldown = time.Minute
+	// mailTimeout bounds sending a mail, which happens after the request
+	// that asked for it has been answered
+	mailTimeout = 30 * time.Second
+
+	minPasswordLength = 6
+)
+
+// AccountSettings configure email verification and password resets
+type AccountSettings struct {
+	// AppURL is the frontend the mailed links point to, e.g.
+	// "<AppURL>/verify-email?token=..."
+	AppURL string
+	// RequireVerifiedEmail makes Login refuse users who haven't verified
+	// their email yet
+	RequireVerifiedEmail bool
+}
+
+var DefaultAccountSettings = AccountSettings{
+	AppURL: "http://localhost:5173",
+}
+
+func (s *UserService) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	if _, err := s.userTokenRepo.VerifyEmail(ctx, hashToken(req.Token)); err != nil {
+		if errors.Is(err, repository.ErrUserTokenInvalid) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	return &pb.VerifyEmailResponse{}, nil
+}
+
+func (s *UserService) ResendVerificationEmail(ctx context.Context, req *pb.ResendVerificationEmailRequest) (*pb.ResendVerificationEmailResponse, error) {
+	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil || user.EmailVerified {
+		return &pb.ResendVerificationEmailResponse{}, nil
+	}
+
+	if err := s.sendVerificationEmail(ctx, user); err != nil && !errors.Is(err, repository.ErrUserTokenThrottled) {
+		return nil, err
+	}
+
+	return &pb.ResendVerificationEmailResponse{}, nil
+}
+
+func (s *UserService) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		return &pb.RequestPasswordResetResponse{}, nil
+	}
+
+	token, err := s.issueUserToken(ctx, user, repository.PurposeResetPassword, resetPasswordTokenDuration)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserTokenThrottled) {
+			return &pb.RequestPasswordResetResponse{}, nil
+		}
+		return nil, err
+	}
+
+	s.sendMail(user, mail.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nsomeone asked to reset the password of your account. To choose a new one, open this link within an hour:\n\n%s\n\nIf it wasn't you, ignore this email and your password stays the same.\n",
+			user.Name, s.link("/reset-password", token),
+		),
+	})
+
+	return &pb.RequestPasswordResetResponse{}, nil
+}
+
+func (s *UserService) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	if len(req.NewPassword) < minPasswordLength {
+		return nil, status.Errorf(codes.InvalidArgument, "password must be at least %d characters", minPasswordLength)
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	userID, err := s.userTokenRepo.ResetPassword(ctx, hashToken(req.Token), string(hashedPassword))
+	if err != nil {
+		if errors.Is(err, repository.ErrUserTokenInvalid) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	log.Printf("Password of user %s was reset, revoked all of their sessions", userID)
+
+	return &pb.ResetPasswordResponse{}, nil
+}
+
+func (s *UserService) sendVerificationEmail(ctx context.Context, user *repository.User) error {
+	token, err := s.issueUserToken(ctx, user, repository.PurposeVerifyEmail, verifyEmailTokenDuration)
+	if err != nil {
+		return err
+	}
+
+	s.sendMail(user, mail.Message{
+		To:      user.Email,
+		Subject: "Verify your email",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nplease confirm that this is your email address by opening this link within 24 hours:\n\n%s\n",
+			user.Name, s.link("/verify-email", token),
+		),
+	})
+
+	return nil
+}
+
+// issueUserToken creates a random one-time token of purpose for the user;
+// only its hash is stored
+func (s *UserService) issueUserToken(ctx context.Context, user *repository.User, purpose string, duration time.Duration) (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	if err := s.userTokenRepo.Create(ctx, user.ID, purpose, hashToken(token), duration, userTokenCooldown); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// sendMail sends in the background, so the response doesn't take longer
+// for accounts that exist and a slow mail server doesn't hold up requests
+func (s *UserService) sendMail(user *repository.User, msg mail.Message) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), mailTimeout)
+		defer cancel()
+
+		if err := s.mailer.Send(ctx, msg); err != nil {
+			log.Printf("Failed to send %q to user %s: %v", msg.Subject, user.ID, err)
+		}
+	}()
+}
+
+func (s *UserService) link(path, token string) string {
+	return s.accounts.AppURL + path + "?token=" + url.QueryEscape(token)
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/services/user-service/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLink(t *testing.T) {
+	s := &UserService{accounts: AccountSettings{AppURL: "https://app.example.com"}}
+
+	tests := []struct {
+		name  string
+		token string
+		want  string
+	}{
+		{name: "url safe token", token: "abc_DEF-123", want: "https://app.example.com/verify-email?token=abc_DEF-123"},
+		{name: "reserved characters are escaped", token: "a+b/c=&d", want: "https://app.example.com/verify-email?token=a%2Bb%2Fc%3D%26d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := s.link("/verify-email", tt.token)
+			if got != tt.want {
+				t.Errorf("link = %s, want %s", got, tt.want)
+			}
+
+			// the frontend reads back exactly the token that was issued
+			u, err := url.Parse(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if token := u.Query().Get("token"); token != tt.token {
+				t.Errorf("link carries token %q, want %q", token, tt.token)
+			}
+		})
+	}
+}
+
+func TestAccountTokenValidation(t *testing.T) {
+	// no repositories: the requests must be turned down before any lookup
+	s := &UserService{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "verify without a token",
+			call: func() error {
+				_, err := s.VerifyEmail(ctx, &pb.VerifyEmailRequest{})
+				return err
+			},
+		},
+		{
+			name: "reset without a token",
+			call: func() error {
+				_, err := s.ResetPassword(ctx, &pb.ResetPasswordRequest{NewPassword: "long enough"})
+				return err
+			},
+		},
+		{
+			name: "reset to a short password",
+			call: func() error {
+				_, err := s.ResetPassword(ctx, &pb.ResetPasswordRequest{Token: "token", NewPassword: strings.Repeat("p", minPasswordLength-1)})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("error = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
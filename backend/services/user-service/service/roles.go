@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/services/user-service/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Roles a user can have. Everyone signs up as a customer; the others are
+// granted by an admin.
+const (
+	RoleCustomer   = "customer"
+	RoleRestaurant = "restaurant"
+	RoleCourier    = "courier"
+	RoleAdmin      = "admin"
+)
+
+var roles = []string{RoleCustomer, RoleRestaurant, RoleCourier, RoleAdmin}
+
+// SetUserRole lets an admin change the role of another user. The actor's
+// role is read from the database rather than trusted from their token, so
+// an admin who was just demoted can't keep granting roles.
+func (s *UserService) SetUserRole(ctx context.Context, req *pb.SetUserRoleRequest) (*pb.SetUserRoleResponse, error) {
+	if req.UserId == "" || req.ActorUserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id and actor user id are required")
+	}
+
+	if !slices.Contains(roles, req.Role) {
+		return nil, status.Errorf(codes.InvalidArgument, "role must be one of %v", roles)
+	}
+
+	actor, err := s.userRepo.GetByID(ctx, req.ActorUserId)
+	if err != nil || actor.Role != RoleAdmin {
+		return nil, status.Error(codes.PermissionDenied, "only admins can change roles")
+	}
+
+	// keeps the last admin from locking everyone out
+	if req.UserId == req.ActorUserId {
+		return nil, status.Error(codes.FailedPrecondition, "admins can't change their own role")
+	}
+
+	found, err := s.userRepo.UpdateRole(ctx, req.UserId, req.Role)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get updated user: %w", err)
+	}
+
+	return &pb.SetUserRoleResponse{
+		User: toPbUser(user),
+	}, nil
+}
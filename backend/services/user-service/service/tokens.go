@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/user-service/repository"
+)
+
+const (
+	accessTokenDuration  = 15 * time.Minute
+	refreshTokenDuration = 5 * 24 * time.Hour
+)
+
+type tokenPair struct {
+	access  string
+	refresh string
+}
+
+// issueTokens signs an access token and a refresh token of familyID for
+// the user. The refresh token is returned with its record, which the caller
+// stores.
+func (s *UserService) issueTokens(user *repository.User, familyID string) (*tokenPair, *repository.RefreshToken, error) {
+	accessToken, err := s.jwtService.GenerateToken(user.ID, user.Email, user.Role, familyID, accessTokenDuration)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	jti := uuid.New().String()
+	refreshToken, err := s.jwtService.GenerateRefreshToken(user.ID, user.Email, user.Role, familyID, jti, refreshTokenDuration)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	return &tokenPair{access: accessToken, refresh: refreshToken}, &repository.RefreshToken{
+		JTI:       jti,
+		FamilyID:  familyID,
+		TokenHash: hashToken(refreshToken),
+	}, nil
+}
+
+// startTokenFamily issues the first tokens of a new login, which is also a
+// new session
+func (s *UserService) startTokenFamily(ctx context.Context, user *repository.User, client repository.Client) (*tokenPair, error) {
+	tokens, record, err := s.issueTokens(user, uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.tokenRepo.CreateFamily(ctx, user.ID, record, client); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// maxUserAgentLength keeps what clients send in the user agent bounded
+const maxUserAgentLength = 512
+
+func toClient(userAgent, ipAddress string) repository.Client {
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = strings.ToValidUTF8(userAgent[:maxUserAgentLength], "")
+	}
+
+	return repository.Client{
+		UserAgent: userAgent,
+		IPAddress: ipAddress,
+	}
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/pkg"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/user-service/repository"
+)
+
+func TestHashToken(t *testing.T) {
+	// SHA-256 of "abc" from FIPS 180-2
+	if got, want := hashToken("abc"), "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"; got != want {
+		t.Errorf("hashToken(abc) = %s, want %s", got, want)
+	}
+
+	// the column holding hashes is CHAR(64)
+	if got := hashToken("a much longer refresh token than the others"); len(got) != 64 {
+		t.Errorf("hash has %d characters, want 64", len(got))
+	}
+
+	if hashToken("token-1") == hashToken("token-2") {
+		t.Error("different tokens hash the same")
+	}
+}
+
+func TestIssueTokens(t *testing.T) {
+	jwtService := pkg.NewJWTService("secret")
+	s := &UserService{jwtService: jwtService}
+	user := &repository.User{ID: "user-1", Email: "user@example.com", Role: "customer"}
+
+	tokens, record, err := s.issueTokens(user, "family-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	access, err := jwtService.ValidateToken(tokens.access)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if access.IsRefresh() {
+		t.Error("access token is a refresh token")
+	}
+	if access.SessionID != "family-1" || access.UserID != user.ID || access.Role != user.Role {
+		t.Errorf("access claims = %+v, want session family-1 of user-1 as customer", access)
+	}
+
+	refresh, err := jwtService.ValidateToken(tokens.refresh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !refresh.IsRefresh() || refresh.Family != "family-1" {
+		t.Errorf("refresh token family = %q, want family-1", refresh.Family)
+	}
+
+	// the record is what Rotate later matches the presented token against
+	if record.JTI == "" || record.JTI != refresh.ID {
+		t.Errorf("record jti = %q, token jti = %q", record.JTI, refresh.ID)
+	}
+	if record.FamilyID != "family-1" {
+		t.Errorf("record family = %q, want family-1", record.FamilyID)
+	}
+	if record.TokenHash != hashToken(tokens.refresh) {
+		t.Error("record hash is not the hash of the refresh token")
+	}
+
+	// rotating issues a new token of the same family
+	next, nextRecord, err := s.issueTokens(user, "family-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.refresh == tokens.refresh || nextRecord.JTI == record.JTI || nextRecord.TokenHash == record.TokenHash {
+		t.Error("rotated refresh token is not a new token")
+	}
+	if nextRecord.FamilyID != record.FamilyID {
+		t.Errorf("rotated token family = %q, want %q", nextRecord.FamilyID, record.FamilyID)
+	}
+}
+
+func TestToClient(t *testing.T) {
+	long := strings.Repeat("a", maxUserAgentLength+10)
+	// a two-byte character straddling the limit
+	straddling := strings.Repeat("a", maxUserAgentLength-1) + "é"
+
+	tests := []struct {
+		name      string
+		userAgent string
+		want      string
+	}{
+		{name: "short", userAgent: "Mozilla/5.0", want: "Mozilla/5.0"},
+		{name: "empty", userAgent: "", want: ""},
+		{name: "exactly the limit", userAgent: long[:maxUserAgentLength], want: long[:maxUserAgentLength]},
+		{name: "too long", userAgent: long, want: long[:maxUserAgentLength]},
+		{name: "character cut in half is dropped", userAgent: straddling, want: straddling[:maxUserAgentLength-1]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := toClient(tt.userAgent, "203.0.113.7")
+
+			if client.UserAgent != tt.want {
+				t.Errorf("user agent has %d bytes, want %d", len(client.UserAgent), len(tt.want))
+			}
+			if !utf8.ValidString(client.UserAgent) {
+				t.Error("user agent is not valid UTF-8")
+			}
+			if client.IPAddress != "203.0.113.7" {
+				t.Errorf("ip address = %q, want 203.0.113.7", client.IPAddress)
+			}
+		})
+	}
+}
@@ -2,28 +2,54 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/kimashii-dan/food-delivery-app/backend/pkg"
+	"github.com/kimashii-dan/food-delivery-app/backend/services/user-service/mail"
 	"github.com/kimashii-dan/food-delivery-app/backend/services/user-service/pb"
 	"github.com/kimashii-dan/food-delivery-app/backend/services/user-service/repository"
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// defaultAddressPageSize is large enough that clients which do not page
+// still get all addresses of practically every user
+const defaultAddressPageSize = 50
+
 type UserService struct {
 	pb.UnimplementedUserServiceServer
-	userRepo    *repository.UserRepository
-	addressRepo *repository.AddressRepository
-	jwtService  *pkg.JWTService
+	userRepo      *repository.UserRepository
+	addressRepo   *repository.AddressRepository
+	tokenRepo     *repository.RefreshTokenRepository
+	userTokenRepo *repository.UserTokenRepository
+	jwtService    *pkg.JWTService
+	mailer        mail.Mailer
+	accounts      AccountSettings
 }
 
-func NewUserService(userRepo *repository.UserRepository, addressRepo *repository.AddressRepository, jwtService *pkg.JWTService) *UserService {
+func NewUserService(
+	userRepo *repository.UserRepository,
+	addressRepo *repository.AddressRepository,
+	tokenRepo *repository.RefreshTokenRepository,
+	userTokenRepo *repository.UserTokenRepository,
+	jwtService *pkg.JWTService,
+	mailer mail.Mailer,
+	accounts AccountSettings,
+) *UserService {
 	return &UserService{
-		userRepo:    userRepo,
-		addressRepo: addressRepo,
-		jwtService:  jwtService,
+		userRepo:      userRepo,
+		addressRepo:   addressRepo,
+		tokenRepo:     tokenRepo,
+		userTokenRepo: userTokenRepo,
+		jwtService:    jwtService,
+		mailer:        mailer,
+		accounts:      accounts,
 	}
 }
 
@@ -47,13 +73,18 @@ func (s *UserService) Register(ctx context.Context, req *pb.RegisterRequest) (*p
 		PasswordHash: string(hashedPassword),
 		Name:         req.Name,
 		Phone:        req.Phone,
-		Role:         req.Role,
+		Role:         RoleCustomer,
 	}
 
 	if err := s.userRepo.Create(ctx, user); err != nil {
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
+	// the account exists either way, a lost email can be sent again
+	if err := s.sendVerificationEmail(ctx, user); err != nil {
+		log.Printf("Failed to send verification email to user %s: %v", user.ID, err)
+	}
+
 	return &pb.RegisterResponse{
 		UserId: user.ID,
 	}, nil
@@ -69,54 +100,143 @@ func (s *UserService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Logi
 		return nil, fmt.Errorf("invalid email or password")
 	}
 
-	accessDuration := 15 * time.Minute
-	accessToken, err := s.jwtService.GenerateToken(user.ID, user.Email, user.Role, accessDuration)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate token: %w", err)
+	if s.accounts.RequireVerifiedEmail && !user.EmailVerified {
+		return nil, status.Error(codes.PermissionDenied, "email address is not verified")
 	}
 
-	refreshDuration := 5 * 24 * time.Hour
-	refreshToken, err := s.jwtService.GenerateToken(user.ID, user.Email, user.Role, refreshDuration)
+	tokens, err := s.startTokenFamily(ctx, user, toClient(req.UserAgent, req.IpAddress))
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate token: %w", err)
+		return nil, err
 	}
 
 	return &pb.LoginResponse{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		User: &pb.User{
-			Id:        user.ID,
-			Email:     user.Email,
-			Name:      user.Name,
-			Phone:     user.Phone,
-			Role:      user.Role,
-			CreatedAt: user.CreatedAt,
-		},
+		AccessToken:  tokens.access,
+		RefreshToken: tokens.refresh,
+		User:         toPbUser(user),
 	}, nil
 }
 
+// Refresh rotates a refresh token: the presented token is used up and a new
+// one of the same family is returned. A token presented a second time
+// revokes its family, logging out whoever holds the current token.
 func (s *UserService) Refresh(ctx context.Context, req *pb.RefreshRequest) (*pb.RefreshResponse, error) {
-	tokenString := req.RefreshToken
-	claims, err := s.jwtService.ValidateToken(tokenString)
-	if err != nil {
-		return nil, fmt.Errorf("failed to validate token: %w", err)
+	claims, err := s.jwtService.ValidateToken(req.RefreshToken)
+	if err != nil || !claims.IsRefresh() || claims.ID == "" {
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired refresh token")
 	}
 
-	accessDuration := 15 * time.Minute
-	accessToken, err := s.jwtService.GenerateToken(claims.UserID, claims.Email, claims.Role, accessDuration)
+	// Tokens carry the role they were issued with, take the current one
+	user, err := s.userRepo.GetByID(ctx, claims.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate token: %w", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired refresh token")
 	}
 
-	refreshDuration := 5 * 24 * time.Hour
-	refreshToken, err := s.jwtService.GenerateToken(claims.UserID, claims.Email, claims.Role, refreshDuration)
+	tokens, next, err := s.issueTokens(user, claims.Family)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate token: %w", err)
+		return nil, err
+	}
+
+	presented := &repository.RefreshToken{
+		JTI:       claims.ID,
+		FamilyID:  claims.Family,
+		TokenHash: hashToken(req.RefreshToken),
+	}
+
+	if err := s.tokenRepo.Rotate(ctx, presented, next, toClient(req.UserAgent, req.IpAddress)); err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenReused) {
+			log.Printf("Refresh token %s of user %s was reused, revoked token family %s", claims.ID, user.ID, claims.Family)
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired refresh token")
+		}
+		if errors.Is(err, repository.ErrRefreshTokenInvalid) {
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired refresh token")
+		}
+		return nil, err
 	}
 
 	return &pb.RefreshResponse{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		AccessToken:  tokens.access,
+		RefreshToken: tokens.refresh,
+	}, nil
+}
+
+// Logout revokes the token family of a refresh token, so neither it nor any
+// token rotated from it can be used again
+func (s *UserService) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	claims, err := s.jwtService.ValidateToken(req.RefreshToken)
+	if err != nil || !claims.IsRefresh() {
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired refresh token")
+	}
+
+	if _, err := s.tokenRepo.RevokeFamily(ctx, claims.Family, claims.UserID); err != nil {
+		return nil, err
+	}
+
+	return &pb.LogoutResponse{}, nil
+}
+
+// ListSessions returns the user's logins that can still be refreshed
+func (s *UserService) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	// A session idle for longer than a refresh token lives can't come back
+	sessions, err := s.tokenRepo.ListSessions(ctx, req.UserId, int64(refreshTokenDuration/time.Second))
+	if err != nil {
+		return nil, err
+	}
+
+	pbSessions := make([]*pb.Session, len(sessions))
+	for i, session := range sessions {
+		pbSessions[i] = &pb.Session{
+			Id:         session.ID,
+			UserAgent:  session.UserAgent,
+			IpAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			Current:    session.ID == req.CurrentSessionId,
+		}
+	}
+
+	return &pb.ListSessionsResponse{
+		Sessions: pbSessions,
+	}, nil
+}
+
+// RevokeSession logs one session out, or every session but the current one
+// when session_id is empty. Access tokens already issued stay valid until
+// they expire.
+func (s *UserService) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	if req.SessionId == "" {
+		if req.CurrentSessionId == "" {
+			return nil, status.Error(codes.InvalidArgument, "current session id is required to revoke other sessions")
+		}
+
+		revoked, err := s.tokenRepo.RevokeOtherFamilies(ctx, req.UserId, req.CurrentSessionId)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.RevokeSessionResponse{
+			Revoked: int32(revoked),
+		}, nil
+	}
+
+	revoked, err := s.tokenRepo.RevokeFamily(ctx, req.SessionId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !revoked {
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+
+	return &pb.RevokeSessionResponse{
+		Revoked: 1,
 	}, nil
 }
 
@@ -127,14 +247,7 @@ func (s *UserService) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.
 	}
 
 	return &pb.GetUserResponse{
-		User: &pb.User{
-			Id:        user.ID,
-			Email:     user.Email,
-			Name:      user.Name,
-			Phone:     user.Phone,
-			Role:      user.Role,
-			CreatedAt: user.CreatedAt,
-		},
+		User: toPbUser(user),
 	}, nil
 }
 
@@ -149,14 +262,7 @@ func (s *UserService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 	}
 
 	return &pb.UpdateUserResponse{
-		User: &pb.User{
-			Id:        user.ID,
-			Email:     user.Email,
-			Name:      user.Name,
-			Phone:     user.Phone,
-			Role:      user.Role,
-			CreatedAt: user.CreatedAt,
-		},
+		User: toPbUser(user),
 	}, nil
 }
 
@@ -182,11 +288,27 @@ func (s *UserService) AddAddress(ctx context.Context, req *pb.AddAddressRequest)
 }
 
 func (s *UserService) GetAddresses(ctx context.Context, req *pb.GetAddressesRequest) (*pb.GetAddressesResponse, error) {
-	addresses, err := s.addressRepo.GetByUserID(ctx, req.UserId)
+	pageSize, err := pkg.PageSize(req.PageSize, defaultAddressPageSize)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pageFilter := pkg.PageFilter(req.UserId)
+	after, err := pkg.DecodePageToken[repository.AddressCursor](req.PageToken, pageFilter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addresses, next, err := s.addressRepo.GetByUserID(ctx, req.UserId, after, pageSize)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get addresses: %w", err)
 	}
 
+	nextPageToken, err := pkg.EncodePageToken(pageFilter, next)
+	if err != nil {
+		return nil, err
+	}
+
 	pbAddresses := make([]*pb.Address, len(addresses))
 	for i, addr := range addresses {
 		pbAddresses[i] = &pb.Address{
@@ -203,6 +325,47 @@ func (s *UserService) GetAddresses(ctx context.Context, req *pb.GetAddressesRequ
 	}
 
 	return &pb.GetAddressesResponse{
-		Addresses: pbAddresses,
+		Addresses:     pbAddresses,
+		NextPageToken: nextPageToken,
 	}, nil
 }
+
+func (s *UserService) GetAddress(ctx context.Context, req *pb.GetAddressRequest) (*pb.GetAddressResponse, error) {
+	addr, err := s.addressRepo.GetByID(ctx, req.AddressId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "address not found")
+		}
+		return nil, fmt.Errorf("failed to get address: %w", err)
+	}
+
+	if req.UserId != "" && addr.UserID != req.UserId {
+		return nil, status.Error(codes.NotFound, "address not found")
+	}
+
+	return &pb.GetAddressResponse{
+		Address: &pb.Address{
+			Id:         addr.ID,
+			UserId:     addr.UserID,
+			Street:     addr.Street,
+			City:       addr.City,
+			PostalCode: addr.PostalCode,
+			Latitude:   addr.Latitude,
+			Longitude:  addr.Longitude,
+			IsDefault:  addr.IsDefault,
+			CreatedAt:  addr.CreatedAt,
+		},
+	}, nil
+}
+
+func toPbUser(user *repository.User) *pb.User {
+	return &pb.User{
+		Id:            user.ID,
+		Email:         user.Email,
+		Name:          user.Name,
+		Phone:         user.Phone,
+		Role:          user.Role,
+		CreatedAt:     user.CreatedAt,
+		EmailVerified: user.EmailVerified,
+	}
+}
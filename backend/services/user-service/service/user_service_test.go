@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kimashii-dan/food-delivery-app/backend/services/user-service/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRevokeSessionValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *pb.RevokeSessionRequest
+	}{
+		{name: "no user", req: &pb.RevokeSessionRequest{SessionId: "s1", CurrentSessionId: "s2"}},
+		{name: "others without the current session", req: &pb.RevokeSessionRequest{UserId: "u1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// no repositories: the request must be turned down before any lookup
+			s := &UserService{}
+
+			_, err := s.RevokeSession(context.Background(), tt.req)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("RevokeSession = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestListSessionsValidation(t *testing.T) {
+	s := &UserService{}
+
+	_, err := s.ListSessions(context.Background(), &pb.ListSessionsRequest{CurrentSessionId: "s1"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListSessions = %v, want InvalidArgument", err)
+	}
+}
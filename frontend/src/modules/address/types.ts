@@ -12,6 +12,7 @@ interface Address {
 
 type GetAddressesResponse = {
   addresses: Address[]
+  next_page_token: string
 }
 
 type AddAddressResponse = {
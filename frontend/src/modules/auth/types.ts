@@ -5,7 +5,6 @@ type RegisterRequest = {
   name: string
   password: string
   phone: string
-  role: string
 }
 
 type RegisterResponse = {
@@ -28,7 +28,7 @@ interface MenuItem {
 
 type getRestaurantsResponse = {
   restaurants: Restaurant[]
-  total: number
+  next_page_token: string
 }
 
 type getRestaurantResponse = {
@@ -37,7 +37,7 @@ type getRestaurantResponse = {
 
 type getMenuResponse = {
   items: MenuItem[]
-  total: number
+  next_page_token: string
 }
 
 type getMenuItemResponse = {
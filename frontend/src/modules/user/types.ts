@@ -5,6 +5,7 @@ interface User {
   phone: string
   role: string
   created_at: string
+  email_verified: boolean
 }
 
 type GetMeResponse = {